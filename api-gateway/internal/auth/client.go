@@ -2,13 +2,20 @@ package auth
 
 import (
 	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth/routes"
 	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/config"
 	"google.golang.org/grpc"
 	"log"
 )
 
 type ServiceClient struct {
-	Client pb.AuthServiceClient
+	Client        pb.AuthServiceClient
+	registerHooks []routes.RegisterHook
+}
+
+// OnRegister adds a hook that runs after every successful sign-up.
+func (svc *ServiceClient) OnRegister(hook routes.RegisterHook) {
+	svc.registerHooks = append(svc.registerHooks, hook)
 }
 
 func InitServiceClient(cfg *config.Config) pb.AuthServiceClient {
@@ -11,9 +11,14 @@ import (
 )
 
 const (
-	authHeader = "Authorization"
-	bearer     = "Bearer "
-	Key        = "userId"
+	authHeader     = "Authorization"
+	bearer         = "Bearer "
+	protocolHeader = "Sec-WebSocket-Protocol"
+	// Browsers cannot set headers on WebSocket handshakes, so these offer
+	// this subprotocol followed by the token instead. Unlike the query, the
+	// header does not end up in access logs.
+	TokenProtocol = "access_token"
+	Key           = "userId"
 )
 
 var (
@@ -33,22 +38,15 @@ func InitMiddleware(svc *ServiceClient) *Middleware {
 }
 
 func (m *Middleware) UserIdentity(ctx *gin.Context) {
-	header := ctx.Request.Header.Get(authHeader)
+	token := bearerToken(ctx.Request)
 
-	if header == "" {
-		utils.NewErrorResponse(ctx, http.StatusUnauthorized, unauthorizedBody)
-		return
-	}
-
-	token := strings.Split(header, bearer)
-
-	if len(token) != 2 {
+	if token == "" {
 		utils.NewErrorResponse(ctx, http.StatusUnauthorized, unauthorizedBody)
 		return
 	}
 
 	res, err := m.svc.Client.Validate(context.Background(), &pb.ValidateRequest{
-		Token: token[1],
+		Token: token,
 	})
 
 	if err != nil || res.Status != http.StatusOK {
@@ -61,6 +59,39 @@ func (m *Middleware) UserIdentity(ctx *gin.Context) {
 	ctx.Next()
 }
 
+// bearerToken returns the token of the Authorization header, or of the
+// subprotocols for WebSocket handshakes without the header. It is empty when
+// there is none.
+func bearerToken(req *http.Request) string {
+	header := req.Header.Get(authHeader)
+
+	if header == "" {
+		if strings.EqualFold(req.Header.Get("Upgrade"), "websocket") {
+			return protocolToken(req.Header.Get(protocolHeader))
+		}
+		return ""
+	}
+
+	token := strings.Split(header, bearer)
+
+	if len(token) != 2 {
+		return ""
+	}
+
+	return token[1]
+}
+
+// protocolToken returns the token offered after TokenProtocol in the
+// subprotocols of a WebSocket handshake.
+func protocolToken(header string) string {
+	protocols := strings.Split(header, ",")
+	if len(protocols) != 2 || strings.TrimSpace(protocols[0]) != TokenProtocol {
+		return ""
+	}
+
+	return strings.TrimSpace(protocols[1])
+}
+
 func GetUserId(c *gin.Context) (int64, error) {
 	id, ok := c.Get(Key)
 	if !ok {
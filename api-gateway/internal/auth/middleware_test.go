@@ -24,6 +24,9 @@ func TestUserIdentity(t *testing.T) {
 	tests := []struct {
 		name           string
 		header         string
+		query          string
+		protocol       string
+		websocket      bool
 		mockClient     *mocks.MockAuthServiceClient
 		expectedStatus int
 		expectedUserId interface{}
@@ -84,6 +87,60 @@ func TestUserIdentity(t *testing.T) {
 			expectedStatus: http.StatusUnauthorized,
 			expectedUserId: 0,
 		},
+		{
+			name:      "token in protocol for websocket",
+			protocol:  "access_token, valid-token",
+			websocket: true,
+			mockClient: &mocks.MockAuthServiceClient{
+				ValidateFunc: func(ctx context.Context, req *pb.ValidateRequest) (*pb.ValidateResponse, error) {
+					assert.Equal(t, "valid-token", req.Token)
+					return &pb.ValidateResponse{
+						UserId: 1,
+						Status: http.StatusOK,
+					}, nil
+				},
+			},
+			expectedStatus: http.StatusOK,
+			expectedUserId: 1,
+		},
+		{
+			name:     "token in protocol without websocket",
+			protocol: "access_token, valid-token",
+			mockClient: &mocks.MockAuthServiceClient{
+				ValidateFunc: func(ctx context.Context, req *pb.ValidateRequest) (*pb.ValidateResponse, error) {
+					t.Error("the protocol is only read for websockets")
+					return nil, nil
+				},
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedUserId: 0,
+		},
+		{
+			name:      "other protocol for websocket",
+			protocol:  "chat, valid-token",
+			websocket: true,
+			mockClient: &mocks.MockAuthServiceClient{
+				ValidateFunc: func(ctx context.Context, req *pb.ValidateRequest) (*pb.ValidateResponse, error) {
+					t.Error("only the token protocol carries a token")
+					return nil, nil
+				},
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedUserId: 0,
+		},
+		{
+			name:      "token in query for websocket",
+			query:     "?access_token=valid-token",
+			websocket: true,
+			mockClient: &mocks.MockAuthServiceClient{
+				ValidateFunc: func(ctx context.Context, req *pb.ValidateRequest) (*pb.ValidateResponse, error) {
+					t.Error("tokens in the query would end up in access logs")
+					return nil, nil
+				},
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedUserId: 0,
+		},
 	}
 
 	for _, tt := range tests {
@@ -99,10 +156,16 @@ func TestUserIdentity(t *testing.T) {
 				ctx.JSON(http.StatusOK, gin.H{"user_id": ctx.Value(Key)})
 			})
 
-			req, _ := http.NewRequest(http.MethodGet, "/protected", nil)
+			req, _ := http.NewRequest(http.MethodGet, "/protected"+tt.query, nil)
 			if tt.header != "" {
 				req.Header.Set(authHeader, tt.header)
 			}
+			if tt.protocol != "" {
+				req.Header.Set(protocolHeader, tt.protocol)
+			}
+			if tt.websocket {
+				req.Header.Set("Upgrade", "websocket")
+			}
 			rec := httptest.NewRecorder()
 
 			router.ServeHTTP(rec, req)
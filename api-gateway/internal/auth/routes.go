@@ -23,7 +23,7 @@ func RegisterRoutes(router *gin.Engine, cfg *config.Config) *ServiceClient {
 }
 
 func (svc *ServiceClient) Register(ctx *gin.Context) {
-	routes.Register(ctx, svc.Client)
+	routes.Register(ctx, svc.Client, svc.registerHooks...)
 }
 func (svc *ServiceClient) Login(ctx *gin.Context) {
 	routes.Login(ctx, svc.Client)
@@ -7,9 +7,11 @@ import (
 )
 
 type MockAuthServiceClient struct {
-	LoginFunc    func(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error)
-	RegisterFunc func(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error)
-	ValidateFunc func(ctx context.Context, req *pb.ValidateRequest) (*pb.ValidateResponse, error)
+	LoginFunc           func(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error)
+	RegisterFunc        func(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error)
+	ValidateFunc        func(ctx context.Context, req *pb.ValidateRequest) (*pb.ValidateResponse, error)
+	FindUserByEmailFunc func(ctx context.Context, req *pb.FindUserByEmailRequest) (*pb.FindUserByEmailResponse, error)
+	BatchGetUsersFunc   func(ctx context.Context, req *pb.BatchGetUsersRequest) (*pb.BatchGetUsersResponse, error)
 }
 
 func (m *MockAuthServiceClient) Login(ctx context.Context, in *pb.LoginRequest, opts ...grpc.CallOption) (*pb.LoginResponse, error) {
@@ -23,3 +25,11 @@ func (m *MockAuthServiceClient) Register(ctx context.Context, in *pb.RegisterReq
 func (m *MockAuthServiceClient) Validate(ctx context.Context, in *pb.ValidateRequest, opts ...grpc.CallOption) (*pb.ValidateResponse, error) {
 	return m.ValidateFunc(ctx, in)
 }
+
+func (m *MockAuthServiceClient) FindUserByEmail(ctx context.Context, in *pb.FindUserByEmailRequest, opts ...grpc.CallOption) (*pb.FindUserByEmailResponse, error) {
+	return m.FindUserByEmailFunc(ctx, in)
+}
+
+func (m *MockAuthServiceClient) BatchGetUsers(ctx context.Context, in *pb.BatchGetUsersRequest, opts ...grpc.CallOption) (*pb.BatchGetUsersResponse, error) {
+	return m.BatchGetUsersFunc(ctx, in)
+}
@@ -13,7 +13,10 @@ type RegisterRequest struct {
 	Password string `json:"password"`
 }
 
-func Register(ctx *gin.Context, client pb.AuthServiceClient) {
+// RegisterHook is called after a user has signed up successfully.
+type RegisterHook func(userId int64, email string)
+
+func Register(ctx *gin.Context, client pb.AuthServiceClient, hooks ...RegisterHook) {
 	var req RegisterRequest
 
 	if err := ctx.BindJSON(&req); err != nil {
@@ -31,5 +34,11 @@ func Register(ctx *gin.Context, client pb.AuthServiceClient) {
 		return
 	}
 
+	if res.Status == http.StatusCreated {
+		for _, hook := range hooks {
+			hook(res.UserId, req.Email)
+		}
+	}
+
 	ctx.JSON(int(res.Status), &res)
 }
@@ -84,3 +84,54 @@ func TestRegister(t *testing.T) {
 		})
 	}
 }
+
+func TestRegister_Hooks(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name          string
+		status        int64
+		expectedCalls int
+	}{
+		{
+			name:          "user created",
+			status:        http.StatusCreated,
+			expectedCalls: 1,
+		},
+		{
+			name:          "email already exists",
+			status:        http.StatusConflict,
+			expectedCalls: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &mocks.MockAuthServiceClient{
+				RegisterFunc: func(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error) {
+					return &pb.RegisterResponse{UserId: 7, Status: tt.status}, nil
+				},
+			}
+
+			calls := 0
+			hook := func(userId int64, email string) {
+				calls++
+				assert.Equal(t, int64(7), userId)
+				assert.Equal(t, testEmail, email)
+			}
+
+			router := gin.New()
+			router.POST("/register", func(ctx *gin.Context) {
+				Register(ctx, mockClient, hook)
+			})
+
+			body, _ := json.Marshal(RegisterRequest{Email: testEmail, Password: testPassword})
+			req, _ := http.NewRequest(http.MethodPost, "/register", bytes.NewBuffer(body))
+			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, req)
+
+			assert.Equal(t, int(tt.status), rr.Code)
+			assert.Equal(t, tt.expectedCalls, calls)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package todo
 
 import (
+	authpb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth/pb"
 	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/config"
 	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
 	"google.golang.org/grpc"
@@ -9,6 +10,7 @@ import (
 
 type ServiceClient struct {
 	Client pb.TodoServiceClient
+	Users  authpb.AuthServiceClient
 }
 
 func InitServiceClient(cfg *config.Config) pb.TodoServiceClient {
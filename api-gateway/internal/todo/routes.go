@@ -1,10 +1,14 @@
 package todo
 
 import (
+	"context"
 	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
 	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/config"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
 	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes"
 	"github.com/gin-gonic/gin"
+	"log"
+	"net/http"
 )
 
 func RegisterRoutes(router *gin.Engine, cfg *config.Config, authSvc *auth.ServiceClient) {
@@ -12,8 +16,15 @@ func RegisterRoutes(router *gin.Engine, cfg *config.Config, authSvc *auth.Servic
 
 	svc := &ServiceClient{
 		Client: InitServiceClient(cfg),
+		Users:  authSvc.Client,
 	}
 
+	authSvc.OnRegister(svc.activateInvitations)
+
+	// Calendar apps subscribe to the feed without logging in, the token in
+	// the path grants access.
+	router.GET("/calendar/:token", svc.getCalendar)
+
 	api := router.Group("/api", a.UserIdentity)
 	{
 		lists := api.Group("/lists")
@@ -22,20 +33,92 @@ func RegisterRoutes(router *gin.Engine, cfg *config.Config, authSvc *auth.Servic
 			lists.GET("/", svc.getTodoLists)
 			lists.GET("/:id", svc.getTodoListById)
 			lists.PUT("/:id", svc.updateTodoList)
+			lists.PATCH("/:id", svc.patchTodoList)
 			lists.DELETE("/:id", svc.deleteTodoList)
+			lists.POST("/:id/archive", svc.archiveTodoList)
+			lists.POST("/:id/unarchive", svc.unarchiveTodoList)
+			lists.POST("/:id/move", svc.moveTodoList)
+			lists.POST("/:id/import", svc.importIntoList)
+			lists.GET("/:id/todo.txt", svc.exportTodoTxt)
+			lists.PUT("/:id/todo.txt", svc.syncTodoTxt)
 
 			items := lists.Group(":id/items")
 			{
 				items.POST("/", svc.createTodoItem)
 				items.GET("/", svc.getTodoItems)
 			}
+
+			members := lists.Group(":id/members")
+			{
+				members.POST("/", svc.shareList)
+				members.GET("/", svc.getListMembers)
+				members.PUT("/:memberId", svc.updateMemberRole)
+				members.DELETE("/:memberId", svc.removeMember)
+			}
+
+			lists.POST("/:id/invitations", svc.createInvitation)
+			lists.GET("/:id/activity", svc.getListActivity)
+			lists.GET("/:id/events", svc.listEvents)
+			lists.GET("/:id/events/ws", svc.listEventsWebSocket)
 		}
 
 		items := api.Group("items")
 		{
+			items.GET("/", svc.getDueItems)
+			items.GET("/overdue", svc.getOverdueItems)
+			items.POST("/occurrences", svc.previewOccurrences)
+			items.POST("/move", svc.moveTodoItems)
+			items.POST("/copy", svc.copyTodoItems)
+			items.POST("/batch", svc.batchUpdateTodoItems)
 			items.GET("/:id", svc.getTodoItemById)
 			items.PUT("/:id", svc.updateTodoItem)
+			items.PATCH("/:id", svc.patchTodoItem)
 			items.DELETE("/:id", svc.deleteTodoItemById)
+			items.POST("/:id/move", svc.moveTodoItem)
+			items.GET("/:id/occurrences", svc.getUpcomingOccurrences)
+			items.POST("/:id/tags/:tagId", svc.attachTag)
+			items.DELETE("/:id/tags/:tagId", svc.detachTag)
+			items.POST("/:id/comments", svc.addComment)
+			items.GET("/:id/comments", svc.listComments)
+			items.PUT("/:id/comments/:commentId", svc.editComment)
+			items.DELETE("/:id/comments/:commentId", svc.deleteComment)
+			items.POST("/:id/attachments", svc.uploadAttachment)
+			items.GET("/:id/attachments", svc.listAttachments)
+			items.GET("/:id/attachments/:attachmentId", svc.downloadAttachment)
+			items.DELETE("/:id/attachments/:attachmentId", svc.deleteAttachment)
+		}
+
+		api.GET("/search", svc.searchItems)
+		api.GET("/export", svc.exportLists)
+		api.POST("/import", svc.importLists)
+
+		calendar := api.Group("/calendar")
+		{
+			calendar.GET("/", svc.getCalendarFeed)
+			calendar.POST("/reset", svc.resetCalendarFeed)
+		}
+
+		trash := api.Group("/trash")
+		{
+			trash.GET("/", svc.listTrash)
+			trash.DELETE("/", svc.emptyTrash)
+			trash.POST("/lists/:id/restore", svc.restoreTodoList)
+			trash.POST("/items/:id/restore", svc.restoreTodoItem)
+		}
+
+		invitations := api.Group("/invitations")
+		{
+			invitations.GET("/", svc.getInvitations)
+			invitations.POST("/:token/accept", svc.acceptInvitation)
+			invitations.POST("/:token/decline", svc.declineInvitation)
+		}
+
+		tags := api.Group("/tags")
+		{
+			tags.POST("/", svc.createTag)
+			tags.GET("/", svc.getTags)
+			tags.PUT("/:id", svc.updateTag)
+			tags.DELETE("/:id", svc.deleteTag)
 		}
 	}
 }
@@ -60,6 +143,70 @@ func (svc *ServiceClient) deleteTodoList(ctx *gin.Context) {
 	routes.DeleteTodoList(ctx, svc.Client)
 }
 
+func (svc *ServiceClient) archiveTodoList(ctx *gin.Context) {
+	routes.ArchiveTodoList(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) unarchiveTodoList(ctx *gin.Context) {
+	routes.UnarchiveTodoList(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) moveTodoList(ctx *gin.Context) {
+	routes.MoveTodoList(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) restoreTodoList(ctx *gin.Context) {
+	routes.RestoreTodoList(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) shareList(ctx *gin.Context) {
+	routes.ShareList(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) getListMembers(ctx *gin.Context) {
+	routes.GetListMembers(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) updateMemberRole(ctx *gin.Context) {
+	routes.UpdateMemberRole(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) removeMember(ctx *gin.Context) {
+	routes.RemoveMember(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) createInvitation(ctx *gin.Context) {
+	routes.CreateInvitation(ctx, svc.Client, svc.Users)
+}
+
+func (svc *ServiceClient) getInvitations(ctx *gin.Context) {
+	routes.GetInvitations(ctx, svc.Client, svc.Users)
+}
+
+func (svc *ServiceClient) acceptInvitation(ctx *gin.Context) {
+	routes.AcceptInvitation(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) declineInvitation(ctx *gin.Context) {
+	routes.DeclineInvitation(ctx, svc.Client)
+}
+
+// activateInvitations hands invitations sent to the e-mail of a new user over
+// to their account.
+func (svc *ServiceClient) activateInvitations(userId int64, email string) {
+	res, err := svc.Client.ActivateInvitations(context.Background(), &pb.ActivateInvitationsRequest{
+		UserId: userId,
+		Email:  email,
+	})
+	if err != nil {
+		log.Println("Could not activate invitations:", err)
+		return
+	}
+	if res.Status != http.StatusOK {
+		log.Println("Could not activate invitations:", res.Error)
+	}
+}
+
 func (svc *ServiceClient) createTodoItem(ctx *gin.Context) {
 	routes.CreateTodoItem(ctx, svc.Client)
 }
@@ -79,3 +226,159 @@ func (svc *ServiceClient) updateTodoItem(ctx *gin.Context) {
 func (svc *ServiceClient) deleteTodoItemById(ctx *gin.Context) {
 	routes.DeleteTodoItemById(ctx, svc.Client)
 }
+
+func (svc *ServiceClient) moveTodoItem(ctx *gin.Context) {
+	routes.MoveTodoItem(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) moveTodoItems(ctx *gin.Context) {
+	routes.MoveTodoItems(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) copyTodoItems(ctx *gin.Context) {
+	routes.CopyTodoItems(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) batchUpdateTodoItems(ctx *gin.Context) {
+	routes.BatchUpdateTodoItems(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) restoreTodoItem(ctx *gin.Context) {
+	routes.RestoreTodoItem(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) getDueItems(ctx *gin.Context) {
+	routes.GetDueItems(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) getOverdueItems(ctx *gin.Context) {
+	routes.GetOverdueItems(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) getUpcomingOccurrences(ctx *gin.Context) {
+	routes.GetUpcomingOccurrences(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) previewOccurrences(ctx *gin.Context) {
+	routes.PreviewOccurrences(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) searchItems(ctx *gin.Context) {
+	routes.SearchItems(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) listTrash(ctx *gin.Context) {
+	routes.ListTrash(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) emptyTrash(ctx *gin.Context) {
+	routes.EmptyTrash(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) createTag(ctx *gin.Context) {
+	routes.CreateTag(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) getTags(ctx *gin.Context) {
+	routes.GetTags(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) updateTag(ctx *gin.Context) {
+	routes.UpdateTag(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) deleteTag(ctx *gin.Context) {
+	routes.DeleteTag(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) attachTag(ctx *gin.Context) {
+	routes.AttachTag(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) detachTag(ctx *gin.Context) {
+	routes.DetachTag(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) addComment(ctx *gin.Context) {
+	routes.AddComment(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) listComments(ctx *gin.Context) {
+	routes.ListComments(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) editComment(ctx *gin.Context) {
+	routes.EditComment(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) deleteComment(ctx *gin.Context) {
+	routes.DeleteComment(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) uploadAttachment(ctx *gin.Context) {
+	routes.UploadAttachment(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) listAttachments(ctx *gin.Context) {
+	routes.ListAttachments(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) downloadAttachment(ctx *gin.Context) {
+	routes.DownloadAttachment(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) deleteAttachment(ctx *gin.Context) {
+	routes.DeleteAttachment(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) exportLists(ctx *gin.Context) {
+	routes.ExportLists(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) importLists(ctx *gin.Context) {
+	routes.ImportLists(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) importIntoList(ctx *gin.Context) {
+	routes.ImportIntoList(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) exportTodoTxt(ctx *gin.Context) {
+	routes.ExportTodoTxt(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) syncTodoTxt(ctx *gin.Context) {
+	routes.SyncTodoTxt(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) getCalendarFeed(ctx *gin.Context) {
+	routes.GetCalendarFeed(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) resetCalendarFeed(ctx *gin.Context) {
+	routes.ResetCalendarFeed(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) getCalendar(ctx *gin.Context) {
+	routes.GetCalendar(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) getListActivity(ctx *gin.Context) {
+	routes.GetListActivity(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) listEvents(ctx *gin.Context) {
+	routes.ListEvents(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) listEventsWebSocket(ctx *gin.Context) {
+	routes.ListEventsWebSocket(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) patchTodoList(ctx *gin.Context) {
+	routes.PatchTodoList(ctx, svc.Client)
+}
+
+func (svc *ServiceClient) patchTodoItem(ctx *gin.Context) {
+	routes.PatchTodoItem(ctx, svc.Client)
+}
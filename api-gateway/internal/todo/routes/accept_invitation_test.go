@@ -0,0 +1,80 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAcceptInvitation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name: "successfully accepting invitation",
+			mockClient: &mocks.MockTodoServiceClient{
+				AcceptInvitationFunc: func(ctx context.Context, in *pb.AcceptInvitationRequest) (*pb.AcceptInvitationResponse, error) {
+					assert.Equal(t, "abc", in.Token)
+					return &pb.AcceptInvitationResponse{Success: true, Status: http.StatusOK}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"success":true,"status":200}`,
+			userId:               1,
+		},
+		{
+			name: "expired invitation",
+			mockClient: &mocks.MockTodoServiceClient{
+				AcceptInvitationFunc: func(ctx context.Context, in *pb.AcceptInvitationRequest) (*pb.AcceptInvitationResponse, error) {
+					return &pb.AcceptInvitationResponse{Status: http.StatusGone, Error: "Invitation has expired"}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"status":410,"error":"Invitation has expired"}`,
+			userId:               1,
+		},
+		{
+			name:                 "user not authorized",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			expectedStatusCode:   http.StatusUnauthorized,
+			expectedResponseBody: `{"message":"invalid user ID"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodPost, "/invitations/abc/accept", nil)
+
+			r.POST("/invitations/:token/accept", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				AcceptInvitation(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedResponseBody != "" {
+				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+type CommentInput struct {
+	Body string `json:"body"`
+}
+
+func AddComment(ctx *gin.Context, client pb.TodoServiceClient) {
+	var req CommentInput
+
+	if err := ctx.BindJSON(&req); err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidInputBody)
+		return
+	}
+
+	userID, err := auth.GetUserId(ctx)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusUnauthorized, invalidUserID)
+		return
+	}
+
+	itemId, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidItemId)
+		return
+	}
+
+	res, err := client.AddComment(context.Background(), &pb.AddCommentRequest{
+		UserId: userID,
+		ItemId: int64(itemId),
+		Body:   req.Body,
+	})
+
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, &res)
+}
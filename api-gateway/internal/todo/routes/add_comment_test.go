@@ -0,0 +1,102 @@
+package routes
+
+import (
+	"bytes"
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAddComment(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		inputBody            string
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name: "successfully adding a comment",
+			mockClient: &mocks.MockTodoServiceClient{
+				AddCommentFunc: func(ctx context.Context, req *pb.AddCommentRequest) (*pb.AddCommentResponse, error) {
+					assert.Equal(t, int64(5), req.ItemId)
+					assert.Equal(t, "Deployed", req.Body)
+					return &pb.AddCommentResponse{
+						Comment: &pb.Comment{Id: 1, ItemId: 5, AuthorId: 1, Body: "Deployed"},
+						Status:  http.StatusCreated,
+					}, nil
+				},
+			},
+			inputBody:            `{"body":"Deployed"}`,
+			expectedStatusCode:   http.StatusCreated,
+			expectedResponseBody: `{"comment":{"id":1,"item_id":5,"author_id":1,"body":"Deployed"},"status":201}`,
+			userId:               1,
+		},
+		{
+			name: "not a member",
+			mockClient: &mocks.MockTodoServiceClient{
+				AddCommentFunc: func(ctx context.Context, req *pb.AddCommentRequest) (*pb.AddCommentResponse, error) {
+					return &pb.AddCommentResponse{
+						Status: http.StatusForbidden,
+						Error:  "User does not have access to this list",
+					}, nil
+				},
+			},
+			inputBody:            `{"body":"Deployed"}`,
+			expectedStatusCode:   http.StatusCreated,
+			expectedResponseBody: `{"status":403,"error":"User does not have access to this list"}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid input body",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			inputBody:            `{"body":1}`,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"invalid input body"}`,
+			userId:               1,
+		},
+		{
+			name:                 "unauthorized",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			inputBody:            `{"body":"Deployed"}`,
+			expectedStatusCode:   http.StatusUnauthorized,
+			expectedResponseBody: `{"message":"invalid user ID"}`,
+			userId:               0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodPost, "/items/5/comments", bytes.NewBufferString(tt.inputBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			r.POST("/items/:id/comments", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				AddComment(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedResponseBody != "" {
+				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestArchiveTodoList(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		query                string
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name: "successfully archiving todo list",
+			mockClient: &mocks.MockTodoServiceClient{
+				ArchiveTodoListFunc: func(ctx context.Context, req *pb.ArchiveTodoListRequest) (*pb.ArchiveTodoListResponse, error) {
+					return &pb.ArchiveTodoListResponse{
+						Success: true,
+						Status:  http.StatusOK,
+					}, nil
+				},
+			},
+			query:                "1/archive",
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"success":true,"status":200}`,
+			userId:               1,
+		},
+		{
+			name: "not the owner",
+			mockClient: &mocks.MockTodoServiceClient{
+				ArchiveTodoListFunc: func(ctx context.Context, req *pb.ArchiveTodoListRequest) (*pb.ArchiveTodoListResponse, error) {
+					return &pb.ArchiveTodoListResponse{
+						Status: http.StatusForbidden,
+						Error:  "User role does not allow this action",
+					}, nil
+				},
+			},
+			query:                "1/archive",
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"status":403,"error":"User role does not allow this action"}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid list id",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			query:                "abc/archive",
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"invalid list id"}`,
+			userId:               1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodPost, "/lists/"+tt.query, nil)
+
+			r.POST("/lists/:id/archive", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				ArchiveTodoList(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedResponseBody != "" {
+				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			}
+		})
+	}
+}
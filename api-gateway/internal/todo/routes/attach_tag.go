@@ -0,0 +1,44 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+func AttachTag(ctx *gin.Context, client pb.TodoServiceClient) {
+	userID, err := auth.GetUserId(ctx)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusUnauthorized, invalidUserID)
+		return
+	}
+
+	itemId, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidItemId)
+		return
+	}
+
+	tagId, err := strconv.Atoi(ctx.Param("tagId"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidTagID)
+		return
+	}
+
+	res, err := client.AttachTag(context.Background(), &pb.AttachTagRequest{
+		UserId: userID,
+		ItemId: int64(itemId),
+		TagId:  int64(tagId),
+	})
+
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	ctx.JSON(http.StatusOK, &res)
+}
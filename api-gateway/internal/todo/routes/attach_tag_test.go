@@ -0,0 +1,75 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAttachTag(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		tagId                string
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name: "successfully attaching tag",
+			mockClient: &mocks.MockTodoServiceClient{
+				AttachTagFunc: func(ctx context.Context, req *pb.AttachTagRequest) (*pb.AttachTagResponse, error) {
+					return &pb.AttachTagResponse{
+						Status:  http.StatusOK,
+						Success: true,
+					}, nil
+				},
+			},
+			tagId:                "2",
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"success":true,"status":200}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid tag id",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			tagId:                "bug",
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"invalid tag id"}`,
+			userId:               1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodPost, "/items/1/tags/"+tt.tagId, nil)
+
+			r.POST("/items/:id/tags/:tagId", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				AttachTag(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedResponseBody != "" {
+				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package routes
+
+import (
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+)
+
+var (
+	invalidOperation = "operation must be one of complete, uncomplete, delete, set_fields"
+
+	batchOperations = map[string]pb.BatchOperation{
+		"complete":   pb.BatchOperation_BATCH_OPERATION_COMPLETE,
+		"uncomplete": pb.BatchOperation_BATCH_OPERATION_UNCOMPLETE,
+		"delete":     pb.BatchOperation_BATCH_OPERATION_DELETE,
+		"set_fields": pb.BatchOperation_BATCH_OPERATION_SET_FIELDS,
+	}
+)
+
+type BatchUpdateInput struct {
+	Ids       []int64           `json:"ids"`
+	Operation string            `json:"operation"`
+	Fields    *BatchFieldsInput `json:"fields"`
+}
+
+// BatchFieldsInput holds the fields set by the set_fields operation, fields
+// left out are not changed.
+type BatchFieldsInput struct {
+	StartAt  *time.Time `json:"start_at"`
+	DueAt    *time.Time `json:"due_at"`
+	TimeZone *string    `json:"time_zone"`
+	Priority *int32     `json:"priority"`
+}
+
+func BatchUpdateTodoItems(ctx *gin.Context, client pb.TodoServiceClient) {
+	var req BatchUpdateInput
+
+	if err := ctx.BindJSON(&req); err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidInputBody)
+		return
+	}
+
+	operation, ok := batchOperations[req.Operation]
+	if !ok {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidOperation)
+		return
+	}
+
+	userID, err := auth.GetUserId(ctx)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusUnauthorized, invalidUserID)
+		return
+	}
+
+	var fields *pb.BatchItemFields
+	if req.Fields != nil {
+		fields = &pb.BatchItemFields{
+			StartAt:  utils.ToTimestamp(req.Fields.StartAt),
+			DueAt:    utils.ToTimestamp(req.Fields.DueAt),
+			TimeZone: req.Fields.TimeZone,
+		}
+		if req.Fields.Priority != nil {
+			priority := pb.Priority(*req.Fields.Priority)
+			fields.Priority = &priority
+		}
+	}
+
+	res, err := client.BatchUpdateTodoItems(idempotencyContext(ctx), &pb.BatchUpdateTodoItemsRequest{
+		UserId:    userID,
+		Ids:       req.Ids,
+		Operation: operation,
+		Fields:    fields,
+	})
+
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	ctx.JSON(http.StatusOK, &res)
+}
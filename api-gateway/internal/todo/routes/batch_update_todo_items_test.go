@@ -0,0 +1,133 @@
+package routes
+
+import (
+	"bytes"
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestBatchUpdateTodoItems(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		inputBody            string
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name: "successfully completing todo items",
+			mockClient: &mocks.MockTodoServiceClient{
+				BatchUpdateTodoItemsFunc: func(ctx context.Context, req *pb.BatchUpdateTodoItemsRequest) (*pb.BatchUpdateTodoItemsResponse, error) {
+					assert.Equal(t, []int64{1, 2}, req.Ids)
+					assert.Equal(t, pb.BatchOperation_BATCH_OPERATION_COMPLETE, req.Operation)
+					return &pb.BatchUpdateTodoItemsResponse{
+						Results: []*pb.BatchItemResult{
+							{Id: 1, Status: http.StatusOK},
+							{Id: 2, Status: http.StatusOK},
+						},
+						Status: http.StatusOK,
+					}, nil
+				},
+			},
+			inputBody:            `{"ids":[1,2],"operation":"complete"}`,
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"results":[{"id":1,"status":200},{"id":2,"status":200}],"status":200}`,
+			userId:               1,
+		},
+		{
+			name: "setting fields",
+			mockClient: &mocks.MockTodoServiceClient{
+				BatchUpdateTodoItemsFunc: func(ctx context.Context, req *pb.BatchUpdateTodoItemsRequest) (*pb.BatchUpdateTodoItemsResponse, error) {
+					assert.Equal(t, pb.BatchOperation_BATCH_OPERATION_SET_FIELDS, req.Operation)
+					assert.Equal(t, pb.Priority_PRIORITY_HIGH, req.Fields.GetPriority())
+					assert.Nil(t, req.Fields.TimeZone)
+					return &pb.BatchUpdateTodoItemsResponse{
+						Status: http.StatusOK,
+					}, nil
+				},
+			},
+			inputBody:            `{"ids":[1],"operation":"set_fields","fields":{"priority":3}}`,
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"status":200}`,
+			userId:               1,
+		},
+		{
+			name: "item failing the batch",
+			mockClient: &mocks.MockTodoServiceClient{
+				BatchUpdateTodoItemsFunc: func(ctx context.Context, req *pb.BatchUpdateTodoItemsRequest) (*pb.BatchUpdateTodoItemsResponse, error) {
+					return &pb.BatchUpdateTodoItemsResponse{
+						Results: []*pb.BatchItemResult{
+							{Id: 1, Status: http.StatusNotFound, Error: "Item not found"},
+						},
+						Status: http.StatusNotFound,
+						Error:  "Some items failed, no item was changed",
+					}, nil
+				},
+			},
+			inputBody:            `{"ids":[1],"operation":"delete"}`,
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"results":[{"id":1,"status":404,"error":"Item not found"}],"status":404,"error":"Some items failed, no item was changed"}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid operation",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			inputBody:            `{"ids":[1],"operation":"archive"}`,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"operation must be one of complete, uncomplete, delete, set_fields"}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid input body",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			inputBody:            `{"ids":"1"}`,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"invalid input body"}`,
+			userId:               1,
+		},
+		{
+			name:                 "unauthorized",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			inputBody:            `{"ids":[1],"operation":"complete"}`,
+			expectedStatusCode:   http.StatusUnauthorized,
+			expectedResponseBody: `{"message":"invalid user ID"}`,
+			userId:               0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodPost, "/items/batch", bytes.NewBufferString(tt.inputBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			r.POST("/items/batch", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				BatchUpdateTodoItems(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedResponseBody != "" {
+				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+func CopyTodoItems(ctx *gin.Context, client pb.TodoServiceClient) {
+	var req TransferItemsInput
+
+	if err := ctx.BindJSON(&req); err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidInputBody)
+		return
+	}
+
+	userID, err := auth.GetUserId(ctx)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusUnauthorized, invalidUserID)
+		return
+	}
+
+	res, err := client.CopyTodoItems(context.Background(), &pb.CopyTodoItemsRequest{
+		UserId: userID,
+		Ids:    req.Ids,
+		ListId: req.ListId,
+	})
+
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, &res)
+}
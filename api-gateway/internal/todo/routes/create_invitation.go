@@ -0,0 +1,84 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	authpb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth/pb"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+var (
+	invalidEmail = "email is required"
+)
+
+type CreateInvitationInput struct {
+	Email string `json:"email"`
+	Role  int32  `json:"role"`
+}
+
+func CreateInvitation(ctx *gin.Context, client pb.TodoServiceClient, users authpb.AuthServiceClient) {
+	var req CreateInvitationInput
+
+	if err := ctx.BindJSON(&req); err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidInputBody)
+		return
+	}
+
+	userID, err := auth.GetUserId(ctx)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusUnauthorized, invalidUserID)
+		return
+	}
+
+	listId, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidListID)
+		return
+	}
+
+	// Looked up the way the todo service stores invitations, in lower case.
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	if email == "" {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidEmail)
+		return
+	}
+
+	user, err := users.FindUserByEmail(context.Background(), &authpb.FindUserByEmailRequest{
+		Email: email,
+	})
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	// Invitations to e-mails nobody has signed up with yet wait for the
+	// invitee to register.
+	var inviteeId int64
+	switch user.Status {
+	case http.StatusOK:
+		inviteeId = user.User.Id
+	case http.StatusNotFound:
+	default:
+		utils.NewErrorResponse(ctx, int(user.Status), user.Error)
+		return
+	}
+
+	res, err := client.CreateInvitation(context.Background(), &pb.CreateInvitationRequest{
+		UserId:    userID,
+		ListId:    int64(listId),
+		Email:     email,
+		Role:      pb.ListRole(req.Role),
+		InviteeId: inviteeId,
+	})
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, &res)
+}
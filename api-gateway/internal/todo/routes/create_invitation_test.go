@@ -0,0 +1,127 @@
+package routes
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	authpb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth/pb"
+	authmocks "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth/routes/mocks"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCreateInvitation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		mockUsers            *authmocks.MockAuthServiceClient
+		inputBody            string
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name: "successfully inviting registered user",
+			mockClient: &mocks.MockTodoServiceClient{
+				CreateInvitationFunc: func(ctx context.Context, in *pb.CreateInvitationRequest) (*pb.CreateInvitationResponse, error) {
+					assert.Equal(t, "colleague@example.com", in.Email)
+					assert.Equal(t, int64(2), in.InviteeId)
+					assert.Equal(t, pb.ListRole_LIST_ROLE_EDITOR, in.Role)
+					return &pb.CreateInvitationResponse{Status: http.StatusCreated}, nil
+				},
+			},
+			mockUsers: &authmocks.MockAuthServiceClient{
+				FindUserByEmailFunc: func(ctx context.Context, req *authpb.FindUserByEmailRequest) (*authpb.FindUserByEmailResponse, error) {
+					assert.Equal(t, "colleague@example.com", req.Email)
+					return &authpb.FindUserByEmailResponse{User: &authpb.User{Id: 2, Email: req.Email}, Status: http.StatusOK}, nil
+				},
+			},
+			inputBody:            `{"email":" Colleague@Example.com ","role":2}`,
+			expectedStatusCode:   http.StatusCreated,
+			expectedResponseBody: `{"status":201}`,
+			userId:               1,
+		},
+		{
+			name: "successfully inviting unregistered e-mail",
+			mockClient: &mocks.MockTodoServiceClient{
+				CreateInvitationFunc: func(ctx context.Context, in *pb.CreateInvitationRequest) (*pb.CreateInvitationResponse, error) {
+					assert.Equal(t, int64(0), in.InviteeId)
+					return &pb.CreateInvitationResponse{Status: http.StatusCreated}, nil
+				},
+			},
+			mockUsers: &authmocks.MockAuthServiceClient{
+				FindUserByEmailFunc: func(ctx context.Context, req *authpb.FindUserByEmailRequest) (*authpb.FindUserByEmailResponse, error) {
+					return &authpb.FindUserByEmailResponse{Status: http.StatusNotFound, Error: "user not found"}, nil
+				},
+			},
+			inputBody:            `{"email":"new@example.com","role":1}`,
+			expectedStatusCode:   http.StatusCreated,
+			expectedResponseBody: `{"status":201}`,
+			userId:               1,
+		},
+		{
+			name:                 "missing e-mail",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			mockUsers:            &authmocks.MockAuthServiceClient{},
+			inputBody:            `{"role":1}`,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"email is required"}`,
+			userId:               1,
+		},
+		{
+			name:       "auth service unavailable",
+			mockClient: &mocks.MockTodoServiceClient{},
+			mockUsers: &authmocks.MockAuthServiceClient{
+				FindUserByEmailFunc: func(ctx context.Context, req *authpb.FindUserByEmailRequest) (*authpb.FindUserByEmailResponse, error) {
+					return nil, errors.New("unavailable")
+				},
+			},
+			inputBody:            `{"email":"new@example.com","role":1}`,
+			expectedStatusCode:   http.StatusBadGateway,
+			expectedResponseBody: `{"message":"unavailable"}`,
+			userId:               1,
+		},
+		{
+			name:                 "user not authorized",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			mockUsers:            &authmocks.MockAuthServiceClient{},
+			inputBody:            `{"email":"new@example.com","role":1}`,
+			expectedStatusCode:   http.StatusUnauthorized,
+			expectedResponseBody: `{"message":"invalid user ID"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodPost, "/lists/1/invitations", bytes.NewBufferString(tt.inputBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			r.POST("/lists/:id/invitations", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				CreateInvitation(ctx, tt.mockClient, tt.mockUsers)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedResponseBody != "" {
+				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			}
+		})
+	}
+}
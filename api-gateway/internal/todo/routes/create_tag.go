@@ -0,0 +1,41 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type CreateTagInput struct {
+	Name string `json:"name"`
+}
+
+func CreateTag(ctx *gin.Context, client pb.TodoServiceClient) {
+	var req CreateTagInput
+
+	if err := ctx.BindJSON(&req); err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidInputBody)
+		return
+	}
+
+	userID, err := auth.GetUserId(ctx)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusUnauthorized, invalidUserID)
+		return
+	}
+
+	res, err := client.CreateTag(context.Background(), &pb.CreateTagRequest{
+		UserId: userID,
+		Name:   req.Name,
+	})
+
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, &res)
+}
@@ -0,0 +1,77 @@
+package routes
+
+import (
+	"bytes"
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCreateTag(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		inputBody            string
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name: "successfully creating tag",
+			mockClient: &mocks.MockTodoServiceClient{
+				CreateTagFunc: func(ctx context.Context, req *pb.CreateTagRequest) (*pb.CreateTagResponse, error) {
+					return &pb.CreateTagResponse{
+						Status: http.StatusCreated,
+						Tag:    &pb.Tag{Id: 1, Name: req.Name},
+					}, nil
+				},
+			},
+			inputBody:            `{"name":"bug"}`,
+			expectedStatusCode:   http.StatusCreated,
+			expectedResponseBody: `{"tag":{"id":1,"name":"bug"},"status":201}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid body",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			inputBody:            "invalid body",
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"invalid input body"}`,
+			userId:               1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodPost, "/tags", bytes.NewBufferString(tt.inputBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			r.POST("/tags", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				CreateTag(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedResponseBody != "" {
+				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			}
+		})
+	}
+}
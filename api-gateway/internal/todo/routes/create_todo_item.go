@@ -1,13 +1,13 @@
 package routes
 
 import (
-	"context"
 	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
 	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
 	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
 	"github.com/gin-gonic/gin"
 	"net/http"
 	"strconv"
+	"time"
 )
 
 var (
@@ -15,8 +15,16 @@ var (
 )
 
 type CreateTodoItemInput struct {
-	Title       string `json:"title"`
-	Description string `json:"description"`
+	Title           string     `json:"title"`
+	Description     string     `json:"description"`
+	StartAt         *time.Time `json:"start_at"`
+	DueAt           *time.Time `json:"due_at"`
+	TimeZone        string     `json:"time_zone"`
+	Priority        int32      `json:"priority"`
+	ParentId        int64      `json:"parent_id"`
+	Recurrence      string     `json:"recurrence"`
+	RecurrenceCount int32      `json:"recurrence_count"`
+	RecurrenceUntil *time.Time `json:"recurrence_until"`
 }
 
 func CreateTodoItem(ctx *gin.Context, client pb.TodoServiceClient) {
@@ -39,11 +47,19 @@ func CreateTodoItem(ctx *gin.Context, client pb.TodoServiceClient) {
 		return
 	}
 
-	res, err := client.CreateTodoItem(context.Background(), &pb.CreateTodoItemRequest{
-		ListId:      int64(listId),
-		UserId:      userID,
-		Title:       req.Title,
-		Description: req.Description,
+	res, err := client.CreateTodoItem(idempotencyContext(ctx), &pb.CreateTodoItemRequest{
+		ListId:          int64(listId),
+		UserId:          userID,
+		Title:           req.Title,
+		Description:     req.Description,
+		StartAt:         utils.ToTimestamp(req.StartAt),
+		DueAt:           utils.ToTimestamp(req.DueAt),
+		TimeZone:        req.TimeZone,
+		Priority:        pb.Priority(req.Priority),
+		ParentId:        req.ParentId,
+		Recurrence:      req.Recurrence,
+		RecurrenceCount: req.RecurrenceCount,
+		RecurrenceUntil: utils.ToTimestamp(req.RecurrenceUntil),
 	})
 	if err != nil {
 		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
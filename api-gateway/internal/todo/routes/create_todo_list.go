@@ -1,7 +1,6 @@
 package routes
 
 import (
-	"context"
 	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
 	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
 	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
@@ -32,7 +31,7 @@ func CreateTodoList(ctx *gin.Context, client pb.TodoServiceClient) {
 		return
 	}
 
-	res, err := client.CreateTodoList(context.Background(), &pb.CreateTodoListRequest{
+	res, err := client.CreateTodoList(idempotencyContext(ctx), &pb.CreateTodoListRequest{
 		UserId: userID,
 		Title:  req.Title,
 	})
@@ -8,6 +8,7 @@ import (
 	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -24,6 +25,7 @@ func TestCreateTodoList(t *testing.T) {
 		expectedStatusCode   int
 		expectedResponseBody string
 		userId               int64
+		idempotencyKey       string
 	}{
 		{
 			name: "successfully creating todo list",
@@ -54,6 +56,23 @@ func TestCreateTodoList(t *testing.T) {
 			expectedResponseBody: `{"message":"invalid input body"}`,
 			userId:               1,
 		},
+		{
+			name: "forwarding idempotency key",
+			mockClient: &mocks.MockTodoServiceClient{
+				CreateTodoListFunc: func(ctx context.Context, req *pb.CreateTodoListRequest) (*pb.CreateTodoListResponse, error) {
+					md, _ := metadata.FromOutgoingContext(ctx)
+					assert.Equal(t, []string{"retry-me"}, md.Get("idempotency-key"))
+					return &pb.CreateTodoListResponse{
+						Status: http.StatusCreated,
+					}, nil
+				},
+			},
+			inputBody:            `{"title":"test todo list"}`,
+			expectedStatusCode:   http.StatusCreated,
+			expectedResponseBody: `{"status":201}`,
+			userId:               1,
+			idempotencyKey:       "retry-me",
+		},
 	}
 
 	for _, tt := range tests {
@@ -62,6 +81,9 @@ func TestCreateTodoList(t *testing.T) {
 
 			req, _ := http.NewRequest(http.MethodPost, "/list", bytes.NewBufferString(tt.inputBody))
 			req.Header.Set("Content-Type", "application/json")
+			if tt.idempotencyKey != "" {
+				req.Header.Set("Idempotency-Key", tt.idempotencyKey)
+			}
 
 			r.POST("/list", func(ctx *gin.Context) {
 				if tt.userId != 0 {
@@ -0,0 +1,44 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+func DeleteAttachment(ctx *gin.Context, client pb.TodoServiceClient) {
+	userID, err := auth.GetUserId(ctx)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusUnauthorized, invalidUserID)
+		return
+	}
+
+	itemId, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidItemId)
+		return
+	}
+
+	attachmentId, err := strconv.Atoi(ctx.Param("attachmentId"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidAttachmentID)
+		return
+	}
+
+	res, err := client.DeleteAttachment(context.Background(), &pb.DeleteAttachmentRequest{
+		UserId: userID,
+		ItemId: int64(itemId),
+		Id:     int64(attachmentId),
+	})
+
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	ctx.JSON(http.StatusOK, &res)
+}
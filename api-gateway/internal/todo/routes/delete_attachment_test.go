@@ -0,0 +1,99 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDeleteAttachment(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		attachmentId         string
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name:         "successfully deleting an attachment",
+			attachmentId: "7",
+			mockClient: &mocks.MockTodoServiceClient{
+				DeleteAttachmentFunc: func(ctx context.Context, req *pb.DeleteAttachmentRequest) (*pb.DeleteAttachmentResponse, error) {
+					assert.Equal(t, int64(7), req.Id)
+					return &pb.DeleteAttachmentResponse{
+						Success: true,
+						Status:  http.StatusOK,
+					}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"success":true,"status":200}`,
+			userId:               1,
+		},
+		{
+			name:         "attachment not found",
+			attachmentId: "7",
+			mockClient: &mocks.MockTodoServiceClient{
+				DeleteAttachmentFunc: func(ctx context.Context, req *pb.DeleteAttachmentRequest) (*pb.DeleteAttachmentResponse, error) {
+					return &pb.DeleteAttachmentResponse{
+						Status: http.StatusNotFound,
+						Error:  "Attachment not found",
+					}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"status":404,"error":"Attachment not found"}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid attachment id",
+			attachmentId:         "abc",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"invalid attachment id"}`,
+			userId:               1,
+		},
+		{
+			name:                 "unauthorized",
+			attachmentId:         "7",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			expectedStatusCode:   http.StatusUnauthorized,
+			expectedResponseBody: `{"message":"invalid user ID"}`,
+			userId:               0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodDelete, "/items/5/attachments/"+tt.attachmentId, nil)
+
+			r.DELETE("/items/:id/attachments/:attachmentId", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				DeleteAttachment(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedResponseBody != "" {
+				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDeleteComment(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		commentId            string
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name:      "successfully deleting a comment",
+			commentId: "7",
+			mockClient: &mocks.MockTodoServiceClient{
+				DeleteCommentFunc: func(ctx context.Context, req *pb.DeleteCommentRequest) (*pb.DeleteCommentResponse, error) {
+					assert.Equal(t, int64(7), req.Id)
+					return &pb.DeleteCommentResponse{
+						Success: true,
+						Status:  http.StatusOK,
+					}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"success":true,"status":200}`,
+			userId:               1,
+		},
+		{
+			name:      "comment not found",
+			commentId: "7",
+			mockClient: &mocks.MockTodoServiceClient{
+				DeleteCommentFunc: func(ctx context.Context, req *pb.DeleteCommentRequest) (*pb.DeleteCommentResponse, error) {
+					return &pb.DeleteCommentResponse{
+						Status: http.StatusNotFound,
+						Error:  "Comment not found",
+					}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"status":404,"error":"Comment not found"}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid comment id",
+			commentId:            "abc",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"invalid comment id"}`,
+			userId:               1,
+		},
+		{
+			name:                 "unauthorized",
+			commentId:            "7",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			expectedStatusCode:   http.StatusUnauthorized,
+			expectedResponseBody: `{"message":"invalid user ID"}`,
+			userId:               0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodDelete, "/items/5/comments/"+tt.commentId, nil)
+
+			r.DELETE("/items/:id/comments/:commentId", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				DeleteComment(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedResponseBody != "" {
+				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			}
+		})
+	}
+}
@@ -26,9 +26,17 @@ func DeleteTodoItemById(ctx *gin.Context, c pb.TodoServiceClient) {
 		return
 	}
 
+	version, ok := ifMatch(ctx)
+	if !ok {
+		utils.NewErrorResponse(ctx, http.StatusPreconditionFailed, invalidIfMatch)
+		return
+	}
+
 	res, err := c.DeleteTodoItem(ctx, &pb.DeleteTodoItemRequest{
-		UserId: userID,
-		Id:     int64(itemId),
+		UserId:           userID,
+		Id:               int64(itemId),
+		ReparentChildren: ctx.Query("reparent") == "true",
+		Version:          version,
 	})
 
 	if err != nil {
@@ -36,5 +44,5 @@ func DeleteTodoItemById(ctx *gin.Context, c pb.TodoServiceClient) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, &res)
+	versionedJSON(ctx, res.Status, &res)
 }
@@ -21,9 +21,11 @@ func TestDeleteTodoItem(t *testing.T) {
 		name                 string
 		mockClient           *mocks.MockTodoServiceClient
 		inputBody            string
+		query                string
 		expectedStatusCode   int
 		expectedResponseBody string
 		userId               int64
+		ifMatch              string
 	}{
 		{
 			name: "successfully deleting todo item",
@@ -54,14 +56,49 @@ func TestDeleteTodoItem(t *testing.T) {
 			expectedResponseBody: `{"status":502,"error":"invalid input body"}`,
 			userId:               1,
 		},
+		{
+			name: "deleting todo item and keeping its subtasks",
+			mockClient: &mocks.MockTodoServiceClient{
+				DeleteTodoItemFunc: func(ctx context.Context, req *pb.DeleteTodoItemRequest) (*pb.DeleteTodoItemResponse, error) {
+					assert.True(t, req.ReparentChildren)
+					return &pb.DeleteTodoItemResponse{
+						Status:  http.StatusOK,
+						Success: true,
+					}, nil
+				},
+			},
+			query:                "?reparent=true",
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"success":true,"status":200}`,
+			userId:               1,
+		},
+		{
+			name: "deleting stale todo item",
+			mockClient: &mocks.MockTodoServiceClient{
+				DeleteTodoItemFunc: func(ctx context.Context, req *pb.DeleteTodoItemRequest) (*pb.DeleteTodoItemResponse, error) {
+					assert.Equal(t, int64(2), req.Version)
+					return &pb.DeleteTodoItemResponse{
+						Status: http.StatusPreconditionFailed,
+						Error:  "stale",
+					}, nil
+				},
+			},
+			ifMatch:              `"2"`,
+			expectedStatusCode:   http.StatusPreconditionFailed,
+			expectedResponseBody: `{"status":412,"error":"stale"}`,
+			userId:               1,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			r := gin.New()
 
-			req, _ := http.NewRequest(http.MethodDelete, "/todo-item/1", bytes.NewBufferString(tt.inputBody))
+			req, _ := http.NewRequest(http.MethodDelete, "/todo-item/1"+tt.query, bytes.NewBufferString(tt.inputBody))
 			req.Header.Set("Content-Type", "application/json")
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
 
 			r.DELETE("/todo-item/:id", func(ctx *gin.Context) {
 				if tt.userId != 0 {
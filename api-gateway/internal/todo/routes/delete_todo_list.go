@@ -23,9 +23,16 @@ func DeleteTodoList(ctx *gin.Context, client pb.TodoServiceClient) {
 		return
 	}
 
+	version, ok := ifMatch(ctx)
+	if !ok {
+		utils.NewErrorResponse(ctx, http.StatusPreconditionFailed, invalidIfMatch)
+		return
+	}
+
 	res, err := client.DeleteTodoList(context.Background(), &pb.DeleteTodoListRequest{
-		Id:     int64(listId),
-		UserId: userID,
+		Id:      int64(listId),
+		UserId:  userID,
+		Version: version,
 	})
 
 	if err != nil {
@@ -33,5 +40,5 @@ func DeleteTodoList(ctx *gin.Context, client pb.TodoServiceClient) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, &res)
+	versionedJSON(ctx, res.Status, &res)
 }
@@ -0,0 +1,86 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"mime"
+	"net/http"
+	"strconv"
+)
+
+var (
+	invalidAttachmentID = "invalid attachment id"
+)
+
+func DownloadAttachment(ctx *gin.Context, client pb.TodoServiceClient) {
+	userID, err := auth.GetUserId(ctx)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusUnauthorized, invalidUserID)
+		return
+	}
+
+	itemId, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidItemId)
+		return
+	}
+
+	attachmentId, err := strconv.Atoi(ctx.Param("attachmentId"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidAttachmentID)
+		return
+	}
+
+	streamCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.DownloadAttachment(streamCtx, &pb.DownloadAttachmentRequest{
+		UserId: userID,
+		ItemId: int64(itemId),
+		Id:     int64(attachmentId),
+	})
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	res, err := stream.Recv()
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	if res.Status != http.StatusOK {
+		ctx.JSON(http.StatusOK, &res)
+		return
+	}
+
+	attachment := res.Attachment
+	ctx.DataFromReader(http.StatusOK, attachment.Size, attachment.ContentType, &chunkReader[*pb.DownloadAttachmentResponse]{stream: stream}, map[string]string{
+		"Content-Disposition":    mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}),
+		"X-Content-Type-Options": "nosniff",
+	})
+}
+
+// chunkReader reads the chunks of a download stream as one byte stream.
+type chunkReader[Res interface{ GetChunk() []byte }] struct {
+	stream interface{ Recv() (Res, error) }
+	chunk  []byte
+}
+
+func (r *chunkReader[Res]) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		res, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.chunk = res.GetChunk()
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
@@ -0,0 +1,122 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDownloadAttachment(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		attachmentId         string
+		expectedStatusCode   int
+		expectedResponseBody string
+		expectedHeaders      map[string]string
+		userId               int64
+	}{
+		{
+			name:         "successfully downloading an attachment",
+			attachmentId: "3",
+			mockClient: &mocks.MockTodoServiceClient{
+				DownloadAttachmentFunc: func(ctx context.Context, req *pb.DownloadAttachmentRequest) (pb.TodoService_DownloadAttachmentClient, error) {
+					assert.Equal(t, int64(5), req.ItemId)
+					assert.Equal(t, int64(3), req.Id)
+					return &mocks.MockDownloadAttachmentClient{
+						Responses: []*pb.DownloadAttachmentResponse{
+							{
+								Attachment: &pb.Attachment{Id: 3, ItemId: 5, FileName: "release notes.txt", ContentType: "text/plain; charset=utf-8", Size: 11},
+								Status:     http.StatusOK,
+							},
+							{Chunk: []byte("hello ")},
+							{Chunk: []byte("world")},
+						},
+					}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: "hello world",
+			expectedHeaders: map[string]string{
+				"Content-Type":           "text/plain; charset=utf-8",
+				"Content-Length":         "11",
+				"Content-Disposition":    `attachment; filename="release notes.txt"`,
+				"X-Content-Type-Options": "nosniff",
+			},
+			userId: 1,
+		},
+		{
+			name:         "attachment not found",
+			attachmentId: "3",
+			mockClient: &mocks.MockTodoServiceClient{
+				DownloadAttachmentFunc: func(ctx context.Context, req *pb.DownloadAttachmentRequest) (pb.TodoService_DownloadAttachmentClient, error) {
+					return &mocks.MockDownloadAttachmentClient{
+						Responses: []*pb.DownloadAttachmentResponse{
+							{Status: http.StatusNotFound, Error: "Attachment not found"},
+						},
+					}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"status":404,"error":"Attachment not found"}`,
+			userId:               1,
+		},
+		{
+			name:         "stream error",
+			attachmentId: "3",
+			mockClient: &mocks.MockTodoServiceClient{
+				DownloadAttachmentFunc: func(ctx context.Context, req *pb.DownloadAttachmentRequest) (pb.TodoService_DownloadAttachmentClient, error) {
+					return &mocks.MockDownloadAttachmentClient{Err: errors.New("service unavailable")}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusBadGateway,
+			expectedResponseBody: `{"message":"service unavailable"}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid attachment id",
+			attachmentId:         "abc",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"invalid attachment id"}`,
+			userId:               1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodGet, "/items/5/attachments/"+tt.attachmentId, nil)
+
+			r.GET("/items/:id/attachments/:attachmentId", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				DownloadAttachment(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedResponseBody != "" {
+				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			}
+			for header, value := range tt.expectedHeaders {
+				assert.Equal(t, value, w.Header().Get(header))
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package routes
+
+import (
+	"bytes"
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestEditComment(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		commentId            string
+		inputBody            string
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name:      "successfully editing a comment",
+			commentId: "7",
+			mockClient: &mocks.MockTodoServiceClient{
+				EditCommentFunc: func(ctx context.Context, req *pb.EditCommentRequest) (*pb.EditCommentResponse, error) {
+					assert.Equal(t, int64(5), req.ItemId)
+					assert.Equal(t, int64(7), req.Id)
+					return &pb.EditCommentResponse{
+						Comment: &pb.Comment{Id: 7, ItemId: 5, AuthorId: 1, Body: "Deployed", Edited: true},
+						Status:  http.StatusOK,
+					}, nil
+				},
+			},
+			inputBody:            `{"body":"Deployed"}`,
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"comment":{"id":7,"item_id":5,"author_id":1,"body":"Deployed","edited":true},"status":200}`,
+			userId:               1,
+		},
+		{
+			name:      "not the author",
+			commentId: "7",
+			mockClient: &mocks.MockTodoServiceClient{
+				EditCommentFunc: func(ctx context.Context, req *pb.EditCommentRequest) (*pb.EditCommentResponse, error) {
+					return &pb.EditCommentResponse{
+						Status: http.StatusForbidden,
+						Error:  "Only the author can edit a comment",
+					}, nil
+				},
+			},
+			inputBody:            `{"body":"Deployed"}`,
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"status":403,"error":"Only the author can edit a comment"}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid comment id",
+			commentId:            "abc",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			inputBody:            `{"body":"Deployed"}`,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"invalid comment id"}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid input body",
+			commentId:            "7",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			inputBody:            `{"body":1}`,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"invalid input body"}`,
+			userId:               1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodPut, "/items/5/comments/"+tt.commentId, bytes.NewBufferString(tt.inputBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			r.PUT("/items/:id/comments/:commentId", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				EditComment(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedResponseBody != "" {
+				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			}
+		})
+	}
+}
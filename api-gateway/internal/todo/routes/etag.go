@@ -0,0 +1,53 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+var (
+	invalidIfMatch = "If-Match must be a single ETag of the resource"
+)
+
+// setETag tags the response with the version of the resource, which clients
+// send back in If-Match to change only what they have seen.
+func setETag(ctx *gin.Context, version int64) {
+	if version != 0 {
+		ctx.Header("ETag", strconv.Quote(strconv.FormatInt(version, 10)))
+	}
+}
+
+// ifMatch returns the version the If-Match header asks for, zero when there
+// is none or any version will do. Weak, malformed and multiple tags cannot
+// be checked and are reported as not ok.
+func ifMatch(ctx *gin.Context) (int64, bool) {
+	header := strings.TrimSpace(ctx.GetHeader("If-Match"))
+	if header == "" || header == "*" {
+		return 0, true
+	}
+
+	tag, err := strconv.Unquote(header)
+	if err != nil || !strings.HasPrefix(header, `"`) {
+		return 0, false
+	}
+
+	version, err := strconv.ParseInt(tag, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, false
+	}
+
+	return version, true
+}
+
+// versionedJSON writes a response of a change that may have been turned down
+// for a stale version, which is a failed precondition for HTTP as well.
+func versionedJSON(ctx *gin.Context, status int64, res any) {
+	if status == http.StatusPreconditionFailed {
+		ctx.JSON(http.StatusPreconditionFailed, res)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
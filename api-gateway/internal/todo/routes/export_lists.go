@@ -0,0 +1,63 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"mime"
+	"net/http"
+)
+
+var (
+	invalidListIDs = "lists must be a comma-separated list of list ids"
+)
+
+func ExportLists(ctx *gin.Context, client pb.TodoServiceClient) {
+	userID, err := auth.GetUserId(ctx)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusUnauthorized, invalidUserID)
+		return
+	}
+
+	listIds, err := parseIds(ctx.Query("lists"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidListIDs)
+		return
+	}
+
+	exportLists(ctx, client, &pb.ExportListsRequest{
+		UserId:  userID,
+		Format:  ctx.DefaultQuery("format", "json"),
+		ListIds: listIds,
+	}, "attachment")
+}
+
+// exportLists streams the export to the client, as a download or inline
+// depending on disposition.
+func exportLists(ctx *gin.Context, client pb.TodoServiceClient, in *pb.ExportListsRequest, disposition string) {
+	streamCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.ExportLists(streamCtx, in)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	res, err := stream.Recv()
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	if res.Status != http.StatusOK {
+		ctx.JSON(http.StatusOK, &res)
+		return
+	}
+
+	ctx.DataFromReader(http.StatusOK, -1, res.ContentType, &chunkReader[*pb.ExportListsResponse]{stream: stream}, map[string]string{
+		"Content-Disposition": mime.FormatMediaType(disposition, map[string]string{"filename": res.FileName}),
+	})
+}
@@ -0,0 +1,139 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestExportLists(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		query                string
+		expectedStatusCode   int
+		expectedResponseBody string
+		expectedHeaders      map[string]string
+		userId               int64
+	}{
+		{
+			name:  "successfully exporting lists",
+			query: "?format=csv&lists=2,3",
+			mockClient: &mocks.MockTodoServiceClient{
+				ExportListsFunc: func(ctx context.Context, req *pb.ExportListsRequest) (pb.TodoService_ExportListsClient, error) {
+					assert.Equal(t, int64(1), req.UserId)
+					assert.Equal(t, "csv", req.Format)
+					assert.Equal(t, []int64{2, 3}, req.ListIds)
+					return &mocks.MockExportListsClient{
+						Responses: []*pb.ExportListsResponse{
+							{ContentType: "text/csv; charset=utf-8", FileName: "lists.csv", Status: http.StatusOK},
+							{Chunk: []byte("list,depth,title\n")},
+							{Chunk: []byte("Groceries,1,Milk\n")},
+						},
+					}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: "list,depth,title\nGroceries,1,Milk",
+			expectedHeaders: map[string]string{
+				"Content-Type":        "text/csv; charset=utf-8",
+				"Content-Disposition": `attachment; filename=lists.csv`,
+			},
+			userId: 1,
+		},
+		{
+			name: "defaulting to json",
+			mockClient: &mocks.MockTodoServiceClient{
+				ExportListsFunc: func(ctx context.Context, req *pb.ExportListsRequest) (pb.TodoService_ExportListsClient, error) {
+					assert.Equal(t, "json", req.Format)
+					assert.Empty(t, req.ListIds)
+					return &mocks.MockExportListsClient{
+						Responses: []*pb.ExportListsResponse{
+							{ContentType: "application/json", FileName: "lists.json", Status: http.StatusOK},
+							{Chunk: []byte(`{"lists":[]}`)},
+						},
+					}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"lists":[]}`,
+			userId:               1,
+		},
+		{
+			name:  "unknown format",
+			query: "?format=xml",
+			mockClient: &mocks.MockTodoServiceClient{
+				ExportListsFunc: func(ctx context.Context, req *pb.ExportListsRequest) (pb.TodoService_ExportListsClient, error) {
+					return &mocks.MockExportListsClient{
+						Responses: []*pb.ExportListsResponse{
+							{Status: http.StatusBadRequest, Error: "Unknown format, use json, csv or markdown"},
+						},
+					}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"status":400,"error":"Unknown format, use json, csv or markdown"}`,
+			userId:               1,
+		},
+		{
+			name:  "stream error",
+			query: "?format=json",
+			mockClient: &mocks.MockTodoServiceClient{
+				ExportListsFunc: func(ctx context.Context, req *pb.ExportListsRequest) (pb.TodoService_ExportListsClient, error) {
+					return &mocks.MockExportListsClient{Err: errors.New("service unavailable")}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusBadGateway,
+			expectedResponseBody: `{"message":"service unavailable"}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid list ids",
+			query:                "?lists=2,abc",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"lists must be a comma-separated list of list ids"}`,
+			userId:               1,
+		},
+		{
+			name:                 "unauthorized",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			expectedStatusCode:   http.StatusUnauthorized,
+			expectedResponseBody: `{"message":"invalid user ID"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodGet, "/export"+tt.query, nil)
+
+			r.GET("/export", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				ExportLists(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			for header, value := range tt.expectedHeaders {
+				assert.Equal(t, value, w.Header().Get(header))
+			}
+		})
+	}
+}
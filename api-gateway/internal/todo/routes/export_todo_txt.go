@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+// ExportTodoTxt serves the list in the path as a todo.txt file, which
+// SyncTodoTxt takes back once edited.
+func ExportTodoTxt(ctx *gin.Context, client pb.TodoServiceClient) {
+	userID, err := auth.GetUserId(ctx)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusUnauthorized, invalidUserID)
+		return
+	}
+
+	listId, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidListID)
+		return
+	}
+
+	exportLists(ctx, client, &pb.ExportListsRequest{
+		UserId:  userID,
+		Format:  "todotxt",
+		ListIds: []int64{int64(listId)},
+	}, "inline")
+}
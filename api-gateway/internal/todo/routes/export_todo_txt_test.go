@@ -0,0 +1,108 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestExportTodoTxt(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		listId               string
+		expectedStatusCode   int
+		expectedResponseBody string
+		expectedHeaders      map[string]string
+		userId               int64
+	}{
+		{
+			name:   "successfully exporting a list",
+			listId: "2",
+			mockClient: &mocks.MockTodoServiceClient{
+				ExportListsFunc: func(ctx context.Context, req *pb.ExportListsRequest) (pb.TodoService_ExportListsClient, error) {
+					assert.Equal(t, int64(1), req.UserId)
+					assert.Equal(t, "todotxt", req.Format)
+					assert.Equal(t, []int64{2}, req.ListIds)
+					return &mocks.MockExportListsClient{
+						Responses: []*pb.ExportListsResponse{
+							{ContentType: "text/plain; charset=utf-8", FileName: "lists.txt", Status: http.StatusOK},
+							{Chunk: []byte("(A) Milk +Groceries @store id:5\n")},
+						},
+					}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: "(A) Milk +Groceries @store id:5",
+			expectedHeaders: map[string]string{
+				"Content-Type":        "text/plain; charset=utf-8",
+				"Content-Disposition": `inline; filename=lists.txt`,
+			},
+			userId: 1,
+		},
+		{
+			name:   "forbidden",
+			listId: "2",
+			mockClient: &mocks.MockTodoServiceClient{
+				ExportListsFunc: func(ctx context.Context, req *pb.ExportListsRequest) (pb.TodoService_ExportListsClient, error) {
+					return &mocks.MockExportListsClient{
+						Responses: []*pb.ExportListsResponse{
+							{Status: http.StatusForbidden, Error: "You have no access to this list"},
+						},
+					}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"status":403,"error":"You have no access to this list"}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid list id",
+			listId:               "abc",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"invalid list id"}`,
+			userId:               1,
+		},
+		{
+			name:                 "unauthorized",
+			listId:               "2",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			expectedStatusCode:   http.StatusUnauthorized,
+			expectedResponseBody: `{"message":"invalid user ID"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodGet, "/lists/"+tt.listId+"/todo.txt", nil)
+
+			r.GET("/lists/:id/todo.txt", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				ExportTodoTxt(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			for header, value := range tt.expectedHeaders {
+				assert.Equal(t, value, w.Header().Get(header))
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package routes
+
+import (
+	"context"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strings"
+)
+
+// GetCalendar serves the calendar feed of the token in the path, with or
+// without an .ics extension. Calendar apps only understand HTTP statuses, so
+// failures are reported with the status of the response.
+func GetCalendar(ctx *gin.Context, client pb.TodoServiceClient) {
+	token := strings.TrimSuffix(ctx.Param("token"), ".ics")
+
+	res, err := client.GetCalendar(context.Background(), &pb.GetCalendarRequest{
+		Token: token,
+	})
+
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	if res.Status != http.StatusOK {
+		utils.NewErrorResponse(ctx, int(res.Status), res.Error)
+		return
+	}
+
+	ctx.Header("Cache-Control", "private, max-age=300")
+	ctx.Data(http.StatusOK, res.ContentType, res.Content)
+}
@@ -0,0 +1,84 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGetCalendarFeed(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name: "successfully getting the feed",
+			mockClient: &mocks.MockTodoServiceClient{
+				GetCalendarFeedFunc: func(ctx context.Context, req *pb.GetCalendarFeedRequest) (*pb.GetCalendarFeedResponse, error) {
+					assert.Equal(t, int64(1), req.UserId)
+					return &pb.GetCalendarFeedResponse{
+						Token:  "secret",
+						Status: http.StatusOK,
+					}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"token":"secret","status":200}`,
+			userId:               1,
+		},
+		{
+			name: "service error",
+			mockClient: &mocks.MockTodoServiceClient{
+				GetCalendarFeedFunc: func(ctx context.Context, req *pb.GetCalendarFeedRequest) (*pb.GetCalendarFeedResponse, error) {
+					return nil, errors.New("service unavailable")
+				},
+			},
+			expectedStatusCode:   http.StatusBadGateway,
+			expectedResponseBody: `{"message":"service unavailable"}`,
+			userId:               1,
+		},
+		{
+			name:                 "missing user id",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			expectedStatusCode:   http.StatusUnauthorized,
+			expectedResponseBody: `{"message":"invalid user ID"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodGet, "/calendar", nil)
+
+			r.GET("/calendar", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				GetCalendarFeed(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedResponseBody != "" {
+				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			}
+		})
+	}
+}
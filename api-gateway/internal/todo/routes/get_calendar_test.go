@@ -0,0 +1,113 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGetCalendar(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	calendar := "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nEND:VCALENDAR\r\n"
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		path                 string
+		expectedStatusCode   int
+		expectedResponseBody string
+		expectedContentType  string
+	}{
+		{
+			name: "successfully getting the calendar",
+			path: "/calendar/secret.ics",
+			mockClient: &mocks.MockTodoServiceClient{
+				GetCalendarFunc: func(ctx context.Context, req *pb.GetCalendarRequest) (*pb.GetCalendarResponse, error) {
+					assert.Equal(t, "secret", req.Token)
+					return &pb.GetCalendarResponse{
+						ContentType: "text/calendar; charset=utf-8",
+						Content:     []byte(calendar),
+						Status:      http.StatusOK,
+					}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: calendar,
+			expectedContentType:  "text/calendar; charset=utf-8",
+		},
+		{
+			name: "without extension",
+			path: "/calendar/secret",
+			mockClient: &mocks.MockTodoServiceClient{
+				GetCalendarFunc: func(ctx context.Context, req *pb.GetCalendarRequest) (*pb.GetCalendarResponse, error) {
+					assert.Equal(t, "secret", req.Token)
+					return &pb.GetCalendarResponse{
+						ContentType: "text/calendar; charset=utf-8",
+						Content:     []byte(calendar),
+						Status:      http.StatusOK,
+					}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: calendar,
+		},
+		{
+			name: "unknown token",
+			path: "/calendar/guess.ics",
+			mockClient: &mocks.MockTodoServiceClient{
+				GetCalendarFunc: func(ctx context.Context, req *pb.GetCalendarRequest) (*pb.GetCalendarResponse, error) {
+					return &pb.GetCalendarResponse{
+						Status: http.StatusNotFound,
+						Error:  "Calendar feed not found",
+					}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusNotFound,
+			expectedResponseBody: `{"message":"Calendar feed not found"}`,
+		},
+		{
+			name: "service error",
+			path: "/calendar/secret.ics",
+			mockClient: &mocks.MockTodoServiceClient{
+				GetCalendarFunc: func(ctx context.Context, req *pb.GetCalendarRequest) (*pb.GetCalendarResponse, error) {
+					return nil, errors.New("service unavailable")
+				},
+			},
+			expectedStatusCode:   http.StatusBadGateway,
+			expectedResponseBody: `{"message":"service unavailable"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+
+			r.GET("/calendar/:token", func(ctx *gin.Context) {
+				GetCalendar(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			if tt.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, tt.expectedResponseBody, w.Body.String())
+			} else {
+				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			}
+			if tt.expectedContentType != "" {
+				assert.Equal(t, tt.expectedContentType, w.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+var (
+	invalidDueDate = "due_before and due_after must be RFC 3339 timestamps"
+)
+
+func GetDueItems(ctx *gin.Context, client pb.TodoServiceClient) {
+	userID, err := auth.GetUserId(ctx)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusUnauthorized, invalidUserID)
+		return
+	}
+
+	dueBefore, err := utils.ParseTimestamp(ctx.Query("due_before"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidDueDate)
+		return
+	}
+
+	dueAfter, err := utils.ParseTimestamp(ctx.Query("due_after"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidDueDate)
+		return
+	}
+
+	res, err := client.GetDueItems(context.Background(), &pb.GetDueItemsRequest{
+		UserId:           userID,
+		DueBefore:        dueBefore,
+		DueAfter:         dueAfter,
+		IncludeCompleted: ctx.Query("include_completed") == "true",
+	})
+
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	ctx.JSON(http.StatusOK, &res)
+}
@@ -0,0 +1,77 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetDueItems(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		query                string
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name: "successfully getting due items",
+			mockClient: &mocks.MockTodoServiceClient{
+				GetDueItemsFunc: func(ctx context.Context, req *pb.GetDueItemsRequest) (*pb.GetDueItemsResponse, error) {
+					assert.Equal(t, time.Date(2024, 10, 8, 0, 0, 0, 0, time.UTC).Unix(), req.DueBefore.Seconds)
+					assert.Equal(t, time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC).Unix(), req.DueAfter.Seconds)
+					return &pb.GetDueItemsResponse{
+						Status: http.StatusOK,
+					}, nil
+				},
+			},
+			query:                "?due_before=2024-10-08T03:00:00%2B03:00&due_after=2024-10-01T00:00:00Z",
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"status":200}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid due date",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			query:                "?due_before=tomorrow",
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"due_before and due_after must be RFC 3339 timestamps"}`,
+			userId:               1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodGet, "/items"+tt.query, nil)
+
+			r.GET("/items", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				GetDueItems(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedResponseBody != "" {
+				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			}
+		})
+	}
+}
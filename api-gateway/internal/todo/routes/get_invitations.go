@@ -0,0 +1,69 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	authpb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth/pb"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+func GetInvitations(ctx *gin.Context, client pb.TodoServiceClient, users authpb.AuthServiceClient) {
+	userID, err := auth.GetUserId(ctx)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusUnauthorized, invalidUserID)
+		return
+	}
+
+	res, err := client.GetInvitations(context.Background(), &pb.GetInvitationsRequest{
+		UserId: userID,
+	})
+
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	if err := fillInviterEmails(users, res.Invitations); err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	ctx.JSON(http.StatusOK, &res)
+}
+
+// fillInviterEmails looks up who sent the invitations, todo-svc only knows
+// their ids.
+func fillInviterEmails(users authpb.AuthServiceClient, invitations []*pb.Invitation) error {
+	if len(invitations) == 0 {
+		return nil
+	}
+
+	seen := make(map[int64]bool)
+	var ids []int64
+	for _, invitation := range invitations {
+		if !seen[invitation.InviterId] {
+			seen[invitation.InviterId] = true
+			ids = append(ids, invitation.InviterId)
+		}
+	}
+
+	res, err := users.BatchGetUsers(context.Background(), &authpb.BatchGetUsersRequest{
+		Ids: ids,
+	})
+	if err != nil {
+		return err
+	}
+
+	emails := make(map[int64]string, len(res.Users))
+	for _, user := range res.Users {
+		emails[user.Id] = user.Email
+	}
+	for _, invitation := range invitations {
+		invitation.InviterEmail = emails[invitation.InviterId]
+	}
+
+	return nil
+}
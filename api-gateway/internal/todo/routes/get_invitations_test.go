@@ -0,0 +1,96 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	authpb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth/pb"
+	authmocks "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth/routes/mocks"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGetInvitations(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		mockUsers            *authmocks.MockAuthServiceClient
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name: "successfully getting invitations",
+			mockClient: &mocks.MockTodoServiceClient{
+				GetInvitationsFunc: func(ctx context.Context, in *pb.GetInvitationsRequest) (*pb.GetInvitationsResponse, error) {
+					return &pb.GetInvitationsResponse{
+						Invitations: []*pb.Invitation{
+							{Id: 1, ListId: 1, InviterId: 3},
+							{Id: 2, ListId: 2, InviterId: 3},
+						},
+						Status: http.StatusOK,
+					}, nil
+				},
+			},
+			mockUsers: &authmocks.MockAuthServiceClient{
+				BatchGetUsersFunc: func(ctx context.Context, req *authpb.BatchGetUsersRequest) (*authpb.BatchGetUsersResponse, error) {
+					assert.Equal(t, []int64{3}, req.Ids)
+					return &authpb.BatchGetUsersResponse{Users: []*authpb.User{{Id: 3, Email: "owner@example.com"}}, Status: http.StatusOK}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"invitations":[{"id":1,"list_id":1,"inviter_id":3,"inviter_email":"owner@example.com"},{"id":2,"list_id":2,"inviter_id":3,"inviter_email":"owner@example.com"}],"status":200}`,
+			userId:               1,
+		},
+		{
+			name: "no invitations",
+			mockClient: &mocks.MockTodoServiceClient{
+				GetInvitationsFunc: func(ctx context.Context, in *pb.GetInvitationsRequest) (*pb.GetInvitationsResponse, error) {
+					return &pb.GetInvitationsResponse{Status: http.StatusOK}, nil
+				},
+			},
+			mockUsers:            &authmocks.MockAuthServiceClient{},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"status":200}`,
+			userId:               1,
+		},
+		{
+			name:                 "user not authorized",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			mockUsers:            &authmocks.MockAuthServiceClient{},
+			expectedStatusCode:   http.StatusUnauthorized,
+			expectedResponseBody: `{"message":"invalid user ID"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodGet, "/invitations", nil)
+
+			r.GET("/invitations", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				GetInvitations(ctx, tt.mockClient, tt.mockUsers)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedResponseBody != "" {
+				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			}
+		})
+	}
+}
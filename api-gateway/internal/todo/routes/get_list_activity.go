@@ -0,0 +1,45 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+func GetListActivity(ctx *gin.Context, client pb.TodoServiceClient) {
+	userID, err := auth.GetUserId(ctx)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusUnauthorized, invalidUserID)
+		return
+	}
+
+	listId, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidListID)
+		return
+	}
+
+	pageSize, err := parseLimit(ctx.Query("page_size"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidPageSize)
+		return
+	}
+
+	res, err := client.GetListActivity(context.Background(), &pb.GetListActivityRequest{
+		UserId:    userID,
+		ListId:    int64(listId),
+		PageSize:  pageSize,
+		PageToken: ctx.Query("page_token"),
+	})
+
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	ctx.JSON(http.StatusOK, &res)
+}
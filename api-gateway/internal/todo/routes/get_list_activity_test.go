@@ -0,0 +1,122 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGetListActivity(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		listId               string
+		query                string
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name:   "successfully getting the activity of a list",
+			listId: "2",
+			mockClient: &mocks.MockTodoServiceClient{
+				GetListActivityFunc: func(ctx context.Context, req *pb.GetListActivityRequest) (*pb.GetListActivityResponse, error) {
+					assert.Equal(t, int64(1), req.UserId)
+					assert.Equal(t, int64(2), req.ListId)
+					assert.Equal(t, int32(10), req.PageSize)
+					assert.Equal(t, "eyJpZCI6MTB9", req.PageToken)
+					return &pb.GetListActivityResponse{
+						Activities: []*pb.Activity{{
+							Id:         9,
+							ListId:     2,
+							ActorId:    1,
+							Action:     "created",
+							TargetType: "item",
+							TargetId:   5,
+							After:      `{"id":5}`,
+						}},
+						Status:     http.StatusOK,
+						TotalCount: 9,
+					}, nil
+				},
+			},
+			query:                "?page_size=10&page_token=eyJpZCI6MTB9",
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"activities":[{"id":9,"list_id":2,"actor_id":1,"action":"created","target_type":"item","target_id":5,"after":"{\"id\":5}"}],"status":200,"total_count":9}`,
+			userId:               1,
+		},
+		{
+			name:   "not a member",
+			listId: "2",
+			mockClient: &mocks.MockTodoServiceClient{
+				GetListActivityFunc: func(ctx context.Context, req *pb.GetListActivityRequest) (*pb.GetListActivityResponse, error) {
+					return &pb.GetListActivityResponse{
+						Status: http.StatusForbidden,
+						Error:  "You don't have access to this list",
+					}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"status":403,"error":"You don't have access to this list"}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid list id",
+			listId:               "abc",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"invalid list id"}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid page size",
+			listId:               "2",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			query:                "?page_size=0",
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"page_size must be a positive integer"}`,
+			userId:               1,
+		},
+		{
+			name:                 "unauthorized",
+			listId:               "2",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			expectedStatusCode:   http.StatusUnauthorized,
+			expectedResponseBody: `{"message":"invalid user ID"}`,
+			userId:               0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodGet, "/lists/"+tt.listId+"/activity"+tt.query, nil)
+
+			r.GET("/lists/:id/activity", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				GetListActivity(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedResponseBody != "" {
+				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			}
+		})
+	}
+}
@@ -33,5 +33,6 @@ func GetTodoItemById(ctx *gin.Context, client pb.TodoServiceClient) {
 		return
 	}
 
+	setETag(ctx, res.GetItem().GetVersion())
 	ctx.JSON(http.StatusOK, &res)
 }
@@ -22,6 +22,7 @@ func TestGetTodoItemById(t *testing.T) {
 		expectedStatusCode   int
 		expectedResponseBody string
 		userId               int64
+		expectedETag         string
 	}{
 		{
 			name: "successfully getting todo item by id",
@@ -50,6 +51,20 @@ func TestGetTodoItemById(t *testing.T) {
 			expectedResponseBody: `{"status":502,"error":"invalid input body"}`,
 			userId:               1,
 		},
+		{
+			name: "tagging todo item with its version",
+			mockClient: &mocks.MockTodoServiceClient{
+				GetTodoItemByIdFunc: func(ctx context.Context, req *pb.GetTodoItemRequest) (*pb.GetTodoItemResponse, error) {
+					return &pb.GetTodoItemResponse{
+						Status: http.StatusOK,
+						Item:   &pb.TodoItem{Id: 1, Version: 7},
+					}, nil
+				},
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedETag:       `"7"`,
+			userId:             1,
+		},
 	}
 
 	for _, tt := range tests {
@@ -70,6 +85,7 @@ func TestGetTodoItemById(t *testing.T) {
 			r.ServeHTTP(w, req)
 
 			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			assert.Equal(t, tt.expectedETag, w.Header().Get("ETag"))
 
 			if tt.expectedResponseBody != "" {
 				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
@@ -8,6 +8,20 @@ import (
 	"github.com/gin-gonic/gin"
 	"net/http"
 	"strconv"
+	"strings"
+)
+
+var (
+	invalidSort = "sort must be one of manual, priority, created, due, title, optionally prefixed with '-'"
+	invalidDone = "done must be true or false"
+
+	itemSorts = map[string]pb.ItemSort{
+		"manual":   pb.ItemSort_ITEM_SORT_MANUAL,
+		"priority": pb.ItemSort_ITEM_SORT_PRIORITY,
+		"created":  pb.ItemSort_ITEM_SORT_CREATED,
+		"due":      pb.ItemSort_ITEM_SORT_DUE,
+		"title":    pb.ItemSort_ITEM_SORT_TITLE,
+	}
 )
 
 func GetTodoItems(ctx *gin.Context, client pb.TodoServiceClient) {
@@ -23,9 +37,40 @@ func GetTodoItems(ctx *gin.Context, client pb.TodoServiceClient) {
 		return
 	}
 
+	sort, descending, ok := parseItemSort(ctx.Query("sort"))
+	if !ok {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidSort)
+		return
+	}
+
+	tagIds, err := parseIds(ctx.Query("tags"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidTagIDs)
+		return
+	}
+
+	pageSize, err := parseLimit(ctx.Query("page_size"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidPageSize)
+		return
+	}
+
+	completion, err := parseCompletion(ctx.Query("done"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidDone)
+		return
+	}
+
 	res, err := client.GetTodoItems(context.Background(), &pb.GetTodoItemsRequest{
-		UserId: userID,
-		ListId: int64(listId),
+		UserId:     userID,
+		ListId:     int64(listId),
+		Sort:       sort,
+		Descending: descending,
+		TagIds:     tagIds,
+		PageSize:   pageSize,
+		PageToken:  ctx.Query("page_token"),
+		Title:      ctx.Query("title"),
+		Completion: completion,
 	})
 
 	if err != nil {
@@ -35,3 +80,34 @@ func GetTodoItems(ctx *gin.Context, client pb.TodoServiceClient) {
 
 	ctx.JSON(http.StatusOK, &res)
 }
+
+// parseItemSort parses a sort spec such as "priority" or "-due", where the
+// leading minus requests descending order. An empty spec means manual order.
+func parseItemSort(value string) (pb.ItemSort, bool, bool) {
+	descending := strings.HasPrefix(value, "-")
+	value = strings.TrimPrefix(value, "-")
+	if value == "" {
+		return pb.ItemSort_ITEM_SORT_MANUAL, descending, true
+	}
+
+	sort, ok := itemSorts[value]
+	return sort, descending, ok
+}
+
+// parseCompletion parses the optional done query parameter, without it both
+// done and undone items are returned.
+func parseCompletion(value string) (pb.CompletionFilter, error) {
+	if value == "" {
+		return pb.CompletionFilter_COMPLETION_FILTER_ALL, nil
+	}
+
+	done, err := strconv.ParseBool(value)
+	if err != nil {
+		return pb.CompletionFilter_COMPLETION_FILTER_ALL, err
+	}
+
+	if done {
+		return pb.CompletionFilter_COMPLETION_FILTER_DONE, nil
+	}
+	return pb.CompletionFilter_COMPLETION_FILTER_UNDONE, nil
+}
@@ -19,6 +19,7 @@ func TestGetTodoItems(t *testing.T) {
 	tests := []struct {
 		name                 string
 		mockClient           *mocks.MockTodoServiceClient
+		query                string
 		expectedStatusCode   int
 		expectedResponseBody string
 		userId               int64
@@ -50,13 +51,95 @@ func TestGetTodoItems(t *testing.T) {
 			expectedResponseBody: `{"status":502,"error":"invalid input body"}`,
 			userId:               1,
 		},
+		{
+			name: "sorting todo items by priority",
+			mockClient: &mocks.MockTodoServiceClient{
+				GetTodoItemsFunc: func(ctx context.Context, req *pb.GetTodoItemsRequest) (*pb.GetTodoItemsResponse, error) {
+					assert.Equal(t, pb.ItemSort_ITEM_SORT_PRIORITY, req.Sort)
+					assert.True(t, req.Descending)
+					return &pb.GetTodoItemsResponse{
+						Status: http.StatusOK,
+					}, nil
+				},
+			},
+			query:                "?sort=-priority",
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"status":200}`,
+			userId:               1,
+		},
+		{
+			name:                 "unknown sort",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			query:                "?sort=color",
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"sort must be one of manual, priority, created, due, title, optionally prefixed with '-'"}`,
+			userId:               1,
+		},
+		{
+			name: "filtering todo items by tags",
+			mockClient: &mocks.MockTodoServiceClient{
+				GetTodoItemsFunc: func(ctx context.Context, req *pb.GetTodoItemsRequest) (*pb.GetTodoItemsResponse, error) {
+					assert.Equal(t, []int64{3, 4}, req.TagIds)
+					return &pb.GetTodoItemsResponse{
+						Status: http.StatusOK,
+					}, nil
+				},
+			},
+			query:                "?tags=3,4",
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"status":200}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid tag filter",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			query:                "?tags=3,bug",
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"tags must be a comma-separated list of tag ids"}`,
+			userId:               1,
+		},
+		{
+			name: "paging undone todo items",
+			mockClient: &mocks.MockTodoServiceClient{
+				GetTodoItemsFunc: func(ctx context.Context, req *pb.GetTodoItemsRequest) (*pb.GetTodoItemsResponse, error) {
+					assert.Equal(t, int32(10), req.PageSize)
+					assert.Equal(t, "abc", req.PageToken)
+					assert.Equal(t, "milk", req.Title)
+					assert.Equal(t, pb.CompletionFilter_COMPLETION_FILTER_UNDONE, req.Completion)
+					return &pb.GetTodoItemsResponse{
+						Status:     http.StatusOK,
+						TotalCount: 3,
+					}, nil
+				},
+			},
+			query:                "?page_size=10&page_token=abc&title=milk&done=false",
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"status":200,"total_count":3}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid done filter",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			query:                "?done=maybe",
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"done must be true or false"}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid page size",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			query:                "?page_size=-5",
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"page_size must be a positive integer"}`,
+			userId:               1,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			r := gin.New()
 
-			req, _ := http.NewRequest(http.MethodGet, "/items/1", nil)
+			req, _ := http.NewRequest(http.MethodGet, "/items/1"+tt.query, nil)
 			req.Header.Set("Content-Type", "application/json")
 
 			r.GET("/items/:id", func(ctx *gin.Context) {
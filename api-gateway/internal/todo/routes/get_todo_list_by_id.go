@@ -33,5 +33,6 @@ func GetTodoListById(ctx *gin.Context, client pb.TodoServiceClient) {
 		return
 	}
 
+	setETag(ctx, res.GetList().GetVersion())
 	ctx.JSON(http.StatusOK, &res)
 }
@@ -7,6 +7,21 @@ import (
 	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
 	"github.com/gin-gonic/gin"
 	"net/http"
+	"strconv"
+	"strings"
+)
+
+var (
+	invalidTagIDs   = "tags must be a comma-separated list of tag ids"
+	invalidPageSize = "page_size must be a positive integer"
+	invalidArchived = "archived must be true or false"
+	invalidListSort = "sort must be one of created, manual"
+
+	listSorts = map[string]pb.ListSort{
+		"":        pb.ListSort_LIST_SORT_CREATED,
+		"created": pb.ListSort_LIST_SORT_CREATED,
+		"manual":  pb.ListSort_LIST_SORT_MANUAL,
+	}
 )
 
 func GetTodoLists(ctx *gin.Context, client pb.TodoServiceClient) {
@@ -16,8 +31,40 @@ func GetTodoLists(ctx *gin.Context, client pb.TodoServiceClient) {
 		return
 	}
 
+	tagIds, err := parseIds(ctx.Query("tags"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidTagIDs)
+		return
+	}
+
+	pageSize, err := parseLimit(ctx.Query("page_size"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidPageSize)
+		return
+	}
+
+	sort, ok := listSorts[ctx.Query("sort")]
+	if !ok {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidListSort)
+		return
+	}
+
+	includeArchived := false
+	if value := ctx.Query("archived"); value != "" {
+		if includeArchived, err = strconv.ParseBool(value); err != nil {
+			utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidArchived)
+			return
+		}
+	}
+
 	res, err := client.GetTodoLists(context.Background(), &pb.GetTodoListsRequest{
-		UserId: userID,
+		UserId:          userID,
+		TagIds:          tagIds,
+		PageSize:        pageSize,
+		PageToken:       ctx.Query("page_token"),
+		Title:           ctx.Query("title"),
+		IncludeArchived: includeArchived,
+		Sort:            sort,
 	})
 
 	if err != nil {
@@ -27,3 +74,21 @@ func GetTodoLists(ctx *gin.Context, client pb.TodoServiceClient) {
 
 	ctx.JSON(http.StatusOK, &res)
 }
+
+// parseIds parses the comma-separated ids of a query parameter.
+func parseIds(value string) ([]int64, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	var ids []int64
+	for _, part := range strings.Split(value, ",") {
+		id, err := strconv.ParseInt(strings.TrimSpace(part), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
@@ -18,6 +18,7 @@ func TestGetTodoLists(t *testing.T) {
 	tests := []struct {
 		name                 string
 		mockClient           *mocks.MockTodoServiceClient
+		query                string
 		expectedStatusCode   int
 		expectedResponseBody string
 		userId               int64
@@ -49,13 +50,86 @@ func TestGetTodoLists(t *testing.T) {
 			expectedResponseBody: `{"status":502,"error":"invalid input body"}`,
 			userId:               1,
 		},
+		{
+			name: "paging and filtering todo lists",
+			mockClient: &mocks.MockTodoServiceClient{
+				GetTodoListsFunc: func(ctx context.Context, req *pb.GetTodoListsRequest) (*pb.GetTodoListsResponse, error) {
+					assert.Equal(t, int32(20), req.PageSize)
+					assert.Equal(t, "eyJpZCI6MjB9", req.PageToken)
+					assert.Equal(t, "work", req.Title)
+					return &pb.GetTodoListsResponse{
+						Status:        http.StatusOK,
+						NextPageToken: "eyJpZCI6NDB9",
+						TotalCount:    45,
+					}, nil
+				},
+			},
+			query:                "?page_size=20&page_token=eyJpZCI6MjB9&title=work",
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"status":200,"next_page_token":"eyJpZCI6NDB9","total_count":45}`,
+			userId:               1,
+		},
+		{
+			name: "including archived todo lists",
+			mockClient: &mocks.MockTodoServiceClient{
+				GetTodoListsFunc: func(ctx context.Context, req *pb.GetTodoListsRequest) (*pb.GetTodoListsResponse, error) {
+					assert.True(t, req.IncludeArchived)
+					return &pb.GetTodoListsResponse{
+						Status: http.StatusOK,
+					}, nil
+				},
+			},
+			query:                "?archived=true",
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"status":200}`,
+			userId:               1,
+		},
+		{
+			name: "sorting todo lists manually",
+			mockClient: &mocks.MockTodoServiceClient{
+				GetTodoListsFunc: func(ctx context.Context, req *pb.GetTodoListsRequest) (*pb.GetTodoListsResponse, error) {
+					assert.Equal(t, pb.ListSort_LIST_SORT_MANUAL, req.Sort)
+					return &pb.GetTodoListsResponse{
+						Status: http.StatusOK,
+					}, nil
+				},
+			},
+			query:                "?sort=manual",
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"status":200}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid sort",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			query:                "?sort=title",
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"sort must be one of created, manual"}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid archived filter",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			query:                "?archived=maybe",
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"archived must be true or false"}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid page size",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			query:                "?page_size=0",
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"page_size must be a positive integer"}`,
+			userId:               1,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			r := gin.New()
 
-			req, _ := http.NewRequest(http.MethodGet, "/lists", nil)
+			req, _ := http.NewRequest(http.MethodGet, "/lists"+tt.query, nil)
 			req.Header.Set("Content-Type", "application/json")
 
 			r.GET("/lists", func(ctx *gin.Context) {
@@ -0,0 +1,63 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+var (
+	invalidLimit = "limit must be a positive integer"
+)
+
+func GetUpcomingOccurrences(ctx *gin.Context, client pb.TodoServiceClient) {
+	userID, err := auth.GetUserId(ctx)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusUnauthorized, invalidUserID)
+		return
+	}
+
+	itemId, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidItemId)
+		return
+	}
+
+	limit, err := parseLimit(ctx.Query("limit"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidLimit)
+		return
+	}
+
+	res, err := client.GetUpcomingOccurrences(context.Background(), &pb.GetUpcomingOccurrencesRequest{
+		UserId: userID,
+		ItemId: int64(itemId),
+		Limit:  limit,
+	})
+
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	ctx.JSON(http.StatusOK, &res)
+}
+
+// parseLimit parses an optional positive limit, zero leaves the default to
+// the todo service.
+func parseLimit(value string) (int32, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	limit, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || limit <= 0 {
+		return 0, strconv.ErrSyntax
+	}
+
+	return int32(limit), nil
+}
@@ -0,0 +1,90 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGetUpcomingOccurrences(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		query                string
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name: "successfully getting upcoming occurrences",
+			mockClient: &mocks.MockTodoServiceClient{
+				GetUpcomingOccurrencesFunc: func(ctx context.Context, req *pb.GetUpcomingOccurrencesRequest) (*pb.GetUpcomingOccurrencesResponse, error) {
+					assert.Equal(t, int64(1), req.ItemId)
+					assert.Equal(t, int32(5), req.Limit)
+					return &pb.GetUpcomingOccurrencesResponse{
+						Status: http.StatusOK,
+					}, nil
+				},
+			},
+			query:                "?limit=5",
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"status":200}`,
+			userId:               1,
+		},
+		{
+			name: "item does not repeat",
+			mockClient: &mocks.MockTodoServiceClient{
+				GetUpcomingOccurrencesFunc: func(ctx context.Context, req *pb.GetUpcomingOccurrencesRequest) (*pb.GetUpcomingOccurrencesResponse, error) {
+					return &pb.GetUpcomingOccurrencesResponse{
+						Status: http.StatusBadRequest,
+						Error:  "Item does not repeat",
+					}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"status":400,"error":"Item does not repeat"}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid limit",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			query:                "?limit=-1",
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"limit must be a positive integer"}`,
+			userId:               1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodGet, "/items/1/occurrences"+tt.query, nil)
+
+			r.GET("/items/:id/occurrences", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				GetUpcomingOccurrences(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedResponseBody != "" {
+				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			}
+		})
+	}
+}
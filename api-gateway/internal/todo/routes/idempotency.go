@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/metadata"
+)
+
+// idempotencyContext forwards the Idempotency-Key header of a request to the
+// service, which replays its first response to retries with the same key.
+func idempotencyContext(ctx *gin.Context) context.Context {
+	key := ctx.GetHeader("Idempotency-Key")
+	if key == "" {
+		return context.Background()
+	}
+
+	return metadata.AppendToOutgoingContext(context.Background(), "idempotency-key", key)
+}
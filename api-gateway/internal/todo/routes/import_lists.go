@@ -0,0 +1,93 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+var (
+	invalidDryRun = "dry_run must be true or false"
+)
+
+func ImportLists(ctx *gin.Context, client pb.TodoServiceClient) {
+	importLists(ctx, client, 0)
+}
+
+// ImportIntoList adds the items of the uploaded file to the list in the path
+// instead of creating the lists of the file.
+func ImportIntoList(ctx *gin.Context, client pb.TodoServiceClient) {
+	listId, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidListID)
+		return
+	}
+
+	importLists(ctx, client, int64(listId))
+}
+
+func importLists(ctx *gin.Context, client pb.TodoServiceClient, listId int64) {
+	userID, err := auth.GetUserId(ctx)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusUnauthorized, invalidUserID)
+		return
+	}
+
+	dryRun := false
+	if value := ctx.Query("dry_run"); value != "" {
+		if dryRun, err = strconv.ParseBool(value); err != nil {
+			utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidDryRun)
+			return
+		}
+	}
+
+	part, err := filePart(ctx.Request)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidMultipart)
+		return
+	}
+	defer part.Close()
+
+	streamCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.ImportLists(streamCtx)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	err = stream.Send(&pb.ImportListsRequest{
+		Data: &pb.ImportListsRequest_Info{Info: &pb.ImportInfo{
+			UserId:   userID,
+			Format:   ctx.Query("format"),
+			FileName: part.FileName(),
+			DryRun:   dryRun,
+			ListId:   listId,
+		}},
+	})
+	if err == nil {
+		if err = sendChunks(stream, importChunk, part); err != nil {
+			utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidMultipart)
+			return
+		}
+	}
+
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	ctx.JSON(http.StatusOK, &res)
+}
+
+func importChunk(chunk []byte) *pb.ImportListsRequest {
+	return &pb.ImportListsRequest{
+		Data: &pb.ImportListsRequest_Chunk{Chunk: chunk},
+	}
+}
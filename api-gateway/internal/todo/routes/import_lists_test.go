@@ -0,0 +1,214 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestImportLists(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	content := "# Groceries\n\n- [ ] Milk\n"
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		query                string
+		field                string
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name:  "successfully importing lists",
+			field: "file",
+			mockClient: &mocks.MockTodoServiceClient{
+				ImportListsFunc: func(ctx context.Context) (pb.TodoService_ImportListsClient, error) {
+					return &mocks.MockImportListsClient{
+						CloseAndRecvFunc: func(requests []*pb.ImportListsRequest) (*pb.ImportListsResponse, error) {
+							info := requests[0].GetInfo()
+							assert.Equal(t, int64(1), info.UserId)
+							assert.Equal(t, "groceries.md", info.FileName)
+							assert.Empty(t, info.Format)
+							assert.False(t, info.DryRun)
+							assert.Len(t, requests, 2)
+							assert.Equal(t, content, string(requests[1].GetChunk()))
+
+							return &pb.ImportListsResponse{
+								Lists:  []*pb.ImportedList{{Id: 7, Title: "Groceries", ItemCount: 1}},
+								Status: http.StatusCreated,
+							}, nil
+						},
+					}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"lists":[{"id":7,"title":"Groceries","item_count":1}],"status":201}`,
+			userId:               1,
+		},
+		{
+			name:  "dry run",
+			query: "?format=markdown&dry_run=true",
+			field: "file",
+			mockClient: &mocks.MockTodoServiceClient{
+				ImportListsFunc: func(ctx context.Context) (pb.TodoService_ImportListsClient, error) {
+					return &mocks.MockImportListsClient{
+						CloseAndRecvFunc: func(requests []*pb.ImportListsRequest) (*pb.ImportListsResponse, error) {
+							info := requests[0].GetInfo()
+							assert.Equal(t, "markdown", info.Format)
+							assert.True(t, info.DryRun)
+
+							return &pb.ImportListsResponse{
+								Lists:  []*pb.ImportedList{{Title: "Groceries", ItemCount: 1}},
+								DryRun: true,
+								Status: http.StatusOK,
+							}, nil
+						},
+					}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"lists":[{"title":"Groceries","item_count":1}],"dry_run":true,"status":200}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid dry run",
+			query:                "?dry_run=maybe",
+			field:                "file",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"dry_run must be true or false"}`,
+			userId:               1,
+		},
+		{
+			name:  "service error",
+			field: "file",
+			mockClient: &mocks.MockTodoServiceClient{
+				ImportListsFunc: func(ctx context.Context) (pb.TodoService_ImportListsClient, error) {
+					return nil, errors.New("service unavailable")
+				},
+			},
+			expectedStatusCode:   http.StatusBadGateway,
+			expectedResponseBody: `{"message":"service unavailable"}`,
+			userId:               1,
+		},
+		{
+			name:                 "missing file part",
+			field:                "upload",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"request must be multipart/form-data with a file part"}`,
+			userId:               1,
+		},
+		{
+			name:                 "unauthorized",
+			field:                "file",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			expectedStatusCode:   http.StatusUnauthorized,
+			expectedResponseBody: `{"message":"invalid user ID"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			body, contentType := multipartBody(tt.field, "groceries.md", content)
+			req, _ := http.NewRequest(http.MethodPost, "/import"+tt.query, body)
+			req.Header.Set("Content-Type", contentType)
+
+			r.POST("/import", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				ImportLists(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+		})
+	}
+}
+
+func TestImportIntoList(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	content := "BEGIN:VCALENDAR\r\nBEGIN:VTODO\r\nSUMMARY:Pay rent\r\nEND:VTODO\r\nEND:VCALENDAR\r\n"
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		listId               string
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name:   "successfully importing into a list",
+			listId: "2",
+			mockClient: &mocks.MockTodoServiceClient{
+				ImportListsFunc: func(ctx context.Context) (pb.TodoService_ImportListsClient, error) {
+					return &mocks.MockImportListsClient{
+						CloseAndRecvFunc: func(requests []*pb.ImportListsRequest) (*pb.ImportListsResponse, error) {
+							info := requests[0].GetInfo()
+							assert.Equal(t, int64(1), info.UserId)
+							assert.Equal(t, int64(2), info.ListId)
+							assert.Equal(t, "bills.ics", info.FileName)
+
+							return &pb.ImportListsResponse{
+								Lists:  []*pb.ImportedList{{Id: 2, Title: "Bills", ItemCount: 1}},
+								Status: http.StatusCreated,
+							}, nil
+						},
+					}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"lists":[{"id":2,"title":"Bills","item_count":1}],"status":201}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid list id",
+			listId:               "abc",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"invalid list id"}`,
+			userId:               1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			body, contentType := multipartBody("file", "bills.ics", content)
+			req, _ := http.NewRequest(http.MethodPost, "/lists/"+tt.listId+"/import", body)
+			req.Header.Set("Content-Type", contentType)
+
+			r.POST("/lists/:id/import", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				ImportIntoList(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+		})
+	}
+}
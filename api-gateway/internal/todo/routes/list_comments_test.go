@@ -0,0 +1,87 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestListComments(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		query                string
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name: "successfully listing comments",
+			mockClient: &mocks.MockTodoServiceClient{
+				ListCommentsFunc: func(ctx context.Context, req *pb.ListCommentsRequest) (*pb.ListCommentsResponse, error) {
+					assert.Equal(t, int64(5), req.ItemId)
+					assert.Equal(t, int32(10), req.PageSize)
+					assert.Equal(t, "eyJpZCI6MTB9", req.PageToken)
+					return &pb.ListCommentsResponse{
+						Comments:   []*pb.Comment{{Id: 11, ItemId: 5, AuthorId: 2, Body: "On it"}},
+						Status:     http.StatusOK,
+						TotalCount: 11,
+					}, nil
+				},
+			},
+			query:                "?page_size=10&page_token=eyJpZCI6MTB9",
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"comments":[{"id":11,"item_id":5,"author_id":2,"body":"On it"}],"status":200,"total_count":11}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid page size",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			query:                "?page_size=-1",
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"page_size must be a positive integer"}`,
+			userId:               1,
+		},
+		{
+			name:                 "unauthorized",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			query:                "",
+			expectedStatusCode:   http.StatusUnauthorized,
+			expectedResponseBody: `{"message":"invalid user ID"}`,
+			userId:               0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodGet, "/items/5/comments"+tt.query, nil)
+
+			r.GET("/items/:id/comments", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				ListComments(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedResponseBody != "" {
+				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			}
+		})
+	}
+}
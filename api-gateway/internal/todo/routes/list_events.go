@@ -0,0 +1,244 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/websocket"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	lastEventIDHeader = "Last-Event-ID"
+	// Browsers resend Last-Event-ID when an event source reconnects, new
+	// connections and WebSockets pass it in the query.
+	lastEventIDQuery = "last_event_id"
+	// How long event sources wait before reconnecting, in milliseconds.
+	reconnectDelay = 3000
+)
+
+var (
+	invalidLastEventID = "invalid last event id"
+	// Proxies close connections that stay silent for too long.
+	heartbeatInterval = 15 * time.Second
+)
+
+// ListEvents streams the changes to a list as server-sent events. Every
+// event carries its sequence as id, so that reconnecting event sources
+// resume where they left off. Events from shortly before may come again on
+// resuming, clients skip the ids they have seen.
+func ListEvents(ctx *gin.Context, client pb.TodoServiceClient) {
+	stream, cancel, ok := watchList(ctx, client)
+	if !ok {
+		return
+	}
+	defer cancel()
+
+	header := ctx.Writer.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("X-Accel-Buffering", "no")
+	ctx.Status(http.StatusOK)
+
+	w := ctx.Writer
+	if _, err := fmt.Fprintf(w, "retry: %d\n\n", reconnectDelay); err != nil {
+		return
+	}
+	w.Flush()
+
+	relay(ctx.Request.Context(), stream, func(res *pb.WatchListResponse) error {
+		var err error
+		if res.Event == nil {
+			var data []byte
+			if data, err = json.Marshal(res); err == nil {
+				_, err = fmt.Fprintf(w, "event: error\ndata: %s\n\n", data)
+			}
+		} else {
+			var data []byte
+			if data, err = json.Marshal(res.Event); err == nil {
+				_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", res.Event.Sequence, eventName(res.Event.Type), data)
+			}
+		}
+		w.Flush()
+		return err
+	}, func() error {
+		_, err := io.WriteString(w, ": heartbeat\n\n")
+		w.Flush()
+		return err
+	})
+}
+
+// listMessage is what ListEventsWebSocket sends for every event, heartbeat
+// and for the error that ends the stream.
+type listMessage struct {
+	Type   string        `json:"type"`
+	Event  *pb.ListEvent `json:"event,omitempty"`
+	Status int64         `json:"status,omitempty"`
+	Error  string        `json:"error,omitempty"`
+}
+
+// ListEventsWebSocket streams the changes to a list as JSON messages over a
+// WebSocket, for clients that prefer it to server-sent events.
+func ListEventsWebSocket(ctx *gin.Context, client pb.TodoServiceClient) {
+	stream, cancel, ok := watchList(ctx, client)
+	if !ok {
+		return
+	}
+	defer cancel()
+
+	server := websocket.Server{Handshake: selectProtocol, Handler: func(ws *websocket.Conn) {
+		wsCtx, stop := context.WithCancel(ctx.Request.Context())
+		defer stop()
+
+		// Messages from the client are not expected, reading only tells
+		// when it goes away.
+		go func() {
+			_, _ = io.Copy(io.Discard, ws)
+			stop()
+		}()
+
+		relay(wsCtx, stream, func(res *pb.WatchListResponse) error {
+			if res.Event == nil {
+				return websocket.JSON.Send(ws, &listMessage{Type: "error", Status: res.Status, Error: res.Error})
+			}
+			return websocket.JSON.Send(ws, &listMessage{Type: eventName(res.Event.Type), Event: res.Event})
+		}, func() error {
+			return websocket.JSON.Send(ws, &listMessage{Type: "heartbeat"})
+		})
+	}}
+	server.ServeHTTP(ctx.Writer, ctx.Request)
+}
+
+// selectProtocol accepts the subprotocol browsers offer the token with, see
+// auth.TokenProtocol, without sending the token back.
+func selectProtocol(config *websocket.Config, _ *http.Request) error {
+	if slices.Contains(config.Protocol, auth.TokenProtocol) {
+		config.Protocol = []string{auth.TokenProtocol}
+	} else {
+		config.Protocol = nil
+	}
+	return nil
+}
+
+// watchList starts watching the list of the request. It responds itself and
+// reports false when the list cannot be watched, otherwise cancel stops the
+// stream.
+func watchList(ctx *gin.Context, client pb.TodoServiceClient) (pb.TodoService_WatchListClient, context.CancelFunc, bool) {
+	userID, err := auth.GetUserId(ctx)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusUnauthorized, invalidUserID)
+		return nil, nil, false
+	}
+
+	listId, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidListID)
+		return nil, nil, false
+	}
+
+	lastEventID := ctx.GetHeader(lastEventIDHeader)
+	if lastEventID == "" {
+		lastEventID = ctx.Query(lastEventIDQuery)
+	}
+	var after int64
+	if lastEventID != "" {
+		if after, err = strconv.ParseInt(lastEventID, 10, 64); err != nil || after < 0 {
+			utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidLastEventID)
+			return nil, nil, false
+		}
+	}
+
+	streamCtx, cancel := context.WithCancel(ctx.Request.Context())
+
+	stream, err := client.WatchList(streamCtx, &pb.WatchListRequest{
+		UserId:        userID,
+		ListId:        int64(listId),
+		AfterSequence: after,
+	})
+	if err != nil {
+		cancel()
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return nil, nil, false
+	}
+
+	res, err := stream.Recv()
+	if err != nil {
+		cancel()
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return nil, nil, false
+	}
+
+	if res.Status != http.StatusOK {
+		cancel()
+		ctx.JSON(http.StatusOK, &res)
+		return nil, nil, false
+	}
+
+	return stream, cancel, true
+}
+
+// relay hands the responses of the stream to send until the stream ends,
+// ctx is done or sending fails. heartbeat is called whenever nothing was sent
+// for a while. A stream that breaks off is reported to send as an error
+// response, so that clients know to reconnect.
+func relay(ctx context.Context, stream pb.TodoService_WatchListClient, send func(*pb.WatchListResponse) error, heartbeat func() error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	responses := make(chan *pb.WatchListResponse)
+	go func() {
+		defer close(responses)
+		for {
+			res, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				res = &pb.WatchListResponse{Status: http.StatusBadGateway, Error: err.Error()}
+			}
+
+			select {
+			case responses <- res:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := heartbeat(); err != nil {
+				return
+			}
+		case res, ok := <-responses:
+			if !ok || send(res) != nil {
+				return
+			}
+			ticker.Reset(heartbeatInterval)
+		}
+	}
+}
+
+// eventName turns ITEM_CREATED into item_created.
+func eventName(eventType pb.ListEvent_Type) string {
+	return strings.ToLower(eventType.String())
+}
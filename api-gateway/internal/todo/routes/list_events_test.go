@@ -0,0 +1,240 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func watchResponses(responses ...*pb.WatchListResponse) []*pb.WatchListResponse {
+	return append([]*pb.WatchListResponse{{Status: http.StatusOK}}, responses...)
+}
+
+var itemCreated = &pb.WatchListResponse{
+	Event: &pb.ListEvent{
+		Sequence: 6,
+		Type:     pb.ListEvent_ITEM_CREATED,
+		ListId:   2,
+		ActorId:  3,
+		Item:     &pb.TodoItem{Id: 4, Title: "Buy milk"},
+	},
+	Status: http.StatusOK,
+}
+
+func TestListEvents(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		query                string
+		lastEventID          string
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name:        "successfully streaming events",
+			lastEventID: "5",
+			mockClient: &mocks.MockTodoServiceClient{
+				WatchListFunc: func(ctx context.Context, req *pb.WatchListRequest) (pb.TodoService_WatchListClient, error) {
+					assert.Equal(t, int64(1), req.UserId)
+					assert.Equal(t, int64(2), req.ListId)
+					assert.Equal(t, int64(5), req.AfterSequence)
+					return &mocks.MockWatchListClient{
+						Responses: watchResponses(itemCreated, &pb.WatchListResponse{
+							Status: http.StatusForbidden,
+							Error:  "User does not have access to this list",
+						}),
+					}, nil
+				},
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponseBody: "retry: 3000\n\n" +
+				"id: 6\nevent: item_created\ndata: {\"sequence\":6,\"type\":1,\"list_id\":2,\"actor_id\":3,\"item\":{\"id\":4,\"title\":\"Buy milk\"}}\n\n" +
+				"event: error\ndata: {\"status\":403,\"error\":\"User does not have access to this list\"}\n\n",
+			userId: 1,
+		},
+		{
+			name:  "resuming from the query",
+			query: "?last_event_id=8",
+			mockClient: &mocks.MockTodoServiceClient{
+				WatchListFunc: func(ctx context.Context, req *pb.WatchListRequest) (pb.TodoService_WatchListClient, error) {
+					assert.Equal(t, int64(8), req.AfterSequence)
+					return &mocks.MockWatchListClient{Responses: watchResponses()}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: "retry: 3000\n\n",
+			userId:               1,
+		},
+		{
+			name: "stream breaks off",
+			mockClient: &mocks.MockTodoServiceClient{
+				WatchListFunc: func(ctx context.Context, req *pb.WatchListRequest) (pb.TodoService_WatchListClient, error) {
+					return &mocks.MockWatchListClient{
+						Responses: watchResponses(),
+						Err:       errors.New("connection reset"),
+					}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: "retry: 3000\n\nevent: error\ndata: {\"status\":502,\"error\":\"connection reset\"}\n\n",
+			userId:               1,
+		},
+		{
+			name: "not a member",
+			mockClient: &mocks.MockTodoServiceClient{
+				WatchListFunc: func(ctx context.Context, req *pb.WatchListRequest) (pb.TodoService_WatchListClient, error) {
+					return &mocks.MockWatchListClient{
+						Responses: []*pb.WatchListResponse{{
+							Status: http.StatusForbidden,
+							Error:  "User does not have access to this list",
+						}},
+					}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"status":403,"error":"User does not have access to this list"}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid last event id",
+			lastEventID:          "abc",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"invalid last event id"}`,
+			userId:               1,
+		},
+		{
+			name: "service unavailable",
+			mockClient: &mocks.MockTodoServiceClient{
+				WatchListFunc: func(ctx context.Context, req *pb.WatchListRequest) (pb.TodoService_WatchListClient, error) {
+					return nil, errors.New("connection refused")
+				},
+			},
+			expectedStatusCode:   http.StatusBadGateway,
+			expectedResponseBody: `{"message":"connection refused"}`,
+			userId:               1,
+		},
+		{
+			name:                 "unauthorized",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			expectedStatusCode:   http.StatusUnauthorized,
+			expectedResponseBody: `{"message":"invalid user ID"}`,
+			userId:               0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodGet, "/lists/2/events"+tt.query, nil)
+			if tt.lastEventID != "" {
+				req.Header.Set("Last-Event-ID", tt.lastEventID)
+			}
+
+			r.GET("/lists/:id/events", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				ListEvents(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedStatusCode == http.StatusOK && strings.HasPrefix(tt.expectedResponseBody, "retry") {
+				assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+				assert.Equal(t, tt.expectedResponseBody, w.Body.String())
+			} else {
+				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			}
+		})
+	}
+}
+
+func TestListEvents_Heartbeat(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	interval := heartbeatInterval
+	heartbeatInterval = time.Millisecond
+	defer func() { heartbeatInterval = interval }()
+
+	client := &mocks.MockTodoServiceClient{
+		WatchListFunc: func(ctx context.Context, req *pb.WatchListRequest) (pb.TodoService_WatchListClient, error) {
+			return &mocks.MockWatchListClient{Responses: watchResponses(), Done: ctx.Done()}, nil
+		},
+	}
+
+	r := gin.New()
+	r.GET("/lists/:id/events", func(ctx *gin.Context) {
+		ctx.Set(auth.Key, int64(1))
+		ListEvents(ctx, client)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "/lists/2/events", nil)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), ": heartbeat\n\n")
+}
+
+func TestListEventsWebSocket(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	client := &mocks.MockTodoServiceClient{
+		WatchListFunc: func(ctx context.Context, req *pb.WatchListRequest) (pb.TodoService_WatchListClient, error) {
+			assert.Equal(t, int64(5), req.AfterSequence)
+			return &mocks.MockWatchListClient{
+				Responses: watchResponses(itemCreated, &pb.WatchListResponse{
+					Status: http.StatusNotFound,
+					Error:  "List not found",
+				}),
+			}, nil
+		},
+	}
+
+	r := gin.New()
+	r.GET("/lists/:id/events/ws", func(ctx *gin.Context) {
+		ctx.Set(auth.Key, int64(1))
+		ListEventsWebSocket(ctx, client)
+	})
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	config, err := websocket.NewConfig("ws"+strings.TrimPrefix(server.URL, "http")+"/lists/2/events/ws?last_event_id=5", server.URL)
+	require.NoError(t, err)
+	config.Protocol = []string{auth.TokenProtocol, "valid-token"}
+	ws, err := websocket.DialConfig(config)
+	require.NoError(t, err)
+	defer ws.Close()
+	assert.Equal(t, []string{auth.TokenProtocol}, ws.Config().Protocol, "the token is not sent back")
+
+	var message string
+	require.NoError(t, websocket.Message.Receive(ws, &message))
+	assert.Equal(t, `{"type":"item_created","event":{"sequence":6,"type":1,"list_id":2,"actor_id":3,"item":{"id":4,"title":"Buy milk"}}}`, message)
+
+	require.NoError(t, websocket.Message.Receive(ws, &message))
+	assert.Equal(t, `{"type":"error","status":404,"error":"List not found"}`, message)
+
+	assert.Error(t, websocket.Message.Receive(ws, &message), "the server closes the connection")
+}
@@ -4,19 +4,67 @@ import (
 	"context"
 	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"io"
 )
 
 type MockTodoServiceClient struct {
-	CreateTodoItemFunc  func(ctx context.Context, in *pb.CreateTodoItemRequest) (*pb.CreateTodoItemResponse, error)
-	CreateTodoListFunc  func(ctx context.Context, in *pb.CreateTodoListRequest) (*pb.CreateTodoListResponse, error)
-	GetTodoListByIdFunc func(ctx context.Context, in *pb.GetTodoListRequest) (*pb.GetTodoListResponse, error)
-	GetTodoListsFunc    func(ctx context.Context, in *pb.GetTodoListsRequest) (*pb.GetTodoListsResponse, error)
-	UpdateTodoListFunc  func(ctx context.Context, in *pb.UpdateTodoListRequest) (*pb.UpdateTodoListResponse, error)
-	DeleteTodoListFunc  func(ctx context.Context, in *pb.DeleteTodoListRequest) (*pb.DeleteTodoListResponse, error)
-	DeleteTodoItemFunc  func(ctx context.Context, in *pb.DeleteTodoItemRequest) (*pb.DeleteTodoItemResponse, error)
-	UpdateTodoItemFunc  func(ctx context.Context, in *pb.UpdateTodoItemRequest) (*pb.UpdateTodoItemResponse, error)
-	GetTodoItemByIdFunc func(ctx context.Context, in *pb.GetTodoItemRequest) (*pb.GetTodoItemResponse, error)
-	GetTodoItemsFunc    func(ctx context.Context, in *pb.GetTodoItemsRequest) (*pb.GetTodoItemsResponse, error)
+	CreateTodoItemFunc         func(ctx context.Context, in *pb.CreateTodoItemRequest) (*pb.CreateTodoItemResponse, error)
+	CreateTodoListFunc         func(ctx context.Context, in *pb.CreateTodoListRequest) (*pb.CreateTodoListResponse, error)
+	GetTodoListByIdFunc        func(ctx context.Context, in *pb.GetTodoListRequest) (*pb.GetTodoListResponse, error)
+	GetTodoListsFunc           func(ctx context.Context, in *pb.GetTodoListsRequest) (*pb.GetTodoListsResponse, error)
+	UpdateTodoListFunc         func(ctx context.Context, in *pb.UpdateTodoListRequest) (*pb.UpdateTodoListResponse, error)
+	DeleteTodoListFunc         func(ctx context.Context, in *pb.DeleteTodoListRequest) (*pb.DeleteTodoListResponse, error)
+	DeleteTodoItemFunc         func(ctx context.Context, in *pb.DeleteTodoItemRequest) (*pb.DeleteTodoItemResponse, error)
+	UpdateTodoItemFunc         func(ctx context.Context, in *pb.UpdateTodoItemRequest) (*pb.UpdateTodoItemResponse, error)
+	GetTodoItemByIdFunc        func(ctx context.Context, in *pb.GetTodoItemRequest) (*pb.GetTodoItemResponse, error)
+	GetTodoItemsFunc           func(ctx context.Context, in *pb.GetTodoItemsRequest) (*pb.GetTodoItemsResponse, error)
+	GetDueItemsFunc            func(ctx context.Context, in *pb.GetDueItemsRequest) (*pb.GetDueItemsResponse, error)
+	GetOverdueItemsFunc        func(ctx context.Context, in *pb.GetOverdueItemsRequest) (*pb.GetOverdueItemsResponse, error)
+	ShareListFunc              func(ctx context.Context, in *pb.ShareListRequest) (*pb.ShareListResponse, error)
+	UpdateMemberRoleFunc       func(ctx context.Context, in *pb.UpdateMemberRoleRequest) (*pb.UpdateMemberRoleResponse, error)
+	RemoveMemberFunc           func(ctx context.Context, in *pb.RemoveMemberRequest) (*pb.RemoveMemberResponse, error)
+	ListMembersFunc            func(ctx context.Context, in *pb.ListMembersRequest) (*pb.ListMembersResponse, error)
+	CreateInvitationFunc       func(ctx context.Context, in *pb.CreateInvitationRequest) (*pb.CreateInvitationResponse, error)
+	GetInvitationsFunc         func(ctx context.Context, in *pb.GetInvitationsRequest) (*pb.GetInvitationsResponse, error)
+	AcceptInvitationFunc       func(ctx context.Context, in *pb.AcceptInvitationRequest) (*pb.AcceptInvitationResponse, error)
+	DeclineInvitationFunc      func(ctx context.Context, in *pb.DeclineInvitationRequest) (*pb.DeclineInvitationResponse, error)
+	ActivateInvitationsFunc    func(ctx context.Context, in *pb.ActivateInvitationsRequest) (*pb.ActivateInvitationsResponse, error)
+	GetUpcomingOccurrencesFunc func(ctx context.Context, in *pb.GetUpcomingOccurrencesRequest) (*pb.GetUpcomingOccurrencesResponse, error)
+	ArchiveTodoListFunc        func(ctx context.Context, in *pb.ArchiveTodoListRequest) (*pb.ArchiveTodoListResponse, error)
+	UnarchiveTodoListFunc      func(ctx context.Context, in *pb.UnarchiveTodoListRequest) (*pb.UnarchiveTodoListResponse, error)
+	MoveTodoListFunc           func(ctx context.Context, in *pb.MoveTodoListRequest) (*pb.MoveTodoListResponse, error)
+	MoveTodoItemFunc           func(ctx context.Context, in *pb.MoveTodoItemRequest) (*pb.MoveTodoItemResponse, error)
+	AddCommentFunc             func(ctx context.Context, in *pb.AddCommentRequest) (*pb.AddCommentResponse, error)
+	ListCommentsFunc           func(ctx context.Context, in *pb.ListCommentsRequest) (*pb.ListCommentsResponse, error)
+	EditCommentFunc            func(ctx context.Context, in *pb.EditCommentRequest) (*pb.EditCommentResponse, error)
+	DeleteCommentFunc          func(ctx context.Context, in *pb.DeleteCommentRequest) (*pb.DeleteCommentResponse, error)
+	UploadAttachmentFunc       func(ctx context.Context) (pb.TodoService_UploadAttachmentClient, error)
+	DownloadAttachmentFunc     func(ctx context.Context, in *pb.DownloadAttachmentRequest) (pb.TodoService_DownloadAttachmentClient, error)
+	ListAttachmentsFunc        func(ctx context.Context, in *pb.ListAttachmentsRequest) (*pb.ListAttachmentsResponse, error)
+	DeleteAttachmentFunc       func(ctx context.Context, in *pb.DeleteAttachmentRequest) (*pb.DeleteAttachmentResponse, error)
+	GetListActivityFunc        func(ctx context.Context, in *pb.GetListActivityRequest) (*pb.GetListActivityResponse, error)
+	WatchListFunc              func(ctx context.Context, in *pb.WatchListRequest) (pb.TodoService_WatchListClient, error)
+	BatchUpdateTodoItemsFunc   func(ctx context.Context, in *pb.BatchUpdateTodoItemsRequest) (*pb.BatchUpdateTodoItemsResponse, error)
+	MoveTodoItemsFunc          func(ctx context.Context, in *pb.MoveTodoItemsRequest) (*pb.MoveTodoItemsResponse, error)
+	CopyTodoItemsFunc          func(ctx context.Context, in *pb.CopyTodoItemsRequest) (*pb.CopyTodoItemsResponse, error)
+	ListTrashFunc              func(ctx context.Context, in *pb.ListTrashRequest) (*pb.ListTrashResponse, error)
+	RestoreTodoListFunc        func(ctx context.Context, in *pb.RestoreTodoListRequest) (*pb.RestoreTodoListResponse, error)
+	RestoreTodoItemFunc        func(ctx context.Context, in *pb.RestoreTodoItemRequest) (*pb.RestoreTodoItemResponse, error)
+	EmptyTrashFunc             func(ctx context.Context, in *pb.EmptyTrashRequest) (*pb.EmptyTrashResponse, error)
+	SearchItemsFunc            func(ctx context.Context, in *pb.SearchItemsRequest) (*pb.SearchItemsResponse, error)
+	CreateTagFunc              func(ctx context.Context, in *pb.CreateTagRequest) (*pb.CreateTagResponse, error)
+	GetTagsFunc                func(ctx context.Context, in *pb.GetTagsRequest) (*pb.GetTagsResponse, error)
+	UpdateTagFunc              func(ctx context.Context, in *pb.UpdateTagRequest) (*pb.UpdateTagResponse, error)
+	DeleteTagFunc              func(ctx context.Context, in *pb.DeleteTagRequest) (*pb.DeleteTagResponse, error)
+	AttachTagFunc              func(ctx context.Context, in *pb.AttachTagRequest) (*pb.AttachTagResponse, error)
+	DetachTagFunc              func(ctx context.Context, in *pb.DetachTagRequest) (*pb.DetachTagResponse, error)
+	ExportListsFunc            func(ctx context.Context, in *pb.ExportListsRequest) (pb.TodoService_ExportListsClient, error)
+	ImportListsFunc            func(ctx context.Context) (pb.TodoService_ImportListsClient, error)
+	GetCalendarFeedFunc        func(ctx context.Context, in *pb.GetCalendarFeedRequest) (*pb.GetCalendarFeedResponse, error)
+	ResetCalendarFeedFunc      func(ctx context.Context, in *pb.ResetCalendarFeedRequest) (*pb.ResetCalendarFeedResponse, error)
+	GetCalendarFunc            func(ctx context.Context, in *pb.GetCalendarRequest) (*pb.GetCalendarResponse, error)
+	SyncTodoTxtFunc            func(ctx context.Context, in *pb.SyncTodoTxtRequest) (*pb.SyncTodoTxtResponse, error)
 }
 
 func (m *MockTodoServiceClient) CreateTodoItem(ctx context.Context, in *pb.CreateTodoItemRequest, opts ...grpc.CallOption) (*pb.CreateTodoItemResponse, error) {
@@ -49,3 +97,233 @@ func (m *MockTodoServiceClient) GetTodoItemById(ctx context.Context, in *pb.GetT
 func (m *MockTodoServiceClient) GetTodoItems(ctx context.Context, in *pb.GetTodoItemsRequest, opts ...grpc.CallOption) (*pb.GetTodoItemsResponse, error) {
 	return m.GetTodoItemsFunc(ctx, in)
 }
+func (m *MockTodoServiceClient) GetDueItems(ctx context.Context, in *pb.GetDueItemsRequest, opts ...grpc.CallOption) (*pb.GetDueItemsResponse, error) {
+	return m.GetDueItemsFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) GetOverdueItems(ctx context.Context, in *pb.GetOverdueItemsRequest, opts ...grpc.CallOption) (*pb.GetOverdueItemsResponse, error) {
+	return m.GetOverdueItemsFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) ShareList(ctx context.Context, in *pb.ShareListRequest, opts ...grpc.CallOption) (*pb.ShareListResponse, error) {
+	return m.ShareListFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) UpdateMemberRole(ctx context.Context, in *pb.UpdateMemberRoleRequest, opts ...grpc.CallOption) (*pb.UpdateMemberRoleResponse, error) {
+	return m.UpdateMemberRoleFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) RemoveMember(ctx context.Context, in *pb.RemoveMemberRequest, opts ...grpc.CallOption) (*pb.RemoveMemberResponse, error) {
+	return m.RemoveMemberFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) ListMembers(ctx context.Context, in *pb.ListMembersRequest, opts ...grpc.CallOption) (*pb.ListMembersResponse, error) {
+	return m.ListMembersFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) CreateInvitation(ctx context.Context, in *pb.CreateInvitationRequest, opts ...grpc.CallOption) (*pb.CreateInvitationResponse, error) {
+	return m.CreateInvitationFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) GetInvitations(ctx context.Context, in *pb.GetInvitationsRequest, opts ...grpc.CallOption) (*pb.GetInvitationsResponse, error) {
+	return m.GetInvitationsFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) AcceptInvitation(ctx context.Context, in *pb.AcceptInvitationRequest, opts ...grpc.CallOption) (*pb.AcceptInvitationResponse, error) {
+	return m.AcceptInvitationFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) DeclineInvitation(ctx context.Context, in *pb.DeclineInvitationRequest, opts ...grpc.CallOption) (*pb.DeclineInvitationResponse, error) {
+	return m.DeclineInvitationFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) ActivateInvitations(ctx context.Context, in *pb.ActivateInvitationsRequest, opts ...grpc.CallOption) (*pb.ActivateInvitationsResponse, error) {
+	return m.ActivateInvitationsFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) GetUpcomingOccurrences(ctx context.Context, in *pb.GetUpcomingOccurrencesRequest, opts ...grpc.CallOption) (*pb.GetUpcomingOccurrencesResponse, error) {
+	return m.GetUpcomingOccurrencesFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) ArchiveTodoList(ctx context.Context, in *pb.ArchiveTodoListRequest, opts ...grpc.CallOption) (*pb.ArchiveTodoListResponse, error) {
+	return m.ArchiveTodoListFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) UnarchiveTodoList(ctx context.Context, in *pb.UnarchiveTodoListRequest, opts ...grpc.CallOption) (*pb.UnarchiveTodoListResponse, error) {
+	return m.UnarchiveTodoListFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) MoveTodoList(ctx context.Context, in *pb.MoveTodoListRequest, opts ...grpc.CallOption) (*pb.MoveTodoListResponse, error) {
+	return m.MoveTodoListFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) MoveTodoItem(ctx context.Context, in *pb.MoveTodoItemRequest, opts ...grpc.CallOption) (*pb.MoveTodoItemResponse, error) {
+	return m.MoveTodoItemFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) AddComment(ctx context.Context, in *pb.AddCommentRequest, opts ...grpc.CallOption) (*pb.AddCommentResponse, error) {
+	return m.AddCommentFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) ListComments(ctx context.Context, in *pb.ListCommentsRequest, opts ...grpc.CallOption) (*pb.ListCommentsResponse, error) {
+	return m.ListCommentsFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) EditComment(ctx context.Context, in *pb.EditCommentRequest, opts ...grpc.CallOption) (*pb.EditCommentResponse, error) {
+	return m.EditCommentFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) DeleteComment(ctx context.Context, in *pb.DeleteCommentRequest, opts ...grpc.CallOption) (*pb.DeleteCommentResponse, error) {
+	return m.DeleteCommentFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (pb.TodoService_UploadAttachmentClient, error) {
+	return m.UploadAttachmentFunc(ctx)
+}
+func (m *MockTodoServiceClient) DownloadAttachment(ctx context.Context, in *pb.DownloadAttachmentRequest, opts ...grpc.CallOption) (pb.TodoService_DownloadAttachmentClient, error) {
+	return m.DownloadAttachmentFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) ListAttachments(ctx context.Context, in *pb.ListAttachmentsRequest, opts ...grpc.CallOption) (*pb.ListAttachmentsResponse, error) {
+	return m.ListAttachmentsFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) DeleteAttachment(ctx context.Context, in *pb.DeleteAttachmentRequest, opts ...grpc.CallOption) (*pb.DeleteAttachmentResponse, error) {
+	return m.DeleteAttachmentFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) GetListActivity(ctx context.Context, in *pb.GetListActivityRequest, opts ...grpc.CallOption) (*pb.GetListActivityResponse, error) {
+	return m.GetListActivityFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) WatchList(ctx context.Context, in *pb.WatchListRequest, opts ...grpc.CallOption) (pb.TodoService_WatchListClient, error) {
+	return m.WatchListFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) BatchUpdateTodoItems(ctx context.Context, in *pb.BatchUpdateTodoItemsRequest, opts ...grpc.CallOption) (*pb.BatchUpdateTodoItemsResponse, error) {
+	return m.BatchUpdateTodoItemsFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) MoveTodoItems(ctx context.Context, in *pb.MoveTodoItemsRequest, opts ...grpc.CallOption) (*pb.MoveTodoItemsResponse, error) {
+	return m.MoveTodoItemsFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) CopyTodoItems(ctx context.Context, in *pb.CopyTodoItemsRequest, opts ...grpc.CallOption) (*pb.CopyTodoItemsResponse, error) {
+	return m.CopyTodoItemsFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) ListTrash(ctx context.Context, in *pb.ListTrashRequest, opts ...grpc.CallOption) (*pb.ListTrashResponse, error) {
+	return m.ListTrashFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) RestoreTodoList(ctx context.Context, in *pb.RestoreTodoListRequest, opts ...grpc.CallOption) (*pb.RestoreTodoListResponse, error) {
+	return m.RestoreTodoListFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) RestoreTodoItem(ctx context.Context, in *pb.RestoreTodoItemRequest, opts ...grpc.CallOption) (*pb.RestoreTodoItemResponse, error) {
+	return m.RestoreTodoItemFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) EmptyTrash(ctx context.Context, in *pb.EmptyTrashRequest, opts ...grpc.CallOption) (*pb.EmptyTrashResponse, error) {
+	return m.EmptyTrashFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) SearchItems(ctx context.Context, in *pb.SearchItemsRequest, opts ...grpc.CallOption) (*pb.SearchItemsResponse, error) {
+	return m.SearchItemsFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) CreateTag(ctx context.Context, in *pb.CreateTagRequest, opts ...grpc.CallOption) (*pb.CreateTagResponse, error) {
+	return m.CreateTagFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) GetTags(ctx context.Context, in *pb.GetTagsRequest, opts ...grpc.CallOption) (*pb.GetTagsResponse, error) {
+	return m.GetTagsFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) UpdateTag(ctx context.Context, in *pb.UpdateTagRequest, opts ...grpc.CallOption) (*pb.UpdateTagResponse, error) {
+	return m.UpdateTagFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) DeleteTag(ctx context.Context, in *pb.DeleteTagRequest, opts ...grpc.CallOption) (*pb.DeleteTagResponse, error) {
+	return m.DeleteTagFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) AttachTag(ctx context.Context, in *pb.AttachTagRequest, opts ...grpc.CallOption) (*pb.AttachTagResponse, error) {
+	return m.AttachTagFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) DetachTag(ctx context.Context, in *pb.DetachTagRequest, opts ...grpc.CallOption) (*pb.DetachTagResponse, error) {
+	return m.DetachTagFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) ExportLists(ctx context.Context, in *pb.ExportListsRequest, opts ...grpc.CallOption) (pb.TodoService_ExportListsClient, error) {
+	return m.ExportListsFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) ImportLists(ctx context.Context, opts ...grpc.CallOption) (pb.TodoService_ImportListsClient, error) {
+	return m.ImportListsFunc(ctx)
+}
+func (m *MockTodoServiceClient) GetCalendarFeed(ctx context.Context, in *pb.GetCalendarFeedRequest, opts ...grpc.CallOption) (*pb.GetCalendarFeedResponse, error) {
+	return m.GetCalendarFeedFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) ResetCalendarFeed(ctx context.Context, in *pb.ResetCalendarFeedRequest, opts ...grpc.CallOption) (*pb.ResetCalendarFeedResponse, error) {
+	return m.ResetCalendarFeedFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) GetCalendar(ctx context.Context, in *pb.GetCalendarRequest, opts ...grpc.CallOption) (*pb.GetCalendarResponse, error) {
+	return m.GetCalendarFunc(ctx, in)
+}
+func (m *MockTodoServiceClient) SyncTodoTxt(ctx context.Context, in *pb.SyncTodoTxtRequest, opts ...grpc.CallOption) (*pb.SyncTodoTxtResponse, error) {
+	return m.SyncTodoTxtFunc(ctx, in)
+}
+
+// MockUploadAttachmentClient records the messages sent on an upload stream.
+type MockUploadAttachmentClient struct {
+	grpc.ClientStream
+	Requests         []*pb.UploadAttachmentRequest
+	CloseAndRecvFunc func(requests []*pb.UploadAttachmentRequest) (*pb.UploadAttachmentResponse, error)
+}
+
+func (m *MockUploadAttachmentClient) Send(req *pb.UploadAttachmentRequest) error {
+	m.Requests = append(m.Requests, proto.Clone(req).(*pb.UploadAttachmentRequest))
+	return nil
+}
+func (m *MockUploadAttachmentClient) CloseAndRecv() (*pb.UploadAttachmentResponse, error) {
+	return m.CloseAndRecvFunc(m.Requests)
+}
+
+// MockDownloadAttachmentClient replays Responses, then fails with Err or io.EOF.
+type MockDownloadAttachmentClient struct {
+	grpc.ClientStream
+	Responses []*pb.DownloadAttachmentResponse
+	Err       error
+}
+
+func (m *MockDownloadAttachmentClient) Recv() (*pb.DownloadAttachmentResponse, error) {
+	if len(m.Responses) == 0 {
+		if m.Err != nil {
+			return nil, m.Err
+		}
+		return nil, io.EOF
+	}
+	res := m.Responses[0]
+	m.Responses = m.Responses[1:]
+	return res, nil
+}
+
+// MockWatchListClient replays Responses, then waits for Done if it is set and
+// fails with Err or io.EOF.
+type MockWatchListClient struct {
+	grpc.ClientStream
+	Responses []*pb.WatchListResponse
+	Done      <-chan struct{}
+	Err       error
+}
+
+func (m *MockWatchListClient) Recv() (*pb.WatchListResponse, error) {
+	if len(m.Responses) == 0 {
+		if m.Done != nil {
+			<-m.Done
+		}
+		if m.Err != nil {
+			return nil, m.Err
+		}
+		return nil, io.EOF
+	}
+	res := m.Responses[0]
+	m.Responses = m.Responses[1:]
+	return res, nil
+}
+
+// MockExportListsClient replays Responses, then fails with Err or io.EOF.
+type MockExportListsClient struct {
+	grpc.ClientStream
+	Responses []*pb.ExportListsResponse
+	Err       error
+}
+
+func (m *MockExportListsClient) Recv() (*pb.ExportListsResponse, error) {
+	if len(m.Responses) == 0 {
+		if m.Err != nil {
+			return nil, m.Err
+		}
+		return nil, io.EOF
+	}
+	res := m.Responses[0]
+	m.Responses = m.Responses[1:]
+	return res, nil
+}
+
+// MockImportListsClient records the messages sent on an import stream.
+type MockImportListsClient struct {
+	grpc.ClientStream
+	Requests         []*pb.ImportListsRequest
+	CloseAndRecvFunc func(requests []*pb.ImportListsRequest) (*pb.ImportListsResponse, error)
+}
+
+func (m *MockImportListsClient) Send(req *pb.ImportListsRequest) error {
+	m.Requests = append(m.Requests, proto.Clone(req).(*pb.ImportListsRequest))
+	return nil
+}
+func (m *MockImportListsClient) CloseAndRecv() (*pb.ImportListsResponse, error) {
+	return m.CloseAndRecvFunc(m.Requests)
+}
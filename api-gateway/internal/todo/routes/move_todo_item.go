@@ -0,0 +1,53 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+// MoveInput names the neighbours an item or list is moved between, either
+// can be left out.
+type MoveInput struct {
+	AfterId  int64 `json:"after_id"`
+	BeforeId int64 `json:"before_id"`
+}
+
+func MoveTodoItem(ctx *gin.Context, client pb.TodoServiceClient) {
+	var req MoveInput
+
+	if err := ctx.BindJSON(&req); err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidInputBody)
+		return
+	}
+
+	userID, err := auth.GetUserId(ctx)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusUnauthorized, invalidUserID)
+		return
+	}
+
+	itemId, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidItemId)
+		return
+	}
+
+	res, err := client.MoveTodoItem(context.Background(), &pb.MoveTodoItemRequest{
+		UserId:   userID,
+		Id:       int64(itemId),
+		AfterId:  req.AfterId,
+		BeforeId: req.BeforeId,
+	})
+
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	ctx.JSON(http.StatusOK, &res)
+}
@@ -0,0 +1,80 @@
+package routes
+
+import (
+	"bytes"
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMoveTodoItem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		inputBody            string
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name: "successfully moving todo item",
+			mockClient: &mocks.MockTodoServiceClient{
+				MoveTodoItemFunc: func(ctx context.Context, req *pb.MoveTodoItemRequest) (*pb.MoveTodoItemResponse, error) {
+					assert.Equal(t, int64(1), req.Id)
+					assert.Equal(t, int64(2), req.AfterId)
+					assert.Equal(t, int64(3), req.BeforeId)
+					return &pb.MoveTodoItemResponse{
+						Item:   &pb.TodoItem{Id: 1, Position: "a1V"},
+						Status: http.StatusOK,
+					}, nil
+				},
+			},
+			inputBody:            `{"after_id":2,"before_id":3}`,
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"item":{"id":1,"position":"a1V"},"status":200}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid input body",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			inputBody:            `{"after_id":"first"}`,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"invalid input body"}`,
+			userId:               1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodPost, "/items/1/move", bytes.NewBufferString(tt.inputBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			r.POST("/items/:id/move", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				MoveTodoItem(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedResponseBody != "" {
+				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			}
+		})
+	}
+}
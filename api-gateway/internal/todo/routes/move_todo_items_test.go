@@ -0,0 +1,94 @@
+package routes
+
+import (
+	"bytes"
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMoveTodoItems(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		inputBody            string
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name: "successfully moving todo items",
+			mockClient: &mocks.MockTodoServiceClient{
+				MoveTodoItemsFunc: func(ctx context.Context, req *pb.MoveTodoItemsRequest) (*pb.MoveTodoItemsResponse, error) {
+					assert.Equal(t, []int64{1, 2}, req.Ids)
+					assert.Equal(t, int64(3), req.ListId)
+					return &pb.MoveTodoItemsResponse{
+						Items:  []*pb.TodoItem{{Id: 1, ListId: 3}},
+						Status: http.StatusOK,
+					}, nil
+				},
+			},
+			inputBody:            `{"ids":[1,2],"list_id":3}`,
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"items":[{"id":1,"list_id":3}],"status":200}`,
+			userId:               1,
+		},
+		{
+			name: "no edit rights",
+			mockClient: &mocks.MockTodoServiceClient{
+				MoveTodoItemsFunc: func(ctx context.Context, req *pb.MoveTodoItemsRequest) (*pb.MoveTodoItemsResponse, error) {
+					return &pb.MoveTodoItemsResponse{
+						Status: http.StatusForbidden,
+						Error:  "User role does not allow this action",
+					}, nil
+				},
+			},
+			inputBody:            `{"ids":[1],"list_id":3}`,
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"status":403,"error":"User role does not allow this action"}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid input body",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			inputBody:            `{"ids":"1"}`,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"invalid input body"}`,
+			userId:               1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodPost, "/items/move", bytes.NewBufferString(tt.inputBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			r.POST("/items/move", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				MoveTodoItems(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedResponseBody != "" {
+				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package routes
+
+import (
+	"bytes"
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMoveTodoList(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		inputBody            string
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name: "successfully moving todo list",
+			mockClient: &mocks.MockTodoServiceClient{
+				MoveTodoListFunc: func(ctx context.Context, req *pb.MoveTodoListRequest) (*pb.MoveTodoListResponse, error) {
+					assert.Equal(t, int64(0), req.AfterId)
+					assert.Equal(t, int64(2), req.BeforeId)
+					return &pb.MoveTodoListResponse{
+						Success: true,
+						Status:  http.StatusOK,
+					}, nil
+				},
+			},
+			inputBody:            `{"before_id":2}`,
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"success":true,"status":200}`,
+			userId:               1,
+		},
+		{
+			name: "neighbour not found",
+			mockClient: &mocks.MockTodoServiceClient{
+				MoveTodoListFunc: func(ctx context.Context, req *pb.MoveTodoListRequest) (*pb.MoveTodoListResponse, error) {
+					return &pb.MoveTodoListResponse{
+						Status: http.StatusNotFound,
+						Error:  "Neighbour not found",
+					}, nil
+				},
+			},
+			inputBody:            `{"after_id":9}`,
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"status":404,"error":"Neighbour not found"}`,
+			userId:               1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodPost, "/lists/1/move", bytes.NewBufferString(tt.inputBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			r.POST("/lists/:id/move", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				MoveTodoList(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedResponseBody != "" {
+				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			}
+		})
+	}
+}
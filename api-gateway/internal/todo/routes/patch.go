@@ -0,0 +1,42 @@
+package routes
+
+import (
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+var (
+	emptyPatch = "body must set at least one field"
+)
+
+// bindPatch binds the JSON body of a PATCH request to input and returns the
+// mask of the given fields the body sets, named by their JSON keys. Other
+// keys are ignored, as they are for PUT. It fails with a message when the
+// body is invalid or sets none of the fields.
+func bindPatch(ctx *gin.Context, input any, fields ...string) (*fieldmaskpb.FieldMask, string) {
+	body, err := ctx.GetRawData()
+	if err != nil {
+		return nil, invalidInputBody
+	}
+
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal(body, &keys); err != nil {
+		return nil, invalidInputBody
+	}
+	if err := json.Unmarshal(body, input); err != nil {
+		return nil, invalidInputBody
+	}
+
+	mask := &fieldmaskpb.FieldMask{}
+	for _, field := range fields {
+		if _, ok := keys[field]; ok {
+			mask.Paths = append(mask.Paths, field)
+		}
+	}
+	if len(mask.Paths) == 0 {
+		return nil, emptyPatch
+	}
+
+	return mask, ""
+}
@@ -0,0 +1,64 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+// PatchTodoItem changes only the fields of the item the body sets, so that
+// ticking an item off does not need the rest of it.
+func PatchTodoItem(ctx *gin.Context, client pb.TodoServiceClient) {
+	var req UpdateTodoItemInput
+
+	mask, msg := bindPatch(ctx, &req, "title", "description", "completed", "start_at", "due_at", "time_zone", "priority")
+	if msg != "" {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, msg)
+		return
+	}
+
+	itemId, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidItemId)
+		return
+	}
+
+	userID, err := auth.GetUserId(ctx)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusUnauthorized, invalidUserID)
+		return
+	}
+
+	version, ok := ifMatch(ctx)
+	if !ok {
+		utils.NewErrorResponse(ctx, http.StatusPreconditionFailed, invalidIfMatch)
+		return
+	}
+
+	res, err := client.UpdateTodoItem(context.Background(), &pb.UpdateTodoItemRequest{
+		Id:                  int64(itemId),
+		UserId:              userID,
+		Title:               req.Title,
+		Description:         req.Description,
+		Completed:           req.Completed,
+		StartAt:             utils.ToTimestamp(req.StartAt),
+		DueAt:               utils.ToTimestamp(req.DueAt),
+		TimeZone:            req.TimeZone,
+		Priority:            pb.Priority(req.Priority),
+		CompleteDescendants: req.CompleteDescendants,
+		Version:             version,
+		UpdateMask:          mask,
+	})
+
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	setETag(ctx, res.GetItem().GetVersion())
+	versionedJSON(ctx, res.Status, &res)
+}
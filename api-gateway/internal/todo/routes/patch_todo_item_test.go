@@ -0,0 +1,105 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestPatchTodoItem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		inputBody            string
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name: "completing todo item only",
+			mockClient: &mocks.MockTodoServiceClient{
+				UpdateTodoItemFunc: func(ctx context.Context, req *pb.UpdateTodoItemRequest) (*pb.UpdateTodoItemResponse, error) {
+					assert.Equal(t, []string{"completed"}, req.UpdateMask.GetPaths())
+					assert.True(t, req.Completed)
+					return &pb.UpdateTodoItemResponse{
+						Status: http.StatusOK,
+						Item: &pb.TodoItem{
+							Id:          1,
+							Title:       "title",
+							Description: "description",
+							Completed:   true,
+						},
+					}, nil
+				},
+			},
+			inputBody:            `{"completed":true}`,
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"item":{"id":1,"title":"title","description":"description","completed":true},"status":200}`,
+			userId:               1,
+		},
+		{
+			name: "clearing due date",
+			mockClient: &mocks.MockTodoServiceClient{
+				UpdateTodoItemFunc: func(ctx context.Context, req *pb.UpdateTodoItemRequest) (*pb.UpdateTodoItemResponse, error) {
+					assert.Equal(t, []string{"title", "due_at"}, req.UpdateMask.GetPaths())
+					assert.Nil(t, req.DueAt)
+					return &pb.UpdateTodoItemResponse{
+						Status: http.StatusOK,
+					}, nil
+				},
+			},
+			inputBody:            `{"due_at":null,"title":"title","id":1}`,
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"status":200}`,
+			userId:               1,
+		},
+		{
+			name:                 "no fields to update",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			inputBody:            `{"complete_descendants":true}`,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"body must set at least one field"}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid body",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			inputBody:            `{"completed":"yes"}`,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"invalid input body"}`,
+			userId:               1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodPatch, "/item/1", strings.NewReader(tt.inputBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			r.PATCH("/item/:id", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+
+				PatchTodoItem(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			assert.Equal(t, tt.expectedResponseBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+// PatchTodoList changes only the fields of the list the body sets.
+func PatchTodoList(ctx *gin.Context, client pb.TodoServiceClient) {
+	var req UpdateTodoListInput
+
+	mask, msg := bindPatch(ctx, &req, "title")
+	if msg != "" {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, msg)
+		return
+	}
+
+	userID, err := auth.GetUserId(ctx)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusUnauthorized, invalidUserID)
+		return
+	}
+
+	listId, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidListID)
+		return
+	}
+
+	version, ok := ifMatch(ctx)
+	if !ok {
+		utils.NewErrorResponse(ctx, http.StatusPreconditionFailed, invalidIfMatch)
+		return
+	}
+
+	res, err := client.UpdateTodoList(context.Background(), &pb.UpdateTodoListRequest{
+		UserId:     userID,
+		Id:         int64(listId),
+		Title:      req.Title,
+		Version:    version,
+		UpdateMask: mask,
+	})
+
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	setETag(ctx, res.GetList().GetVersion())
+	versionedJSON(ctx, res.Status, &res)
+}
@@ -0,0 +1,78 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestPatchTodoList(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		inputBody            string
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name: "renaming todo list",
+			mockClient: &mocks.MockTodoServiceClient{
+				UpdateTodoListFunc: func(ctx context.Context, req *pb.UpdateTodoListRequest) (*pb.UpdateTodoListResponse, error) {
+					assert.Equal(t, []string{"title"}, req.UpdateMask.GetPaths())
+					return &pb.UpdateTodoListResponse{
+						Status: http.StatusOK,
+						List: &pb.TodoList{
+							Id:    1,
+							Title: req.Title,
+						},
+					}, nil
+				},
+			},
+			inputBody:            `{"title":"title"}`,
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"list":{"id":1,"title":"title"},"status":200}`,
+			userId:               1,
+		},
+		{
+			name:                 "no fields to update",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			inputBody:            `{}`,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"body must set at least one field"}`,
+			userId:               1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodPatch, "/list/1", strings.NewReader(tt.inputBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			r.PATCH("/list/:id", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+
+				PatchTodoList(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			assert.Equal(t, tt.expectedResponseBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+)
+
+type PreviewOccurrencesInput struct {
+	Recurrence      string     `json:"recurrence"`
+	DueAt           *time.Time `json:"due_at"`
+	TimeZone        string     `json:"time_zone"`
+	RecurrenceCount int32      `json:"recurrence_count"`
+	RecurrenceUntil *time.Time `json:"recurrence_until"`
+	Limit           int32      `json:"limit"`
+}
+
+func PreviewOccurrences(ctx *gin.Context, client pb.TodoServiceClient) {
+	var req PreviewOccurrencesInput
+
+	if err := ctx.BindJSON(&req); err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidInputBody)
+		return
+	}
+
+	userID, err := auth.GetUserId(ctx)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusUnauthorized, invalidUserID)
+		return
+	}
+
+	if req.Limit < 0 {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidLimit)
+		return
+	}
+
+	res, err := client.GetUpcomingOccurrences(context.Background(), &pb.GetUpcomingOccurrencesRequest{
+		UserId:          userID,
+		Recurrence:      req.Recurrence,
+		DueAt:           utils.ToTimestamp(req.DueAt),
+		TimeZone:        req.TimeZone,
+		RecurrenceCount: req.RecurrenceCount,
+		RecurrenceUntil: utils.ToTimestamp(req.RecurrenceUntil),
+		Limit:           req.Limit,
+	})
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	ctx.JSON(http.StatusOK, &res)
+}
@@ -0,0 +1,88 @@
+package routes
+
+import (
+	"bytes"
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPreviewOccurrences(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		inputBody            string
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name: "successfully previewing occurrences",
+			mockClient: &mocks.MockTodoServiceClient{
+				GetUpcomingOccurrencesFunc: func(ctx context.Context, req *pb.GetUpcomingOccurrencesRequest) (*pb.GetUpcomingOccurrencesResponse, error) {
+					assert.Equal(t, "FREQ=WEEKLY;BYDAY=MO", req.Recurrence)
+					assert.Equal(t, time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC).Unix(), req.DueAt.Seconds)
+					assert.Equal(t, int32(4), req.RecurrenceCount)
+					return &pb.GetUpcomingOccurrencesResponse{
+						Status: http.StatusOK,
+					}, nil
+				},
+			},
+			inputBody:            `{"recurrence":"FREQ=WEEKLY;BYDAY=MO","due_at":"2024-01-01T09:00:00Z","recurrence_count":4}`,
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"status":200}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid input body",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			inputBody:            `{"recurrence":`,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"invalid input body"}`,
+			userId:               1,
+		},
+		{
+			name:                 "negative limit",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			inputBody:            `{"recurrence":"FREQ=DAILY","limit":-1}`,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"limit must be a positive integer"}`,
+			userId:               1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodPost, "/items/occurrences", bytes.NewBufferString(tt.inputBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			r.POST("/items/occurrences", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				PreviewOccurrences(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedResponseBody != "" {
+				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			}
+		})
+	}
+}
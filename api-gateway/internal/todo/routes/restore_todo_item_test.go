@@ -0,0 +1,90 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRestoreTodoItem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		query                string
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name: "successfully restoring todo item",
+			mockClient: &mocks.MockTodoServiceClient{
+				RestoreTodoItemFunc: func(ctx context.Context, req *pb.RestoreTodoItemRequest) (*pb.RestoreTodoItemResponse, error) {
+					return &pb.RestoreTodoItemResponse{
+						Success: true,
+						Status:  http.StatusOK,
+					}, nil
+				},
+			},
+			query:                "1/restore",
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"success":true,"status":200}`,
+			userId:               1,
+		},
+		{
+			name: "item of a trashed list",
+			mockClient: &mocks.MockTodoServiceClient{
+				RestoreTodoItemFunc: func(ctx context.Context, req *pb.RestoreTodoItemRequest) (*pb.RestoreTodoItemResponse, error) {
+					return &pb.RestoreTodoItemResponse{
+						Status: http.StatusConflict,
+						Error:  "The list of the item is in the trash, restore the list instead",
+					}, nil
+				},
+			},
+			query:                "1/restore",
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"status":409,"error":"The list of the item is in the trash, restore the list instead"}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid item id",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			query:                "abc/restore",
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"invalid must be integer"}`,
+			userId:               1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodPost, "/trash/items/"+tt.query, nil)
+
+			r.POST("/trash/items/:id/restore", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				RestoreTodoItem(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedResponseBody != "" {
+				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRestoreTodoList(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		query                string
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name: "successfully restoring todo list",
+			mockClient: &mocks.MockTodoServiceClient{
+				RestoreTodoListFunc: func(ctx context.Context, req *pb.RestoreTodoListRequest) (*pb.RestoreTodoListResponse, error) {
+					return &pb.RestoreTodoListResponse{
+						Success: true,
+						Status:  http.StatusOK,
+					}, nil
+				},
+			},
+			query:                "1/restore",
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"success":true,"status":200}`,
+			userId:               1,
+		},
+		{
+			name: "list not in the trash",
+			mockClient: &mocks.MockTodoServiceClient{
+				RestoreTodoListFunc: func(ctx context.Context, req *pb.RestoreTodoListRequest) (*pb.RestoreTodoListResponse, error) {
+					return &pb.RestoreTodoListResponse{
+						Status: http.StatusNotFound,
+						Error:  "List not found",
+					}, nil
+				},
+			},
+			query:                "1/restore",
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"status":404,"error":"List not found"}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid list id",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			query:                "abc/restore",
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"invalid list id"}`,
+			userId:               1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodPost, "/trash/lists/"+tt.query, nil)
+
+			r.POST("/trash/lists/:id/restore", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				RestoreTodoList(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedResponseBody != "" {
+				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strings"
+)
+
+var (
+	invalidQuery = "q must not be empty"
+)
+
+func SearchItems(ctx *gin.Context, client pb.TodoServiceClient) {
+	userID, err := auth.GetUserId(ctx)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusUnauthorized, invalidUserID)
+		return
+	}
+
+	query := strings.TrimSpace(ctx.Query("q"))
+	if query == "" {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidQuery)
+		return
+	}
+
+	limit, err := parseLimit(ctx.Query("limit"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidLimit)
+		return
+	}
+
+	res, err := client.SearchItems(context.Background(), &pb.SearchItemsRequest{
+		UserId: userID,
+		Query:  query,
+		Limit:  limit,
+	})
+
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	ctx.JSON(http.StatusOK, &res)
+}
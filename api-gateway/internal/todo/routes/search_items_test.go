@@ -0,0 +1,98 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSearchItems(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		query                string
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name: "successfully searching items",
+			mockClient: &mocks.MockTodoServiceClient{
+				SearchItemsFunc: func(ctx context.Context, in *pb.SearchItemsRequest) (*pb.SearchItemsResponse, error) {
+					assert.Equal(t, "unpaid invoices", in.Query)
+					assert.Equal(t, int32(5), in.Limit)
+					return &pb.SearchItemsResponse{
+						Results: []*pb.SearchResult{
+							{
+								Item:    &pb.TodoItem{Id: 3, Title: "Send invoice", ListId: 2},
+								List:    &pb.TodoList{Id: 2, Title: "Work"},
+								Snippet: "Send <mark>invoice</mark>",
+							},
+						},
+						Status: http.StatusOK,
+					}, nil
+				},
+			},
+			query:                "?q=unpaid+invoices&limit=5",
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"results":[{"item":{"id":3,"title":"Send invoice","list_id":2},"list":{"id":2,"title":"Work"},"snippet":"Send \u003cmark\u003einvoice\u003c/mark\u003e"}],"status":200}`,
+			userId:               1,
+		},
+		{
+			name:                 "missing query",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			query:                "?q=",
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"q must not be empty"}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid limit",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			query:                "?q=invoice&limit=all",
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"limit must be a positive integer"}`,
+			userId:               1,
+		},
+		{
+			name:                 "user not authorized",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			query:                "?q=invoice",
+			expectedStatusCode:   http.StatusUnauthorized,
+			expectedResponseBody: `{"message":"invalid user ID"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodGet, "/search"+tt.query, nil)
+
+			r.GET("/search", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				SearchItems(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedResponseBody != "" {
+				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+var (
+	invalidMemberID = "invalid member id"
+)
+
+type ShareListInput struct {
+	MemberId int64 `json:"member_id"`
+	Role     int32 `json:"role"`
+}
+
+func ShareList(ctx *gin.Context, client pb.TodoServiceClient) {
+	var req ShareListInput
+
+	if err := ctx.BindJSON(&req); err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidInputBody)
+		return
+	}
+
+	userID, err := auth.GetUserId(ctx)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusUnauthorized, invalidUserID)
+		return
+	}
+
+	listId, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidListID)
+		return
+	}
+
+	if req.MemberId <= 0 {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidMemberID)
+		return
+	}
+
+	res, err := client.ShareList(context.Background(), &pb.ShareListRequest{
+		UserId:   userID,
+		ListId:   int64(listId),
+		MemberId: req.MemberId,
+		Role:     pb.ListRole(req.Role),
+	})
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, &res)
+}
@@ -0,0 +1,56 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// SyncTodoTxt applies the todo.txt file in the body to the list in the path.
+// The response holds the list as todo.txt after the sync.
+func SyncTodoTxt(ctx *gin.Context, client pb.TodoServiceClient) {
+	userID, err := auth.GetUserId(ctx)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusUnauthorized, invalidUserID)
+		return
+	}
+
+	listId, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidListID)
+		return
+	}
+
+	dryRun := false
+	if value := ctx.Query("dry_run"); value != "" {
+		if dryRun, err = strconv.ParseBool(value); err != nil {
+			utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidDryRun)
+			return
+		}
+	}
+
+	content, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	res, err := client.SyncTodoTxt(context.Background(), &pb.SyncTodoTxtRequest{
+		UserId:  userID,
+		ListId:  int64(listId),
+		Content: string(content),
+		DryRun:  dryRun,
+	})
+
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	ctx.JSON(http.StatusOK, &res)
+}
@@ -0,0 +1,124 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSyncTodoTxt(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	content := "x Milk +Groceries id:5\nEggs\n"
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		listId               string
+		query                string
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name:   "successfully syncing a list",
+			listId: "2",
+			mockClient: &mocks.MockTodoServiceClient{
+				SyncTodoTxtFunc: func(ctx context.Context, req *pb.SyncTodoTxtRequest) (*pb.SyncTodoTxtResponse, error) {
+					assert.Equal(t, int64(1), req.UserId)
+					assert.Equal(t, int64(2), req.ListId)
+					assert.Equal(t, content, req.Content)
+					assert.False(t, req.DryRun)
+					return &pb.SyncTodoTxtResponse{
+						Created: 1,
+						Updated: 1,
+						Content: "x Milk +Groceries id:5\nEggs +Groceries id:6\n",
+						Status:  http.StatusOK,
+					}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"created":1,"updated":1,"content":"x Milk +Groceries id:5\nEggs +Groceries id:6\n","status":200}`,
+			userId:               1,
+		},
+		{
+			name:   "dry run",
+			listId: "2",
+			query:  "?dry_run=true",
+			mockClient: &mocks.MockTodoServiceClient{
+				SyncTodoTxtFunc: func(ctx context.Context, req *pb.SyncTodoTxtRequest) (*pb.SyncTodoTxtResponse, error) {
+					assert.True(t, req.DryRun)
+					return &pb.SyncTodoTxtResponse{Created: 1, Updated: 1, DryRun: true, Status: http.StatusOK}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"created":1,"updated":1,"dry_run":true,"status":200}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid dry run",
+			listId:               "2",
+			query:                "?dry_run=maybe",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"dry_run must be true or false"}`,
+			userId:               1,
+		},
+		{
+			name:   "client error",
+			listId: "2",
+			mockClient: &mocks.MockTodoServiceClient{
+				SyncTodoTxtFunc: func(ctx context.Context, req *pb.SyncTodoTxtRequest) (*pb.SyncTodoTxtResponse, error) {
+					return nil, errors.New("service unavailable")
+				},
+			},
+			expectedStatusCode:   http.StatusBadGateway,
+			expectedResponseBody: `{"message":"service unavailable"}`,
+			userId:               1,
+		},
+		{
+			name:                 "invalid list id",
+			listId:               "abc",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"invalid list id"}`,
+			userId:               1,
+		},
+		{
+			name:                 "unauthorized",
+			listId:               "2",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			expectedStatusCode:   http.StatusUnauthorized,
+			expectedResponseBody: `{"message":"invalid user ID"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodPut, "/lists/"+tt.listId+"/todo.txt"+tt.query, strings.NewReader(content))
+
+			r.PUT("/lists/:id/todo.txt", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				SyncTodoTxt(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+		})
+	}
+}
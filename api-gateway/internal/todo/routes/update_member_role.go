@@ -0,0 +1,56 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+type UpdateMemberRoleInput struct {
+	Role int32 `json:"role"`
+}
+
+func UpdateMemberRole(ctx *gin.Context, client pb.TodoServiceClient) {
+	var req UpdateMemberRoleInput
+
+	if err := ctx.BindJSON(&req); err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidInputBody)
+		return
+	}
+
+	userID, err := auth.GetUserId(ctx)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusUnauthorized, invalidUserID)
+		return
+	}
+
+	listId, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidListID)
+		return
+	}
+
+	memberId, err := strconv.Atoi(ctx.Param("memberId"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidMemberID)
+		return
+	}
+
+	res, err := client.UpdateMemberRole(context.Background(), &pb.UpdateMemberRoleRequest{
+		UserId:   userID,
+		ListId:   int64(listId),
+		MemberId: int64(memberId),
+		Role:     pb.ListRole(req.Role),
+	})
+
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	ctx.JSON(http.StatusOK, &res)
+}
@@ -0,0 +1,84 @@
+package routes
+
+import (
+	"bytes"
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestUpdateTag(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		inputBody            string
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name: "successfully renaming tag",
+			mockClient: &mocks.MockTodoServiceClient{
+				UpdateTagFunc: func(ctx context.Context, req *pb.UpdateTagRequest) (*pb.UpdateTagResponse, error) {
+					return &pb.UpdateTagResponse{
+						Status: http.StatusOK,
+						Tag:    &pb.Tag{Id: req.Id, Name: req.Name},
+					}, nil
+				},
+			},
+			inputBody:            `{"name":"waiting-on"}`,
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"tag":{"id":1,"name":"waiting-on"},"status":200}`,
+			userId:               1,
+		},
+		{
+			name: "tag not found",
+			mockClient: &mocks.MockTodoServiceClient{
+				UpdateTagFunc: func(ctx context.Context, req *pb.UpdateTagRequest) (*pb.UpdateTagResponse, error) {
+					return &pb.UpdateTagResponse{
+						Status: http.StatusNotFound,
+						Error:  "Tag not found",
+					}, nil
+				},
+			},
+			inputBody:            `{"name":"waiting-on"}`,
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"status":404,"error":"Tag not found"}`,
+			userId:               1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			req, _ := http.NewRequest(http.MethodPut, "/tags/1", bytes.NewBufferString(tt.inputBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			r.PUT("/tags/:id", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				UpdateTag(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedResponseBody != "" {
+				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			}
+		})
+	}
+}
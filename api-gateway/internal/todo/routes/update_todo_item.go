@@ -8,12 +8,18 @@ import (
 	"github.com/gin-gonic/gin"
 	"net/http"
 	"strconv"
+	"time"
 )
 
 type UpdateTodoItemInput struct {
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Completed   bool   `json:"completed"`
+	Title               string     `json:"title"`
+	Description         string     `json:"description"`
+	Completed           bool       `json:"completed"`
+	StartAt             *time.Time `json:"start_at"`
+	DueAt               *time.Time `json:"due_at"`
+	TimeZone            string     `json:"time_zone"`
+	Priority            int32      `json:"priority"`
+	CompleteDescendants bool       `json:"complete_descendants"`
 }
 
 func UpdateTodoItem(ctx *gin.Context, client pb.TodoServiceClient) {
@@ -36,12 +42,24 @@ func UpdateTodoItem(ctx *gin.Context, client pb.TodoServiceClient) {
 		return
 	}
 
+	version, ok := ifMatch(ctx)
+	if !ok {
+		utils.NewErrorResponse(ctx, http.StatusPreconditionFailed, invalidIfMatch)
+		return
+	}
+
 	res, err := client.UpdateTodoItem(context.Background(), &pb.UpdateTodoItemRequest{
-		Id:          int64(itemId),
-		UserId:      userID,
-		Title:       req.Title,
-		Description: req.Description,
-		Completed:   req.Completed,
+		Id:                  int64(itemId),
+		UserId:              userID,
+		Title:               req.Title,
+		Description:         req.Description,
+		Completed:           req.Completed,
+		StartAt:             utils.ToTimestamp(req.StartAt),
+		DueAt:               utils.ToTimestamp(req.DueAt),
+		TimeZone:            req.TimeZone,
+		Priority:            pb.Priority(req.Priority),
+		CompleteDescendants: req.CompleteDescendants,
+		Version:             version,
 	})
 
 	if err != nil {
@@ -49,5 +67,6 @@ func UpdateTodoItem(ctx *gin.Context, client pb.TodoServiceClient) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, &res)
+	setETag(ctx, res.GetItem().GetVersion())
+	versionedJSON(ctx, res.Status, &res)
 }
@@ -34,10 +34,17 @@ func UpdateTodoList(ctx *gin.Context, client pb.TodoServiceClient) {
 		return
 	}
 
+	version, ok := ifMatch(ctx)
+	if !ok {
+		utils.NewErrorResponse(ctx, http.StatusPreconditionFailed, invalidIfMatch)
+		return
+	}
+
 	res, err := client.UpdateTodoList(context.Background(), &pb.UpdateTodoListRequest{
-		UserId: userID,
-		Id:     int64(listId),
-		Title:  req.Title,
+		UserId:  userID,
+		Id:      int64(listId),
+		Title:   req.Title,
+		Version: version,
 	})
 
 	if err != nil {
@@ -45,5 +52,6 @@ func UpdateTodoList(ctx *gin.Context, client pb.TodoServiceClient) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, &res)
+	setETag(ctx, res.GetList().GetVersion())
+	versionedJSON(ctx, res.Status, &res)
 }
@@ -23,6 +23,8 @@ func TestUpdateTodoList(t *testing.T) {
 		expectedStatusCode   int
 		expectedResponseBody string
 		userId               int64
+		ifMatch              string
+		expectedETag         string
 	}{
 		{
 			name: "successfully updating todo list",
@@ -57,6 +59,56 @@ func TestUpdateTodoList(t *testing.T) {
 			expectedResponseBody: `{"status":502,"error":"invalid input body"}`,
 			userId:               1,
 		},
+		{
+			name: "forwarding If-Match version",
+			mockClient: &mocks.MockTodoServiceClient{
+				UpdateTodoListFunc: func(ctx context.Context, req *pb.UpdateTodoListRequest) (*pb.UpdateTodoListResponse, error) {
+					if req.Version != 3 {
+						return &pb.UpdateTodoListResponse{Status: http.StatusPreconditionFailed}, nil
+					}
+
+					return &pb.UpdateTodoListResponse{
+						Status: http.StatusOK,
+						List: &pb.TodoList{
+							Id:      1,
+							Title:   "title",
+							Version: 4,
+						},
+					}, nil
+				},
+			},
+			inputBody:            `{"title":"title"}`,
+			ifMatch:              `"3"`,
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: `{"list":{"id":1,"title":"title","version":4},"status":200}`,
+			expectedETag:         `"4"`,
+			userId:               1,
+		},
+		{
+			name: "stale If-Match version",
+			mockClient: &mocks.MockTodoServiceClient{
+				UpdateTodoListFunc: func(ctx context.Context, req *pb.UpdateTodoListRequest) (*pb.UpdateTodoListResponse, error) {
+					return &pb.UpdateTodoListResponse{
+						Status: http.StatusPreconditionFailed,
+						Error:  "stale",
+					}, nil
+				},
+			},
+			inputBody:            `{"title":"title"}`,
+			ifMatch:              `"2"`,
+			expectedStatusCode:   http.StatusPreconditionFailed,
+			expectedResponseBody: `{"status":412,"error":"stale"}`,
+			userId:               1,
+		},
+		{
+			name:                 "weak If-Match",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			inputBody:            `{"title":"title"}`,
+			ifMatch:              `W/"2"`,
+			expectedStatusCode:   http.StatusPreconditionFailed,
+			expectedResponseBody: `{"message":"If-Match must be a single ETag of the resource"}`,
+			userId:               1,
+		},
 	}
 
 	for _, tt := range tests {
@@ -65,6 +117,9 @@ func TestUpdateTodoList(t *testing.T) {
 
 			req, _ := http.NewRequest(http.MethodPut, "/list/1", strings.NewReader(tt.inputBody))
 			req.Header.Set("Content-Type", "application/json")
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
 
 			r.PUT("/list/:id", func(ctx *gin.Context) {
 				if tt.userId != 0 {
@@ -79,6 +134,7 @@ func TestUpdateTodoList(t *testing.T) {
 
 			assert.Equal(t, tt.expectedStatusCode, w.Code)
 			assert.Equal(t, tt.expectedResponseBody, w.Body.String())
+			assert.Equal(t, tt.expectedETag, w.Header().Get("ETag"))
 		})
 	}
 }
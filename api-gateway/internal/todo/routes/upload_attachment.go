@@ -0,0 +1,117 @@
+package routes
+
+import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+)
+
+var (
+	invalidMultipart = "request must be multipart/form-data with a file part"
+)
+
+// uploadChunk is how much of the file is sent per stream message.
+const uploadChunk = 64 << 10
+
+func UploadAttachment(ctx *gin.Context, client pb.TodoServiceClient) {
+	userID, err := auth.GetUserId(ctx)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusUnauthorized, invalidUserID)
+		return
+	}
+
+	itemId, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidItemId)
+		return
+	}
+
+	part, err := filePart(ctx.Request)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidMultipart)
+		return
+	}
+	defer part.Close()
+
+	streamCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.UploadAttachment(streamCtx)
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	err = stream.Send(&pb.UploadAttachmentRequest{
+		Data: &pb.UploadAttachmentRequest_Info{Info: &pb.AttachmentInfo{
+			UserId:   userID,
+			ItemId:   int64(itemId),
+			FileName: part.FileName(),
+		}},
+	})
+	if err == nil {
+		if err = sendChunks(stream, attachmentChunk, part); err != nil {
+			utils.NewErrorResponse(ctx, http.StatusBadRequest, invalidMultipart)
+			return
+		}
+	}
+
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		utils.NewErrorResponse(ctx, http.StatusBadGateway, err.Error())
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, &res)
+}
+
+func attachmentChunk(chunk []byte) *pb.UploadAttachmentRequest {
+	return &pb.UploadAttachmentRequest{
+		Data: &pb.UploadAttachmentRequest_Chunk{Chunk: chunk},
+	}
+}
+
+// filePart returns the "file" part of a multipart request without buffering it.
+func filePart(req *http.Request) (*multipart.Part, error) {
+	reader, err := req.MultipartReader()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			return nil, err
+		}
+		if part.FormName() == "file" && part.FileName() != "" {
+			return part, nil
+		}
+		part.Close()
+	}
+}
+
+// sendChunks streams r to the service. It only fails when r does: a failed
+// Send means the service stopped reading, and CloseAndRecv reports why.
+func sendChunks[Req any](stream interface{ Send(Req) error }, chunk func([]byte) Req, r io.Reader) error {
+	buf := make([]byte, uploadChunk)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			if stream.Send(chunk(buf[:n])) != nil {
+				return nil
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
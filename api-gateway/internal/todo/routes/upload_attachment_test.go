@@ -0,0 +1,160 @@
+package routes
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/auth"
+	pb "github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/api-gateway/internal/todo/routes/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// multipartBody builds a form with the given file under field.
+func multipartBody(field, fileName, content string) (*bytes.Buffer, string) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	_ = writer.WriteField("note", "ignored")
+	part, _ := writer.CreateFormFile(field, fileName)
+	_, _ = part.Write([]byte(content))
+	_ = writer.Close()
+	return body, writer.FormDataContentType()
+}
+
+func TestUploadAttachment(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	content := strings.Repeat("a", uploadChunk) + "tail"
+
+	tests := []struct {
+		name                 string
+		mockClient           *mocks.MockTodoServiceClient
+		field                string
+		contentType          string
+		expectedStatusCode   int
+		expectedResponseBody string
+		userId               int64
+	}{
+		{
+			name:  "successfully uploading an attachment",
+			field: "file",
+			mockClient: &mocks.MockTodoServiceClient{
+				UploadAttachmentFunc: func(ctx context.Context) (pb.TodoService_UploadAttachmentClient, error) {
+					return &mocks.MockUploadAttachmentClient{
+						CloseAndRecvFunc: func(requests []*pb.UploadAttachmentRequest) (*pb.UploadAttachmentResponse, error) {
+							info := requests[0].GetInfo()
+							assert.Equal(t, int64(1), info.UserId)
+							assert.Equal(t, int64(5), info.ItemId)
+							assert.Equal(t, "notes.txt", info.FileName)
+
+							var received []byte
+							for _, req := range requests[1:] {
+								received = append(received, req.GetChunk()...)
+							}
+							assert.Len(t, requests, 3)
+							assert.Equal(t, content, string(received))
+
+							return &pb.UploadAttachmentResponse{
+								Attachment: &pb.Attachment{Id: 3, ItemId: 5, UploaderId: 1, FileName: "notes.txt", ContentType: "text/plain; charset=utf-8", Size: int64(len(received))},
+								Status:     http.StatusCreated,
+							}, nil
+						},
+					}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusCreated,
+			expectedResponseBody: `{"attachment":{"id":3,"item_id":5,"uploader_id":1,"file_name":"notes.txt","content_type":"text/plain; charset=utf-8","size":65540},"status":201}`,
+			userId:               1,
+		},
+		{
+			name:  "type not allowed",
+			field: "file",
+			mockClient: &mocks.MockTodoServiceClient{
+				UploadAttachmentFunc: func(ctx context.Context) (pb.TodoService_UploadAttachmentClient, error) {
+					return &mocks.MockUploadAttachmentClient{
+						CloseAndRecvFunc: func(requests []*pb.UploadAttachmentRequest) (*pb.UploadAttachmentResponse, error) {
+							return &pb.UploadAttachmentResponse{
+								Status: http.StatusUnsupportedMediaType,
+								Error:  "Files of type text/plain; charset=utf-8 cannot be attached",
+							}, nil
+						},
+					}, nil
+				},
+			},
+			expectedStatusCode:   http.StatusCreated,
+			expectedResponseBody: `{"status":415,"error":"Files of type text/plain; charset=utf-8 cannot be attached"}`,
+			userId:               1,
+		},
+		{
+			name:  "service error",
+			field: "file",
+			mockClient: &mocks.MockTodoServiceClient{
+				UploadAttachmentFunc: func(ctx context.Context) (pb.TodoService_UploadAttachmentClient, error) {
+					return nil, errors.New("service unavailable")
+				},
+			},
+			expectedStatusCode:   http.StatusBadGateway,
+			expectedResponseBody: `{"message":"service unavailable"}`,
+			userId:               1,
+		},
+		{
+			name:                 "missing file part",
+			field:                "upload",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"request must be multipart/form-data with a file part"}`,
+			userId:               1,
+		},
+		{
+			name:                 "not multipart",
+			field:                "file",
+			contentType:          "application/json",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: `{"message":"request must be multipart/form-data with a file part"}`,
+			userId:               1,
+		},
+		{
+			name:                 "unauthorized",
+			field:                "file",
+			mockClient:           &mocks.MockTodoServiceClient{},
+			expectedStatusCode:   http.StatusUnauthorized,
+			expectedResponseBody: `{"message":"invalid user ID"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+
+			body, contentType := multipartBody(tt.field, "notes.txt", content)
+			if tt.contentType != "" {
+				contentType = tt.contentType
+			}
+			req, _ := http.NewRequest(http.MethodPost, "/items/5/attachments", body)
+			req.Header.Set("Content-Type", contentType)
+
+			r.POST("/items/:id/attachments", func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set(auth.Key, tt.userId)
+				}
+				UploadAttachment(ctx, tt.mockClient)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+
+			if tt.expectedResponseBody != "" {
+				assert.Equal(t, tt.expectedResponseBody, strings.TrimSpace(w.Body.String()))
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+// ToTimestamp converts an optional time into its protobuf representation.
+func ToTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// ParseTimestamp parses an optional RFC 3339 value, e.g. a query parameter.
+// An empty string yields a nil timestamp.
+func ParseTimestamp(value string) (*timestamppb.Timestamp, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return timestamppb.New(t), nil
+}
@@ -15,6 +15,7 @@ type UserRepository interface {
 	FindByEmail(email string) (*domain.User, error)
 	CreateUser(user *domain.User) error
 	FindByID(id int64) (*domain.User, error)
+	FindByIDs(ids []int64) ([]*domain.User, error)
 }
 
 func NewRepository(db *gorm.DB) *Repository {
@@ -37,3 +37,12 @@ func (ap *AuthPostgres) FindByID(id int64) (*domain.User, error) {
 	}
 	return &user, nil
 }
+
+func (ap *AuthPostgres) FindByIDs(ids []int64) ([]*domain.User, error) {
+	var users []*domain.User
+	result := ap.db.Where("id IN ?", ids).Order("id").Find(&users)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return users, nil
+}
@@ -180,3 +180,64 @@ func TestAuthPostgres_FindByID(t *testing.T) {
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestAuthPostgres_FindByIDs(t *testing.T) {
+	gormDB, mock, err := setupMockDB()
+	assert.NoError(t, err)
+
+	defer func() {
+		sqlDB, _ := gormDB.DB()
+		sqlDB.Close()
+	}()
+
+	userRepo := NewRepository(gormDB)
+
+	tests := []struct {
+		name          string
+		ids           []int64
+		mockSetup     func()
+		expectedUsers []*domain.User
+		expectedError error
+	}{
+		{
+			name: "Users Found",
+			ids:  []int64{1, 2},
+			mockSetup: func() {
+				mock.ExpectQuery(`SELECT \* FROM "users" WHERE id IN \(\$1,\$2\) ORDER BY id`).
+					WithArgs(1, 2).
+					WillReturnRows(sqlmock.NewRows(args).
+						AddRow(1, testEmail, hashedPassword))
+			},
+			expectedUsers: []*domain.User{{Id: 1, Email: testEmail, Password: hashedPassword}},
+			expectedError: nil,
+		},
+		{
+			name: "Database Error",
+			ids:  []int64{3},
+			mockSetup: func() {
+				mock.ExpectQuery(`SELECT \* FROM "users" WHERE id IN \(\$1\) ORDER BY id`).
+					WithArgs(3).
+					WillReturnError(sql.ErrConnDone)
+			},
+			expectedUsers: nil,
+			expectedError: sql.ErrConnDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+
+			users, err := userRepo.FindByIDs(tt.ids)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedUsers, users)
+		})
+	}
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"github.com/cloud9cloud9/go-grpc-todo/auth-svc/internal/domain"
 	pb "github.com/cloud9cloud9/go-grpc-todo/auth-svc/internal/pb"
 	"github.com/cloud9cloud9/go-grpc-todo/auth-svc/internal/repository"
@@ -13,6 +14,11 @@ var (
 	ErrUserNotFound = "User not found"
 	ErrEmailExists  = "E-Mail already exists"
 	ErrWrongPass    = "Wrong password"
+	ErrTooManyIds   = fmt.Sprintf("At most %d users can be requested at once", maxBatchSize)
+)
+
+const (
+	maxBatchSize = 100
 )
 
 type Server struct {
@@ -75,6 +81,7 @@ func (s *Server) Register(ctx context.Context, in *pb.RegisterRequest) (*pb.Regi
 
 	return &pb.RegisterResponse{
 		Status: http.StatusCreated,
+		UserId: user.Id,
 	}, nil
 }
 
@@ -100,3 +107,57 @@ func (s *Server) Validate(ctx context.Context, in *pb.ValidateRequest) (*pb.Vali
 		UserId: user.Id,
 	}, nil
 }
+
+func (s *Server) FindUserByEmail(ctx context.Context, in *pb.FindUserByEmailRequest) (*pb.FindUserByEmailResponse, error) {
+	user, err := s.Repo.FindByEmail(in.Email)
+	if err != nil {
+		return &pb.FindUserByEmailResponse{
+			Status: http.StatusNotFound,
+			Error:  ErrUserNotFound,
+		}, nil
+	}
+
+	return &pb.FindUserByEmailResponse{
+		User: &pb.User{
+			Id:    user.Id,
+			Email: user.Email,
+		},
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (s *Server) BatchGetUsers(ctx context.Context, in *pb.BatchGetUsersRequest) (*pb.BatchGetUsersResponse, error) {
+	if len(in.Ids) > maxBatchSize {
+		return &pb.BatchGetUsersResponse{
+			Status: http.StatusBadRequest,
+			Error:  ErrTooManyIds,
+		}, nil
+	}
+
+	if len(in.Ids) == 0 {
+		return &pb.BatchGetUsersResponse{
+			Status: http.StatusOK,
+		}, nil
+	}
+
+	users, err := s.Repo.FindByIDs(in.Ids)
+	if err != nil {
+		return &pb.BatchGetUsersResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	var res []*pb.User
+	for _, user := range users {
+		res = append(res, &pb.User{
+			Id:    user.Id,
+			Email: user.Email,
+		})
+	}
+
+	return &pb.BatchGetUsersResponse{
+		Users:  res,
+		Status: http.StatusOK,
+	}, nil
+}
@@ -144,7 +144,10 @@ func TestServer_Register(t *testing.T) {
 			password: testPassword,
 			mockRepoSetup: func() {
 				mockRepo.EXPECT().FindByEmail(testEmail).Return(nil, errors.New("user not found"))
-				mockRepo.EXPECT().CreateUser(gomock.Any()).Return(nil)
+				mockRepo.EXPECT().CreateUser(gomock.Any()).DoAndReturn(func(user *domain.User) error {
+					user.Id = 1
+					return nil
+				})
 			},
 			mockAuthSetup: func() {
 				mockAuthHelper.EXPECT().HashPassword(testPassword).Return(hashedPassword)
@@ -177,6 +180,8 @@ func TestServer_Register(t *testing.T) {
 			assert.Equal(t, tt.expectedStatus, int(resp.Status))
 			if tt.expectedError != "" {
 				assert.Equal(t, tt.expectedError, resp.Error)
+			} else {
+				assert.Equal(t, int64(1), resp.UserId)
 			}
 		})
 	}
@@ -242,3 +247,123 @@ func TestServer_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_FindUserByEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockUserRepository(ctrl)
+	s := Server{
+		Repo: mockRepo,
+	}
+
+	tests := []struct {
+		name           string
+		email          string
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name:  "Success",
+			email: testEmail,
+			mockRepoSetup: func() {
+				mockRepo.EXPECT().FindByEmail(testEmail).Return(&domain.User{Id: 1, Email: testEmail, Password: hashedPassword}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "User Not Found",
+			email: testEmail,
+			mockRepoSetup: func() {
+				mockRepo.EXPECT().FindByEmail(testEmail).Return(nil, errors.New("user not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+
+			resp, err := s.FindUserByEmail(context.Background(), &pb.FindUserByEmailRequest{Email: tt.email})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, resp.Error)
+			} else {
+				assert.Equal(t, &pb.User{Id: 1, Email: testEmail}, resp.User)
+			}
+		})
+	}
+}
+
+func TestServer_BatchGetUsers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mock.NewMockUserRepository(ctrl)
+	s := Server{
+		Repo: mockRepo,
+	}
+
+	tests := []struct {
+		name           string
+		ids            []int64
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+		expectedUsers  int
+	}{
+		{
+			name: "Success",
+			ids:  []int64{1, 2, 3},
+			mockRepoSetup: func() {
+				mockRepo.EXPECT().FindByIDs([]int64{1, 2, 3}).Return([]*domain.User{
+					{Id: 1, Email: testEmail},
+					{Id: 3, Email: "other@example.com"},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedUsers:  2,
+		},
+		{
+			name:           "No Ids",
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Too Many Ids",
+			ids:            make([]int64, maxBatchSize+1),
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  ErrTooManyIds,
+		},
+		{
+			name: "Database Error",
+			ids:  []int64{1},
+			mockRepoSetup: func() {
+				mockRepo.EXPECT().FindByIDs([]int64{1}).Return(nil, errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "db error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+
+			resp, err := s.BatchGetUsers(context.Background(), &pb.BatchGetUsersRequest{Ids: tt.ids})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, resp.Error)
+			}
+			assert.Len(t, resp.Users, tt.expectedUsers)
+		})
+	}
+}
@@ -1,15 +1,19 @@
 package api
 
 import (
+	"context"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/broker"
 	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/config"
 	pb "github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/pb"
 	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/repository"
 	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/service"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/storage"
 	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/pkg/db"
 	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/pkg/utils"
 	"google.golang.org/grpc"
 	"log"
 	"net"
+	"time"
 )
 
 type Server struct {
@@ -27,18 +31,52 @@ func NewServer(
 func (s *Server) Start() error {
 	database := db.ConnectToPostgreSQL(s.cfg)
 	repo := repository.NewRepository(database)
+	if err := repository.IndexItemsForSearch(database); err != nil {
+		log.Fatalln("failed at indexing items for search : ", err)
+	}
+	if err := repository.AssignPositions(database); err != nil {
+		log.Fatalln("failed at assigning positions : ", err)
+	}
 	mapper := utils.NewMapper()
 	log.Println("Database connected")
 
+	blobs, err := storage.New(s.cfg)
+	if err != nil {
+		log.Fatalln("failed at opening storage : ", err)
+	}
+
+	go s.purgeTrash(repo.Trash, repo.Attachment, blobs)
+	go s.purgeIdempotencyKeys(repo.Idempotency)
+
+	events := broker.New()
+	go events.Listen(context.Background(), db.DSN(s.cfg))
+
 	lis, err := net.Listen("tcp", s.cfg.Server.Port)
 	if err != nil {
 		log.Fatalln("failed at listening : ", err)
 	}
 	log.Println("Auth service started")
 	serv := service.Server{
-		ListRepo: repo.TodoList,
-		ItemRepo: repo.TodoItem,
-		Mapper:   mapper,
+		ListRepo:        repo.TodoList,
+		ItemRepo:        repo.TodoItem,
+		TagRepo:         repo.Tag,
+		InvitationRepo:  repo.Invitation,
+		CommentRepo:     repo.Comment,
+		AttachmentRepo:  repo.Attachment,
+		ActivityRepo:    repo.Activity,
+		TrashRepo:       repo.Trash,
+		TransferRepo:    repo.Transfer,
+		CalendarRepo:    repo.Calendar,
+		IdempotencyRepo: repo.Idempotency,
+		IdempotencyTTL:  s.cfg.Idempotency.TTL,
+		PendingKeyTTL:   s.cfg.Idempotency.Lease,
+		Storage:         blobs,
+		Events:          events,
+		Attachments: service.AttachmentPolicy{
+			MaxSize:      s.cfg.Attachments.MaxSize,
+			AllowedTypes: s.cfg.Attachments.AllowedTypes,
+		},
+		Mapper: mapper,
 	}
 	log.Println("Server created")
 
@@ -46,3 +84,63 @@ func (s *Server) Start() error {
 	pb.RegisterTodoServiceServer(grpcServ, &serv)
 	return grpcServ.Serve(lis)
 }
+
+// purgeTrash permanently deletes what has been in the trash for longer than
+// the retention window, checking every purge interval. Files attached to
+// purged items are deleted along with them. A purge interval that is not
+// positive turns purging off.
+func (s *Server) purgeTrash(trash repository.Trash, attachments repository.Attachment, blobs storage.Storage) {
+	if s.cfg.Trash.PurgeInterval <= 0 {
+		log.Println("Trash purge disabled")
+		return
+	}
+
+	ticker := time.NewTicker(s.cfg.Trash.PurgeInterval)
+	defer ticker.Stop()
+
+	for ; true; <-ticker.C {
+		if err := trash.Purge(time.Now().Add(-s.cfg.Trash.Retention)); err != nil {
+			log.Println("failed at purging trash : ", err)
+		}
+		if err := deleteOrphanedAttachments(attachments, blobs); err != nil {
+			log.Println("failed at deleting attachments : ", err)
+		}
+	}
+}
+
+// purgeIdempotencyKeys deletes the idempotency keys that have expired,
+// checking every purge interval. A purge interval that is not positive
+// turns purging off.
+func (s *Server) purgeIdempotencyKeys(keys repository.Idempotency) {
+	if s.cfg.Idempotency.PurgeInterval <= 0 {
+		log.Println("Idempotency key purge disabled")
+		return
+	}
+
+	ticker := time.NewTicker(s.cfg.Idempotency.PurgeInterval)
+	defer ticker.Stop()
+
+	for ; true; <-ticker.C {
+		if err := keys.Purge(time.Now().Add(-s.cfg.Idempotency.TTL)); err != nil {
+			log.Println("failed at purging idempotency keys : ", err)
+		}
+	}
+}
+
+func deleteOrphanedAttachments(attachments repository.Attachment, blobs storage.Storage) error {
+	orphans, err := attachments.GetOrphans()
+	if err != nil {
+		return err
+	}
+
+	for _, attachment := range orphans {
+		if err := blobs.Delete(context.Background(), attachment.StorageKey); err != nil {
+			return err
+		}
+		if err := attachments.Delete(attachment.Id, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package broker
+
+import (
+	"errors"
+	"sync"
+)
+
+var (
+	ErrLagged       = errors.New("subscriber fell behind")
+	ErrDisconnected = errors.New("lost connection to the database")
+)
+
+// subscriptionBuffer is how many events a subscriber can fall behind by
+// before it is dropped.
+const subscriptionBuffer = 64
+
+// Event tells that an activity entry was recorded in a list. Sequence is the
+// id of the entry.
+type Event struct {
+	ListId   int64
+	Sequence int64
+}
+
+// Broker hands events to whoever watches their list in this process.
+// Subscribers are never blocked on, those that fall behind are dropped and
+// are expected to catch up from the activity of the list.
+type Broker struct {
+	mu   sync.Mutex
+	subs map[int64]map[*Subscription]struct{}
+	// down is set while events announced by other replicas may be missed.
+	down bool
+}
+
+func New() *Broker {
+	return &Broker{
+		subs: make(map[int64]map[*Subscription]struct{}),
+	}
+}
+
+// Subscription receives the events of a list on C. C is closed when the
+// subscription is dropped, Err then tells why.
+type Subscription struct {
+	C      <-chan Event
+	c      chan Event
+	listId int64
+	broker *Broker
+	err    error
+}
+
+func (b *Broker) Subscribe(listId int64) *Subscription {
+	c := make(chan Event, subscriptionBuffer)
+	sub := &Subscription{C: c, c: c, listId: listId, broker: b}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.down {
+		sub.err = ErrDisconnected
+		close(c)
+		return sub
+	}
+
+	if b.subs[listId] == nil {
+		b.subs[listId] = make(map[*Subscription]struct{})
+	}
+	b.subs[listId][sub] = struct{}{}
+
+	return sub
+}
+
+// Close ends the subscription. Closing it more than once or after it was
+// dropped does nothing.
+func (s *Subscription) Close() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+
+	if _, ok := s.broker.subs[s.listId][s]; ok {
+		s.broker.remove(s)
+		close(s.c)
+	}
+}
+
+// Err returns why the subscription was dropped, nil while it is not.
+func (s *Subscription) Err() error {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+
+	return s.err
+}
+
+// Publish hands the event to the subscribers of its list.
+func (b *Broker) Publish(event Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for sub := range b.subs[event.ListId] {
+		select {
+		case sub.c <- event:
+		default:
+			b.drop(sub, ErrLagged)
+		}
+	}
+}
+
+// setDown drops every subscriber when events start being missed and lets
+// them subscribe again once they are not.
+func (b *Broker) setDown(down bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if down && !b.down {
+		for _, subs := range b.subs {
+			for sub := range subs {
+				b.drop(sub, ErrDisconnected)
+			}
+		}
+	}
+	b.down = down
+}
+
+// drop and remove must be called with the lock held.
+func (b *Broker) drop(sub *Subscription, err error) {
+	b.remove(sub)
+	sub.err = err
+	close(sub.c)
+}
+
+func (b *Broker) remove(sub *Subscription) {
+	delete(b.subs[sub.listId], sub)
+	if len(b.subs[sub.listId]) == 0 {
+		delete(b.subs, sub.listId)
+	}
+}
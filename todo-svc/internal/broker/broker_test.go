@@ -0,0 +1,77 @@
+package broker
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestBroker_Publish(t *testing.T) {
+	b := New()
+	first := b.Subscribe(1)
+	second := b.Subscribe(1)
+	other := b.Subscribe(2)
+
+	b.Publish(Event{ListId: 1, Sequence: 7})
+
+	assert.Equal(t, Event{ListId: 1, Sequence: 7}, <-first.C)
+	assert.Equal(t, Event{ListId: 1, Sequence: 7}, <-second.C)
+	assert.Empty(t, other.C)
+
+	second.Close()
+	second.Close()
+	_, ok := <-second.C
+	assert.False(t, ok)
+	assert.NoError(t, second.Err())
+
+	b.Publish(Event{ListId: 1, Sequence: 8})
+	assert.Equal(t, Event{ListId: 1, Sequence: 8}, <-first.C)
+}
+
+func TestBroker_Lagged(t *testing.T) {
+	b := New()
+	slow := b.Subscribe(1)
+
+	for sequence := int64(1); sequence <= subscriptionBuffer+1; sequence++ {
+		b.Publish(Event{ListId: 1, Sequence: sequence})
+	}
+
+	var received int
+	for range slow.C {
+		received++
+	}
+	assert.Equal(t, subscriptionBuffer, received)
+	assert.ErrorIs(t, slow.Err(), ErrLagged)
+
+	slow.Close()
+	assert.ErrorIs(t, slow.Err(), ErrLagged)
+}
+
+func TestBroker_Down(t *testing.T) {
+	b := New()
+	sub := b.Subscribe(1)
+
+	b.setDown(true)
+	_, ok := <-sub.C
+	assert.False(t, ok)
+	assert.ErrorIs(t, sub.Err(), ErrDisconnected)
+
+	sub = b.Subscribe(1)
+	_, ok = <-sub.C
+	assert.False(t, ok, "nobody subscribes while events are missed")
+	assert.ErrorIs(t, sub.Err(), ErrDisconnected)
+
+	b.setDown(false)
+	sub = b.Subscribe(1)
+	b.Publish(Event{ListId: 1, Sequence: 3})
+	assert.Equal(t, Event{ListId: 1, Sequence: 3}, <-sub.C)
+}
+
+func TestPayload(t *testing.T) {
+	event, err := ParsePayload(Payload(Event{ListId: 12, Sequence: 345}))
+	require.NoError(t, err)
+	assert.Equal(t, Event{ListId: 12, Sequence: 345}, event)
+
+	_, err = ParsePayload("12")
+	assert.Error(t, err)
+}
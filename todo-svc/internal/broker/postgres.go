@@ -0,0 +1,77 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"log"
+	"time"
+)
+
+// Channel is the Postgres channel activity entries are announced on, so that
+// every replica learns about changes made through the others.
+const Channel = "list_activity"
+
+const reconnectDelay = 5 * time.Second
+
+// Payload is what announces the event on the channel.
+func Payload(event Event) string {
+	return fmt.Sprintf("%d:%d", event.ListId, event.Sequence)
+}
+
+func ParsePayload(payload string) (Event, error) {
+	var event Event
+	if _, err := fmt.Sscanf(payload, "%d:%d", &event.ListId, &event.Sequence); err != nil {
+		return Event{}, fmt.Errorf("invalid payload %q: %w", payload, err)
+	}
+
+	return event, nil
+}
+
+// Listen publishes the events announced on the channel until ctx is done.
+// Subscribers are dropped whenever the connection is lost, since events may
+// have been missed until it is back, and cannot subscribe in the meantime.
+func (b *Broker) Listen(ctx context.Context, dsn string) {
+	b.setDown(true)
+	for {
+		err := b.listen(ctx, dsn)
+		b.setDown(true)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Println("failed at listening for activity : ", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconnectDelay):
+		}
+	}
+}
+
+func (b *Broker) listen(ctx context.Context, dsn string) error {
+	conn, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{Channel}.Sanitize()); err != nil {
+		return err
+	}
+	b.setDown(false)
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		event, err := ParsePayload(notification.Payload)
+		if err != nil {
+			log.Println("failed at reading activity : ", err)
+			continue
+		}
+		b.Publish(event)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/ilyakaznacheev/cleanenv"
 	"github.com/joho/godotenv"
 	"log"
+	"time"
 )
 
 const (
@@ -23,6 +24,41 @@ type Config struct {
 		PostgresDB       string `yaml:"database" env:"POSTGRES_TODO_DB"`
 		PostgresSSLMode  string `yaml:"sslmode"`
 	} `yaml:"db"`
+
+	Trash struct {
+		Retention     time.Duration `yaml:"retention" env:"TRASH_RETENTION" env-default:"720h"`
+		PurgeInterval time.Duration `yaml:"purge_interval" env:"TRASH_PURGE_INTERVAL" env-default:"1h"`
+	} `yaml:"trash"`
+
+	Idempotency struct {
+		// How long responses are replayed to retries with the same key.
+		TTL           time.Duration `yaml:"ttl" env:"IDEMPOTENCY_TTL" env-default:"24h"`
+		PurgeInterval time.Duration `yaml:"purge_interval" env:"IDEMPOTENCY_PURGE_INTERVAL" env-default:"1h"`
+		// How long a request holds its key before retries may claim it
+		// again, in case the request never finished.
+		Lease time.Duration `yaml:"lease" env:"IDEMPOTENCY_LEASE" env-default:"1m"`
+	} `yaml:"idempotency"`
+
+	Attachments struct {
+		MaxSize int64 `yaml:"max_size" env:"ATTACHMENT_MAX_SIZE" env-default:"10485760"`
+		// Checked against the type sniffed from the contents, not the one
+		// sent by the client.
+		AllowedTypes []string `yaml:"allowed_types" env:"ATTACHMENT_ALLOWED_TYPES" env-separator:"," env-default:"image/png,image/jpeg,image/gif,image/webp,application/pdf,text/plain"`
+
+		Storage struct {
+			// Either "local" or "s3".
+			Driver string `yaml:"driver" env:"STORAGE_DRIVER" env-default:"local"`
+			Path   string `yaml:"path" env:"STORAGE_PATH" env-default:"data/attachments"`
+
+			S3 struct {
+				Endpoint  string `yaml:"endpoint" env:"S3_ENDPOINT"`
+				Region    string `yaml:"region" env:"S3_REGION"`
+				Bucket    string `yaml:"bucket" env:"S3_BUCKET"`
+				AccessKey string `yaml:"access_key" env:"S3_ACCESS_KEY"`
+				SecretKey string `yaml:"secret_key" env:"S3_SECRET_KEY"`
+			} `yaml:"s3"`
+		} `yaml:"storage"`
+	} `yaml:"attachments"`
 }
 
 var Instance *Config
@@ -1,28 +1,309 @@
 package domain
 
+import (
+	"encoding/json"
+	"gorm.io/gorm"
+	"time"
+)
+
+// TodoList and TodoItem are soft-deleted. Trashed rows are skipped by queries
+// unless they are Unscoped and purged once they are older than the retention
+// window.
 type TodoList struct {
-	Id          int64      `json:"id" gorm:"primaryKey"`
-	Title       string     `json:"title" binding:"required"`
-	Description string     `json:"description"`
-	Items       []TodoItem `gorm:"foreignKey:ListId"`
+	Id          int64          `json:"id" gorm:"primaryKey"`
+	Title       string         `json:"title" binding:"required"`
+	Description string         `json:"description"`
+	Items       []TodoItem     `json:"items,omitempty" gorm:"foreignKey:ListId"`
+	DeletedAt   gorm.DeletedAt `json:"deleted_at" gorm:"index"`
+	ArchivedAt  *time.Time     `json:"archived_at"`                    // items of archived lists are read-only
+	Position    string         `json:"position" gorm:"->;-:migration"` // of the user's membership
+	// Bumped on every change to the list itself, see TodoItem.Version.
+	Version int64 `json:"version" gorm:"not null;default:1"`
 }
 
 type UsersList struct {
 	Id     int64 `json:"id" gorm:"primaryKey"`
-	UserId int64 `json:"user_id"`
-	ListId int64 `json:"list_id"`
+	UserId int64 `json:"user_id" gorm:"uniqueIndex:idx_users_lists"`
+	ListId int64 `json:"list_id" gorm:"uniqueIndex:idx_users_lists;index"`
+	Role   Role  `json:"role" gorm:"default:3"`
+	// Where the list goes when the user sorts their lists manually.
+	Position string `json:"position" gorm:"not null;default:''"`
+}
+
+// Role is what a member is allowed to do with a shared list. Every role can
+// do everything the roles before it can.
+type Role int32
+
+const (
+	RoleViewer Role = iota + 1
+	RoleEditor
+	RoleOwner
+)
+
+func (r Role) Valid() bool {
+	return r >= RoleViewer && r <= RoleOwner
 }
 
 type TodoItem struct {
-	Id          int64  `json:"id" gorm:"primaryKey"`
-	Title       string `json:"title" binding:"required"`
-	Description string `json:"description"`
-	Done        bool   `json:"done"`
-	ListId      int64  `json:"list_id"` // зв'язок з TodoList
+	Id              int64       `json:"id" gorm:"primaryKey"`
+	Title           string      `json:"title" binding:"required"`
+	Description     string      `json:"description"`
+	Done            bool        `json:"done"`
+	StartAt         *time.Time  `json:"start_at"`
+	DueAt           *time.Time  `json:"due_at" gorm:"index"`
+	TimeZone        string      `json:"time_zone"`
+	Priority        Priority    `json:"priority" gorm:"default:0"`
+	Recurrence      string      `json:"recurrence"`       // RRULE without DTSTART
+	RecurrenceStart *time.Time  `json:"recurrence_start"` // due date of the first occurrence
+	CreatedAt       time.Time   `json:"created_at"`
+	ListId          int64       `json:"list_id"` // зв'язок з TodoList
+	ParentId        *int64      `json:"parent_id" gorm:"index"`
+	Tags            []Tag       `json:"tags" gorm:"-"`
+	Children        []*TodoItem `json:"children" gorm:"-"`
+	Position        string      `json:"position" gorm:"not null;default:''"` // lexorank key within the list
+	// key:value pairs of imported todo.txt tasks that no other field holds,
+	// written back when the item is exported as todo.txt.
+	Extensions map[string]string `json:"extensions,omitempty" gorm:"type:jsonb;serializer:json"`
+	// Items of a trashed list share its DeletedAt so that they are restored
+	// along with it.
+	DeletedAt gorm.DeletedAt `json:"deleted_at" gorm:"index"`
+	// Maintained by the repository from the title and description.
+	SearchVector string `json:"-" gorm:"type:tsvector;index:idx_todo_items_search,type:gin;<-:false;->:false"`
+	// Bumped on every change to the item. Changes based on an older version
+	// are turned down, so that nobody overwrites what they have not seen.
+	Version int64 `json:"version" gorm:"not null;default:1"`
+}
+
+// MaxItemDepth is how deep subtasks can be nested, top-level items included.
+const MaxItemDepth = 5
+
+type Priority int32
+
+const (
+	PriorityNone Priority = iota
+	PriorityLow
+	PriorityMedium
+	PriorityHigh
+	PriorityUrgent
+)
+
+func (p Priority) Valid() bool {
+	return p >= PriorityNone && p <= PriorityUrgent
+}
+
+type ItemSortField int32
+
+const (
+	ItemSortManual ItemSortField = iota
+	ItemSortPriority
+	ItemSortCreated
+	ItemSortDue
+	ItemSortTitle
+)
+
+// ItemSort describes how the items of a list are ordered.
+type ItemSort struct {
+	Field      ItemSortField `json:"field"`
+	Descending bool          `json:"descending"`
+}
+
+// Invitation shares a list with whoever owns Email. Invitations to e-mails
+// nobody has signed up with yet get their InviteeId once that user registers.
+type Invitation struct {
+	Id        int64     `json:"id" gorm:"primaryKey"`
+	ListId    int64     `json:"list_id" gorm:"index"`
+	Email     string    `json:"email" gorm:"index"`
+	InviteeId *int64    `json:"invitee_id" gorm:"index"`
+	InviterId int64     `json:"inviter_id"`
+	Role      Role      `json:"role"`
+	Token     string    `json:"token" gorm:"uniqueIndex"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// SearchResult is an item matching a full-text search together with the
+// title of its list.
+type SearchResult struct {
+	Item      TodoItem `gorm:"embedded"`
+	ListTitle string
+	Rank      float64
+	Snippet   string
+}
+
+// Comment is a message a list member left on an item.
+type Comment struct {
+	Id        int64     `json:"id" gorm:"primaryKey"`
+	ItemId    int64     `json:"item_id" gorm:"index"`
+	AuthorId  int64     `json:"author_id"`
+	Body      string    `json:"body"`
+	Edited    bool      `json:"edited"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Attachment describes a file attached to an item. Its contents live in the
+// blob storage under StorageKey.
+type Attachment struct {
+	Id          int64     `json:"id" gorm:"primaryKey"`
+	ItemId      int64     `json:"item_id" gorm:"index"`
+	UploaderId  int64     `json:"uploader_id"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	StorageKey  string    `json:"-" gorm:"uniqueIndex"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Activity is an entry of the audit trail of a list: who did what to which
+// target, with snapshots of the target before and after the change. Changes
+// that only concern one user, such as the order of their lists or their own
+// tags, are not recorded.
+type Activity struct {
+	Id         int64           `json:"id" gorm:"primaryKey"`
+	ListId     int64           `json:"list_id" gorm:"index"`
+	ActorId    int64           `json:"actor_id"`
+	Action     Action          `json:"action"`
+	TargetType TargetType      `json:"target_type"`
+	TargetId   int64           `json:"target_id"`
+	Before     json.RawMessage `json:"before" gorm:"type:jsonb"` // empty for created targets
+	After      json.RawMessage `json:"after" gorm:"type:jsonb"`  // empty for deleted targets
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+// IdempotencyKey remembers the response to a request a user sent with an
+// idempotency key, so that retries of the request get the same response
+// instead of repeating it.
+type IdempotencyKey struct {
+	UserId      int64     `json:"user_id" gorm:"primaryKey;autoIncrement:false"`
+	Key         string    `json:"key" gorm:"primaryKey"`
+	RequestHash string    `json:"request_hash"` // of the method and the request
+	Response    []byte    `json:"response"`     // empty while the request is handled
+	CreatedAt   time.Time `json:"created_at" gorm:"index"`
+}
+
+// CalendarFeed gives access to the calendar of a user to whoever knows its
+// Token, so that calendar apps can subscribe without logging in.
+type CalendarFeed struct {
+	UserId    int64     `json:"user_id" gorm:"primaryKey;autoIncrement:false"`
+	Token     string    `json:"token" gorm:"uniqueIndex"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
+type Action string
+
+const (
+	ActionCreated    Action = "created"
+	ActionUpdated    Action = "updated"
+	ActionDeleted    Action = "deleted"
+	ActionRestored   Action = "restored"
+	ActionPurged     Action = "purged"
+	ActionArchived   Action = "archived"
+	ActionUnarchived Action = "unarchived"
+	ActionCompleted  Action = "completed"
+	ActionMoved      Action = "moved"
+	ActionCopied     Action = "copied"
+	ActionAdded      Action = "added"
+	ActionRemoved    Action = "removed"
+	ActionAccepted   Action = "accepted"
+	ActionDeclined   Action = "declined"
+)
+
+type TargetType string
+
+const (
+	TargetList       TargetType = "list"
+	TargetItem       TargetType = "item"
+	TargetMember     TargetType = "member"
+	TargetInvitation TargetType = "invitation"
+	TargetTag        TargetType = "tag"
+	TargetComment    TargetType = "comment"
+	TargetAttachment TargetType = "attachment"
+)
+
 type ListsItem struct {
 	Id     int64 `json:"id" gorm:"primaryKey"`
 	ListId int64 `json:"list_id"`
 	ItemId int64 `json:"item_id"`
 }
+
+type Tag struct {
+	Id     int64  `json:"id" gorm:"primaryKey"`
+	UserId int64  `json:"user_id" gorm:"uniqueIndex:idx_tags_user_name"`
+	Name   string `json:"name" gorm:"uniqueIndex:idx_tags_user_name"`
+}
+
+type ItemsTag struct {
+	Id     int64 `json:"id" gorm:"primaryKey"`
+	ItemId int64 `json:"item_id" gorm:"uniqueIndex:idx_items_tags"`
+	TagId  int64 `json:"tag_id" gorm:"uniqueIndex:idx_items_tags;index"`
+}
+
+type ListFilter struct {
+	TagIds          []int64
+	Title           string
+	IncludeArchived bool
+}
+
+type ItemFilter struct {
+	TagIds []int64
+	Title  string
+	Done   *bool
+}
+
+// ItemBatch is a set of changes to items that are applied together or not at
+// all.
+type ItemBatch struct {
+	Save []*TodoItem
+	// Next maps the ids of completed recurring items in Save to their next
+	// occurrence.
+	Next map[int64]*TodoItem
+	// Delete holds the ids of items moved to the trash with their subtasks.
+	Delete []int64
+}
+
+// ItemSync is a set of changes to the items of a list read from a file, that
+// are applied together or not at all.
+type ItemSync struct {
+	Update []*TodoItem
+	// Next maps the ids of completed recurring items in Update to their next
+	// occurrence.
+	Next map[int64]*TodoItem
+	// Create holds the items added to the end of the list.
+	Create []*TodoItem
+}
+
+type ListSort int32
+
+const (
+	ListSortCreated ListSort = iota
+	ListSortManual
+)
+
+// ListCursor points at the last list of a page, the next page starts after it.
+type ListCursor struct {
+	Sort     ListSort `json:"sort,omitempty"`
+	Id       int64    `json:"id"`
+	Position string   `json:"position,omitempty"`
+}
+
+// CommentCursor points at the last comment of a page.
+type CommentCursor struct {
+	Id int64 `json:"id"`
+}
+
+// ActivityCursor points at the last entry of a page of a list's activity.
+type ActivityCursor struct {
+	Id int64 `json:"id"`
+}
+
+// ItemCursor points at the last item of a page. Besides the id it keeps the
+// value of the column the items are sorted by.
+type ItemCursor struct {
+	Sort      ItemSort   `json:"sort"`
+	Id        int64      `json:"id"`
+	Priority  Priority   `json:"priority,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	DueAt     *time.Time `json:"due_at,omitempty"`
+	Title     string     `json:"title,omitempty"`
+	Position  string     `json:"position,omitempty"`
+}
@@ -19,16 +19,62 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TodoService_CreateTodoList_FullMethodName  = "/todo.TodoService/CreateTodoList"
-	TodoService_GetTodoListById_FullMethodName = "/todo.TodoService/GetTodoListById"
-	TodoService_GetTodoLists_FullMethodName    = "/todo.TodoService/GetTodoLists"
-	TodoService_UpdateTodoList_FullMethodName  = "/todo.TodoService/UpdateTodoList"
-	TodoService_DeleteTodoList_FullMethodName  = "/todo.TodoService/DeleteTodoList"
-	TodoService_CreateTodoItem_FullMethodName  = "/todo.TodoService/CreateTodoItem"
-	TodoService_GetTodoItemById_FullMethodName = "/todo.TodoService/GetTodoItemById"
-	TodoService_GetTodoItems_FullMethodName    = "/todo.TodoService/GetTodoItems"
-	TodoService_UpdateTodoItem_FullMethodName  = "/todo.TodoService/UpdateTodoItem"
-	TodoService_DeleteTodoItem_FullMethodName  = "/todo.TodoService/DeleteTodoItem"
+	TodoService_CreateTodoList_FullMethodName         = "/todo.TodoService/CreateTodoList"
+	TodoService_GetTodoListById_FullMethodName        = "/todo.TodoService/GetTodoListById"
+	TodoService_GetTodoLists_FullMethodName           = "/todo.TodoService/GetTodoLists"
+	TodoService_UpdateTodoList_FullMethodName         = "/todo.TodoService/UpdateTodoList"
+	TodoService_DeleteTodoList_FullMethodName         = "/todo.TodoService/DeleteTodoList"
+	TodoService_ArchiveTodoList_FullMethodName        = "/todo.TodoService/ArchiveTodoList"
+	TodoService_UnarchiveTodoList_FullMethodName      = "/todo.TodoService/UnarchiveTodoList"
+	TodoService_MoveTodoList_FullMethodName           = "/todo.TodoService/MoveTodoList"
+	TodoService_ListTrash_FullMethodName              = "/todo.TodoService/ListTrash"
+	TodoService_RestoreTodoList_FullMethodName        = "/todo.TodoService/RestoreTodoList"
+	TodoService_RestoreTodoItem_FullMethodName        = "/todo.TodoService/RestoreTodoItem"
+	TodoService_EmptyTrash_FullMethodName             = "/todo.TodoService/EmptyTrash"
+	TodoService_ShareList_FullMethodName              = "/todo.TodoService/ShareList"
+	TodoService_UpdateMemberRole_FullMethodName       = "/todo.TodoService/UpdateMemberRole"
+	TodoService_RemoveMember_FullMethodName           = "/todo.TodoService/RemoveMember"
+	TodoService_ListMembers_FullMethodName            = "/todo.TodoService/ListMembers"
+	TodoService_CreateInvitation_FullMethodName       = "/todo.TodoService/CreateInvitation"
+	TodoService_GetInvitations_FullMethodName         = "/todo.TodoService/GetInvitations"
+	TodoService_AcceptInvitation_FullMethodName       = "/todo.TodoService/AcceptInvitation"
+	TodoService_DeclineInvitation_FullMethodName      = "/todo.TodoService/DeclineInvitation"
+	TodoService_ActivateInvitations_FullMethodName    = "/todo.TodoService/ActivateInvitations"
+	TodoService_CreateTodoItem_FullMethodName         = "/todo.TodoService/CreateTodoItem"
+	TodoService_GetTodoItemById_FullMethodName        = "/todo.TodoService/GetTodoItemById"
+	TodoService_GetTodoItems_FullMethodName           = "/todo.TodoService/GetTodoItems"
+	TodoService_UpdateTodoItem_FullMethodName         = "/todo.TodoService/UpdateTodoItem"
+	TodoService_DeleteTodoItem_FullMethodName         = "/todo.TodoService/DeleteTodoItem"
+	TodoService_MoveTodoItem_FullMethodName           = "/todo.TodoService/MoveTodoItem"
+	TodoService_MoveTodoItems_FullMethodName          = "/todo.TodoService/MoveTodoItems"
+	TodoService_CopyTodoItems_FullMethodName          = "/todo.TodoService/CopyTodoItems"
+	TodoService_BatchUpdateTodoItems_FullMethodName   = "/todo.TodoService/BatchUpdateTodoItems"
+	TodoService_GetDueItems_FullMethodName            = "/todo.TodoService/GetDueItems"
+	TodoService_GetOverdueItems_FullMethodName        = "/todo.TodoService/GetOverdueItems"
+	TodoService_GetUpcomingOccurrences_FullMethodName = "/todo.TodoService/GetUpcomingOccurrences"
+	TodoService_SearchItems_FullMethodName            = "/todo.TodoService/SearchItems"
+	TodoService_CreateTag_FullMethodName              = "/todo.TodoService/CreateTag"
+	TodoService_GetTags_FullMethodName                = "/todo.TodoService/GetTags"
+	TodoService_UpdateTag_FullMethodName              = "/todo.TodoService/UpdateTag"
+	TodoService_DeleteTag_FullMethodName              = "/todo.TodoService/DeleteTag"
+	TodoService_AttachTag_FullMethodName              = "/todo.TodoService/AttachTag"
+	TodoService_DetachTag_FullMethodName              = "/todo.TodoService/DetachTag"
+	TodoService_AddComment_FullMethodName             = "/todo.TodoService/AddComment"
+	TodoService_ListComments_FullMethodName           = "/todo.TodoService/ListComments"
+	TodoService_EditComment_FullMethodName            = "/todo.TodoService/EditComment"
+	TodoService_DeleteComment_FullMethodName          = "/todo.TodoService/DeleteComment"
+	TodoService_UploadAttachment_FullMethodName       = "/todo.TodoService/UploadAttachment"
+	TodoService_DownloadAttachment_FullMethodName     = "/todo.TodoService/DownloadAttachment"
+	TodoService_ListAttachments_FullMethodName        = "/todo.TodoService/ListAttachments"
+	TodoService_DeleteAttachment_FullMethodName       = "/todo.TodoService/DeleteAttachment"
+	TodoService_GetListActivity_FullMethodName        = "/todo.TodoService/GetListActivity"
+	TodoService_WatchList_FullMethodName              = "/todo.TodoService/WatchList"
+	TodoService_ExportLists_FullMethodName            = "/todo.TodoService/ExportLists"
+	TodoService_ImportLists_FullMethodName            = "/todo.TodoService/ImportLists"
+	TodoService_GetCalendarFeed_FullMethodName        = "/todo.TodoService/GetCalendarFeed"
+	TodoService_ResetCalendarFeed_FullMethodName      = "/todo.TodoService/ResetCalendarFeed"
+	TodoService_GetCalendar_FullMethodName            = "/todo.TodoService/GetCalendar"
+	TodoService_SyncTodoTxt_FullMethodName            = "/todo.TodoService/SyncTodoTxt"
 )
 
 // TodoServiceClient is the client API for TodoService service.
@@ -40,11 +86,57 @@ type TodoServiceClient interface {
 	GetTodoLists(ctx context.Context, in *GetTodoListsRequest, opts ...grpc.CallOption) (*GetTodoListsResponse, error)
 	UpdateTodoList(ctx context.Context, in *UpdateTodoListRequest, opts ...grpc.CallOption) (*UpdateTodoListResponse, error)
 	DeleteTodoList(ctx context.Context, in *DeleteTodoListRequest, opts ...grpc.CallOption) (*DeleteTodoListResponse, error)
+	ArchiveTodoList(ctx context.Context, in *ArchiveTodoListRequest, opts ...grpc.CallOption) (*ArchiveTodoListResponse, error)
+	UnarchiveTodoList(ctx context.Context, in *UnarchiveTodoListRequest, opts ...grpc.CallOption) (*UnarchiveTodoListResponse, error)
+	MoveTodoList(ctx context.Context, in *MoveTodoListRequest, opts ...grpc.CallOption) (*MoveTodoListResponse, error)
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	RestoreTodoList(ctx context.Context, in *RestoreTodoListRequest, opts ...grpc.CallOption) (*RestoreTodoListResponse, error)
+	RestoreTodoItem(ctx context.Context, in *RestoreTodoItemRequest, opts ...grpc.CallOption) (*RestoreTodoItemResponse, error)
+	EmptyTrash(ctx context.Context, in *EmptyTrashRequest, opts ...grpc.CallOption) (*EmptyTrashResponse, error)
+	ShareList(ctx context.Context, in *ShareListRequest, opts ...grpc.CallOption) (*ShareListResponse, error)
+	UpdateMemberRole(ctx context.Context, in *UpdateMemberRoleRequest, opts ...grpc.CallOption) (*UpdateMemberRoleResponse, error)
+	RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error)
+	ListMembers(ctx context.Context, in *ListMembersRequest, opts ...grpc.CallOption) (*ListMembersResponse, error)
+	CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...grpc.CallOption) (*CreateInvitationResponse, error)
+	GetInvitations(ctx context.Context, in *GetInvitationsRequest, opts ...grpc.CallOption) (*GetInvitationsResponse, error)
+	AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*AcceptInvitationResponse, error)
+	DeclineInvitation(ctx context.Context, in *DeclineInvitationRequest, opts ...grpc.CallOption) (*DeclineInvitationResponse, error)
+	ActivateInvitations(ctx context.Context, in *ActivateInvitationsRequest, opts ...grpc.CallOption) (*ActivateInvitationsResponse, error)
 	CreateTodoItem(ctx context.Context, in *CreateTodoItemRequest, opts ...grpc.CallOption) (*CreateTodoItemResponse, error)
 	GetTodoItemById(ctx context.Context, in *GetTodoItemRequest, opts ...grpc.CallOption) (*GetTodoItemResponse, error)
 	GetTodoItems(ctx context.Context, in *GetTodoItemsRequest, opts ...grpc.CallOption) (*GetTodoItemsResponse, error)
 	UpdateTodoItem(ctx context.Context, in *UpdateTodoItemRequest, opts ...grpc.CallOption) (*UpdateTodoItemResponse, error)
 	DeleteTodoItem(ctx context.Context, in *DeleteTodoItemRequest, opts ...grpc.CallOption) (*DeleteTodoItemResponse, error)
+	MoveTodoItem(ctx context.Context, in *MoveTodoItemRequest, opts ...grpc.CallOption) (*MoveTodoItemResponse, error)
+	MoveTodoItems(ctx context.Context, in *MoveTodoItemsRequest, opts ...grpc.CallOption) (*MoveTodoItemsResponse, error)
+	CopyTodoItems(ctx context.Context, in *CopyTodoItemsRequest, opts ...grpc.CallOption) (*CopyTodoItemsResponse, error)
+	BatchUpdateTodoItems(ctx context.Context, in *BatchUpdateTodoItemsRequest, opts ...grpc.CallOption) (*BatchUpdateTodoItemsResponse, error)
+	GetDueItems(ctx context.Context, in *GetDueItemsRequest, opts ...grpc.CallOption) (*GetDueItemsResponse, error)
+	GetOverdueItems(ctx context.Context, in *GetOverdueItemsRequest, opts ...grpc.CallOption) (*GetOverdueItemsResponse, error)
+	GetUpcomingOccurrences(ctx context.Context, in *GetUpcomingOccurrencesRequest, opts ...grpc.CallOption) (*GetUpcomingOccurrencesResponse, error)
+	SearchItems(ctx context.Context, in *SearchItemsRequest, opts ...grpc.CallOption) (*SearchItemsResponse, error)
+	CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*CreateTagResponse, error)
+	GetTags(ctx context.Context, in *GetTagsRequest, opts ...grpc.CallOption) (*GetTagsResponse, error)
+	UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...grpc.CallOption) (*UpdateTagResponse, error)
+	DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error)
+	AttachTag(ctx context.Context, in *AttachTagRequest, opts ...grpc.CallOption) (*AttachTagResponse, error)
+	DetachTag(ctx context.Context, in *DetachTagRequest, opts ...grpc.CallOption) (*DetachTagResponse, error)
+	AddComment(ctx context.Context, in *AddCommentRequest, opts ...grpc.CallOption) (*AddCommentResponse, error)
+	ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error)
+	EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*EditCommentResponse, error)
+	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse], error)
+	DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error)
+	ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error)
+	DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error)
+	GetListActivity(ctx context.Context, in *GetListActivityRequest, opts ...grpc.CallOption) (*GetListActivityResponse, error)
+	WatchList(ctx context.Context, in *WatchListRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchListResponse], error)
+	ExportLists(ctx context.Context, in *ExportListsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportListsResponse], error)
+	ImportLists(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportListsRequest, ImportListsResponse], error)
+	GetCalendarFeed(ctx context.Context, in *GetCalendarFeedRequest, opts ...grpc.CallOption) (*GetCalendarFeedResponse, error)
+	ResetCalendarFeed(ctx context.Context, in *ResetCalendarFeedRequest, opts ...grpc.CallOption) (*ResetCalendarFeedResponse, error)
+	GetCalendar(ctx context.Context, in *GetCalendarRequest, opts ...grpc.CallOption) (*GetCalendarResponse, error)
+	SyncTodoTxt(ctx context.Context, in *SyncTodoTxtRequest, opts ...grpc.CallOption) (*SyncTodoTxtResponse, error)
 }
 
 type todoServiceClient struct {
@@ -105,6 +197,166 @@ func (c *todoServiceClient) DeleteTodoList(ctx context.Context, in *DeleteTodoLi
 	return out, nil
 }
 
+func (c *todoServiceClient) ArchiveTodoList(ctx context.Context, in *ArchiveTodoListRequest, opts ...grpc.CallOption) (*ArchiveTodoListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveTodoListResponse)
+	err := c.cc.Invoke(ctx, TodoService_ArchiveTodoList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) UnarchiveTodoList(ctx context.Context, in *UnarchiveTodoListRequest, opts ...grpc.CallOption) (*UnarchiveTodoListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnarchiveTodoListResponse)
+	err := c.cc.Invoke(ctx, TodoService_UnarchiveTodoList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) MoveTodoList(ctx context.Context, in *MoveTodoListRequest, opts ...grpc.CallOption) (*MoveTodoListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveTodoListResponse)
+	err := c.cc.Invoke(ctx, TodoService_MoveTodoList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, TodoService_ListTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) RestoreTodoList(ctx context.Context, in *RestoreTodoListRequest, opts ...grpc.CallOption) (*RestoreTodoListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreTodoListResponse)
+	err := c.cc.Invoke(ctx, TodoService_RestoreTodoList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) RestoreTodoItem(ctx context.Context, in *RestoreTodoItemRequest, opts ...grpc.CallOption) (*RestoreTodoItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreTodoItemResponse)
+	err := c.cc.Invoke(ctx, TodoService_RestoreTodoItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) EmptyTrash(ctx context.Context, in *EmptyTrashRequest, opts ...grpc.CallOption) (*EmptyTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyTrashResponse)
+	err := c.cc.Invoke(ctx, TodoService_EmptyTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ShareList(ctx context.Context, in *ShareListRequest, opts ...grpc.CallOption) (*ShareListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareListResponse)
+	err := c.cc.Invoke(ctx, TodoService_ShareList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) UpdateMemberRole(ctx context.Context, in *UpdateMemberRoleRequest, opts ...grpc.CallOption) (*UpdateMemberRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMemberRoleResponse)
+	err := c.cc.Invoke(ctx, TodoService_UpdateMemberRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...grpc.CallOption) (*RemoveMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveMemberResponse)
+	err := c.cc.Invoke(ctx, TodoService_RemoveMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ListMembers(ctx context.Context, in *ListMembersRequest, opts ...grpc.CallOption) (*ListMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMembersResponse)
+	err := c.cc.Invoke(ctx, TodoService_ListMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...grpc.CallOption) (*CreateInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateInvitationResponse)
+	err := c.cc.Invoke(ctx, TodoService_CreateInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) GetInvitations(ctx context.Context, in *GetInvitationsRequest, opts ...grpc.CallOption) (*GetInvitationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInvitationsResponse)
+	err := c.cc.Invoke(ctx, TodoService_GetInvitations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*AcceptInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptInvitationResponse)
+	err := c.cc.Invoke(ctx, TodoService_AcceptInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) DeclineInvitation(ctx context.Context, in *DeclineInvitationRequest, opts ...grpc.CallOption) (*DeclineInvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeclineInvitationResponse)
+	err := c.cc.Invoke(ctx, TodoService_DeclineInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ActivateInvitations(ctx context.Context, in *ActivateInvitationsRequest, opts ...grpc.CallOption) (*ActivateInvitationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActivateInvitationsResponse)
+	err := c.cc.Invoke(ctx, TodoService_ActivateInvitations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *todoServiceClient) CreateTodoItem(ctx context.Context, in *CreateTodoItemRequest, opts ...grpc.CallOption) (*CreateTodoItemResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateTodoItemResponse)
@@ -155,257 +407,1559 @@ func (c *todoServiceClient) DeleteTodoItem(ctx context.Context, in *DeleteTodoIt
 	return out, nil
 }
 
-// TodoServiceServer is the server API for TodoService service.
-// All implementations must embed UnimplementedTodoServiceServer
-// for forward compatibility.
-type TodoServiceServer interface {
-	CreateTodoList(context.Context, *CreateTodoListRequest) (*CreateTodoListResponse, error)
-	GetTodoListById(context.Context, *GetTodoListRequest) (*GetTodoListResponse, error)
-	GetTodoLists(context.Context, *GetTodoListsRequest) (*GetTodoListsResponse, error)
-	UpdateTodoList(context.Context, *UpdateTodoListRequest) (*UpdateTodoListResponse, error)
-	DeleteTodoList(context.Context, *DeleteTodoListRequest) (*DeleteTodoListResponse, error)
-	CreateTodoItem(context.Context, *CreateTodoItemRequest) (*CreateTodoItemResponse, error)
-	GetTodoItemById(context.Context, *GetTodoItemRequest) (*GetTodoItemResponse, error)
-	GetTodoItems(context.Context, *GetTodoItemsRequest) (*GetTodoItemsResponse, error)
-	UpdateTodoItem(context.Context, *UpdateTodoItemRequest) (*UpdateTodoItemResponse, error)
-	DeleteTodoItem(context.Context, *DeleteTodoItemRequest) (*DeleteTodoItemResponse, error)
-	mustEmbedUnimplementedTodoServiceServer()
+func (c *todoServiceClient) MoveTodoItem(ctx context.Context, in *MoveTodoItemRequest, opts ...grpc.CallOption) (*MoveTodoItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveTodoItemResponse)
+	err := c.cc.Invoke(ctx, TodoService_MoveTodoItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedTodoServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedTodoServiceServer struct{}
+func (c *todoServiceClient) MoveTodoItems(ctx context.Context, in *MoveTodoItemsRequest, opts ...grpc.CallOption) (*MoveTodoItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveTodoItemsResponse)
+	err := c.cc.Invoke(ctx, TodoService_MoveTodoItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedTodoServiceServer) CreateTodoList(context.Context, *CreateTodoListRequest) (*CreateTodoListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTodoList not implemented")
+func (c *todoServiceClient) CopyTodoItems(ctx context.Context, in *CopyTodoItemsRequest, opts ...grpc.CallOption) (*CopyTodoItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CopyTodoItemsResponse)
+	err := c.cc.Invoke(ctx, TodoService_CopyTodoItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedTodoServiceServer) GetTodoListById(context.Context, *GetTodoListRequest) (*GetTodoListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTodoListById not implemented")
+
+func (c *todoServiceClient) BatchUpdateTodoItems(ctx context.Context, in *BatchUpdateTodoItemsRequest, opts ...grpc.CallOption) (*BatchUpdateTodoItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchUpdateTodoItemsResponse)
+	err := c.cc.Invoke(ctx, TodoService_BatchUpdateTodoItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedTodoServiceServer) GetTodoLists(context.Context, *GetTodoListsRequest) (*GetTodoListsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTodoLists not implemented")
+
+func (c *todoServiceClient) GetDueItems(ctx context.Context, in *GetDueItemsRequest, opts ...grpc.CallOption) (*GetDueItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDueItemsResponse)
+	err := c.cc.Invoke(ctx, TodoService_GetDueItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedTodoServiceServer) UpdateTodoList(context.Context, *UpdateTodoListRequest) (*UpdateTodoListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTodoList not implemented")
+
+func (c *todoServiceClient) GetOverdueItems(ctx context.Context, in *GetOverdueItemsRequest, opts ...grpc.CallOption) (*GetOverdueItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOverdueItemsResponse)
+	err := c.cc.Invoke(ctx, TodoService_GetOverdueItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedTodoServiceServer) DeleteTodoList(context.Context, *DeleteTodoListRequest) (*DeleteTodoListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTodoList not implemented")
+
+func (c *todoServiceClient) GetUpcomingOccurrences(ctx context.Context, in *GetUpcomingOccurrencesRequest, opts ...grpc.CallOption) (*GetUpcomingOccurrencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUpcomingOccurrencesResponse)
+	err := c.cc.Invoke(ctx, TodoService_GetUpcomingOccurrences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedTodoServiceServer) CreateTodoItem(context.Context, *CreateTodoItemRequest) (*CreateTodoItemResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTodoItem not implemented")
+
+func (c *todoServiceClient) SearchItems(ctx context.Context, in *SearchItemsRequest, opts ...grpc.CallOption) (*SearchItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchItemsResponse)
+	err := c.cc.Invoke(ctx, TodoService_SearchItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*CreateTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTagResponse)
+	err := c.cc.Invoke(ctx, TodoService_CreateTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) GetTags(ctx context.Context, in *GetTagsRequest, opts ...grpc.CallOption) (*GetTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTagsResponse)
+	err := c.cc.Invoke(ctx, TodoService_GetTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...grpc.CallOption) (*UpdateTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTagResponse)
+	err := c.cc.Invoke(ctx, TodoService_UpdateTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTagResponse)
+	err := c.cc.Invoke(ctx, TodoService_DeleteTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) AttachTag(ctx context.Context, in *AttachTagRequest, opts ...grpc.CallOption) (*AttachTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachTagResponse)
+	err := c.cc.Invoke(ctx, TodoService_AttachTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) DetachTag(ctx context.Context, in *DetachTagRequest, opts ...grpc.CallOption) (*DetachTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetachTagResponse)
+	err := c.cc.Invoke(ctx, TodoService_DetachTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) AddComment(ctx context.Context, in *AddCommentRequest, opts ...grpc.CallOption) (*AddCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddCommentResponse)
+	err := c.cc.Invoke(ctx, TodoService_AddComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommentsResponse)
+	err := c.cc.Invoke(ctx, TodoService_ListComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) EditComment(ctx context.Context, in *EditCommentRequest, opts ...grpc.CallOption) (*EditCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EditCommentResponse)
+	err := c.cc.Invoke(ctx, TodoService_EditComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCommentResponse)
+	err := c.cc.Invoke(ctx, TodoService_DeleteComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TodoService_ServiceDesc.Streams[0], TodoService_UploadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadAttachmentRequest, UploadAttachmentResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TodoService_UploadAttachmentClient = grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse]
+
+func (c *todoServiceClient) DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TodoService_ServiceDesc.Streams[1], TodoService_DownloadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadAttachmentRequest, DownloadAttachmentResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TodoService_DownloadAttachmentClient = grpc.ServerStreamingClient[DownloadAttachmentResponse]
+
+func (c *todoServiceClient) ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAttachmentsResponse)
+	err := c.cc.Invoke(ctx, TodoService_ListAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAttachmentResponse)
+	err := c.cc.Invoke(ctx, TodoService_DeleteAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) GetListActivity(ctx context.Context, in *GetListActivityRequest, opts ...grpc.CallOption) (*GetListActivityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetListActivityResponse)
+	err := c.cc.Invoke(ctx, TodoService_GetListActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) WatchList(ctx context.Context, in *WatchListRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchListResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TodoService_ServiceDesc.Streams[2], TodoService_WatchList_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchListRequest, WatchListResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TodoService_WatchListClient = grpc.ServerStreamingClient[WatchListResponse]
+
+func (c *todoServiceClient) ExportLists(ctx context.Context, in *ExportListsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportListsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TodoService_ServiceDesc.Streams[3], TodoService_ExportLists_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportListsRequest, ExportListsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TodoService_ExportListsClient = grpc.ServerStreamingClient[ExportListsResponse]
+
+func (c *todoServiceClient) ImportLists(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportListsRequest, ImportListsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TodoService_ServiceDesc.Streams[4], TodoService_ImportLists_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportListsRequest, ImportListsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TodoService_ImportListsClient = grpc.ClientStreamingClient[ImportListsRequest, ImportListsResponse]
+
+func (c *todoServiceClient) GetCalendarFeed(ctx context.Context, in *GetCalendarFeedRequest, opts ...grpc.CallOption) (*GetCalendarFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCalendarFeedResponse)
+	err := c.cc.Invoke(ctx, TodoService_GetCalendarFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ResetCalendarFeed(ctx context.Context, in *ResetCalendarFeedRequest, opts ...grpc.CallOption) (*ResetCalendarFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetCalendarFeedResponse)
+	err := c.cc.Invoke(ctx, TodoService_ResetCalendarFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) GetCalendar(ctx context.Context, in *GetCalendarRequest, opts ...grpc.CallOption) (*GetCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCalendarResponse)
+	err := c.cc.Invoke(ctx, TodoService_GetCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) SyncTodoTxt(ctx context.Context, in *SyncTodoTxtRequest, opts ...grpc.CallOption) (*SyncTodoTxtResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncTodoTxtResponse)
+	err := c.cc.Invoke(ctx, TodoService_SyncTodoTxt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TodoServiceServer is the server API for TodoService service.
+// All implementations must embed UnimplementedTodoServiceServer
+// for forward compatibility.
+type TodoServiceServer interface {
+	CreateTodoList(context.Context, *CreateTodoListRequest) (*CreateTodoListResponse, error)
+	GetTodoListById(context.Context, *GetTodoListRequest) (*GetTodoListResponse, error)
+	GetTodoLists(context.Context, *GetTodoListsRequest) (*GetTodoListsResponse, error)
+	UpdateTodoList(context.Context, *UpdateTodoListRequest) (*UpdateTodoListResponse, error)
+	DeleteTodoList(context.Context, *DeleteTodoListRequest) (*DeleteTodoListResponse, error)
+	ArchiveTodoList(context.Context, *ArchiveTodoListRequest) (*ArchiveTodoListResponse, error)
+	UnarchiveTodoList(context.Context, *UnarchiveTodoListRequest) (*UnarchiveTodoListResponse, error)
+	MoveTodoList(context.Context, *MoveTodoListRequest) (*MoveTodoListResponse, error)
+	ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	RestoreTodoList(context.Context, *RestoreTodoListRequest) (*RestoreTodoListResponse, error)
+	RestoreTodoItem(context.Context, *RestoreTodoItemRequest) (*RestoreTodoItemResponse, error)
+	EmptyTrash(context.Context, *EmptyTrashRequest) (*EmptyTrashResponse, error)
+	ShareList(context.Context, *ShareListRequest) (*ShareListResponse, error)
+	UpdateMemberRole(context.Context, *UpdateMemberRoleRequest) (*UpdateMemberRoleResponse, error)
+	RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error)
+	ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error)
+	CreateInvitation(context.Context, *CreateInvitationRequest) (*CreateInvitationResponse, error)
+	GetInvitations(context.Context, *GetInvitationsRequest) (*GetInvitationsResponse, error)
+	AcceptInvitation(context.Context, *AcceptInvitationRequest) (*AcceptInvitationResponse, error)
+	DeclineInvitation(context.Context, *DeclineInvitationRequest) (*DeclineInvitationResponse, error)
+	ActivateInvitations(context.Context, *ActivateInvitationsRequest) (*ActivateInvitationsResponse, error)
+	CreateTodoItem(context.Context, *CreateTodoItemRequest) (*CreateTodoItemResponse, error)
+	GetTodoItemById(context.Context, *GetTodoItemRequest) (*GetTodoItemResponse, error)
+	GetTodoItems(context.Context, *GetTodoItemsRequest) (*GetTodoItemsResponse, error)
+	UpdateTodoItem(context.Context, *UpdateTodoItemRequest) (*UpdateTodoItemResponse, error)
+	DeleteTodoItem(context.Context, *DeleteTodoItemRequest) (*DeleteTodoItemResponse, error)
+	MoveTodoItem(context.Context, *MoveTodoItemRequest) (*MoveTodoItemResponse, error)
+	MoveTodoItems(context.Context, *MoveTodoItemsRequest) (*MoveTodoItemsResponse, error)
+	CopyTodoItems(context.Context, *CopyTodoItemsRequest) (*CopyTodoItemsResponse, error)
+	BatchUpdateTodoItems(context.Context, *BatchUpdateTodoItemsRequest) (*BatchUpdateTodoItemsResponse, error)
+	GetDueItems(context.Context, *GetDueItemsRequest) (*GetDueItemsResponse, error)
+	GetOverdueItems(context.Context, *GetOverdueItemsRequest) (*GetOverdueItemsResponse, error)
+	GetUpcomingOccurrences(context.Context, *GetUpcomingOccurrencesRequest) (*GetUpcomingOccurrencesResponse, error)
+	SearchItems(context.Context, *SearchItemsRequest) (*SearchItemsResponse, error)
+	CreateTag(context.Context, *CreateTagRequest) (*CreateTagResponse, error)
+	GetTags(context.Context, *GetTagsRequest) (*GetTagsResponse, error)
+	UpdateTag(context.Context, *UpdateTagRequest) (*UpdateTagResponse, error)
+	DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error)
+	AttachTag(context.Context, *AttachTagRequest) (*AttachTagResponse, error)
+	DetachTag(context.Context, *DetachTagRequest) (*DetachTagResponse, error)
+	AddComment(context.Context, *AddCommentRequest) (*AddCommentResponse, error)
+	ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error)
+	EditComment(context.Context, *EditCommentRequest) (*EditCommentResponse, error)
+	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
+	UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]) error
+	DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error
+	ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error)
+	DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error)
+	GetListActivity(context.Context, *GetListActivityRequest) (*GetListActivityResponse, error)
+	WatchList(*WatchListRequest, grpc.ServerStreamingServer[WatchListResponse]) error
+	ExportLists(*ExportListsRequest, grpc.ServerStreamingServer[ExportListsResponse]) error
+	ImportLists(grpc.ClientStreamingServer[ImportListsRequest, ImportListsResponse]) error
+	GetCalendarFeed(context.Context, *GetCalendarFeedRequest) (*GetCalendarFeedResponse, error)
+	ResetCalendarFeed(context.Context, *ResetCalendarFeedRequest) (*ResetCalendarFeedResponse, error)
+	GetCalendar(context.Context, *GetCalendarRequest) (*GetCalendarResponse, error)
+	SyncTodoTxt(context.Context, *SyncTodoTxtRequest) (*SyncTodoTxtResponse, error)
+	mustEmbedUnimplementedTodoServiceServer()
+}
+
+// UnimplementedTodoServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTodoServiceServer struct{}
+
+func (UnimplementedTodoServiceServer) CreateTodoList(context.Context, *CreateTodoListRequest) (*CreateTodoListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTodoList not implemented")
+}
+func (UnimplementedTodoServiceServer) GetTodoListById(context.Context, *GetTodoListRequest) (*GetTodoListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTodoListById not implemented")
+}
+func (UnimplementedTodoServiceServer) GetTodoLists(context.Context, *GetTodoListsRequest) (*GetTodoListsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTodoLists not implemented")
+}
+func (UnimplementedTodoServiceServer) UpdateTodoList(context.Context, *UpdateTodoListRequest) (*UpdateTodoListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTodoList not implemented")
+}
+func (UnimplementedTodoServiceServer) DeleteTodoList(context.Context, *DeleteTodoListRequest) (*DeleteTodoListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTodoList not implemented")
+}
+func (UnimplementedTodoServiceServer) ArchiveTodoList(context.Context, *ArchiveTodoListRequest) (*ArchiveTodoListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveTodoList not implemented")
+}
+func (UnimplementedTodoServiceServer) UnarchiveTodoList(context.Context, *UnarchiveTodoListRequest) (*UnarchiveTodoListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnarchiveTodoList not implemented")
+}
+func (UnimplementedTodoServiceServer) MoveTodoList(context.Context, *MoveTodoListRequest) (*MoveTodoListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveTodoList not implemented")
+}
+func (UnimplementedTodoServiceServer) ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedTodoServiceServer) RestoreTodoList(context.Context, *RestoreTodoListRequest) (*RestoreTodoListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTodoList not implemented")
+}
+func (UnimplementedTodoServiceServer) RestoreTodoItem(context.Context, *RestoreTodoItemRequest) (*RestoreTodoItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTodoItem not implemented")
+}
+func (UnimplementedTodoServiceServer) EmptyTrash(context.Context, *EmptyTrashRequest) (*EmptyTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmptyTrash not implemented")
+}
+func (UnimplementedTodoServiceServer) ShareList(context.Context, *ShareListRequest) (*ShareListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareList not implemented")
+}
+func (UnimplementedTodoServiceServer) UpdateMemberRole(context.Context, *UpdateMemberRoleRequest) (*UpdateMemberRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMemberRole not implemented")
+}
+func (UnimplementedTodoServiceServer) RemoveMember(context.Context, *RemoveMemberRequest) (*RemoveMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMember not implemented")
+}
+func (UnimplementedTodoServiceServer) ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMembers not implemented")
+}
+func (UnimplementedTodoServiceServer) CreateInvitation(context.Context, *CreateInvitationRequest) (*CreateInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvitation not implemented")
+}
+func (UnimplementedTodoServiceServer) GetInvitations(context.Context, *GetInvitationsRequest) (*GetInvitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvitations not implemented")
+}
+func (UnimplementedTodoServiceServer) AcceptInvitation(context.Context, *AcceptInvitationRequest) (*AcceptInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvitation not implemented")
+}
+func (UnimplementedTodoServiceServer) DeclineInvitation(context.Context, *DeclineInvitationRequest) (*DeclineInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineInvitation not implemented")
+}
+func (UnimplementedTodoServiceServer) ActivateInvitations(context.Context, *ActivateInvitationsRequest) (*ActivateInvitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateInvitations not implemented")
+}
+func (UnimplementedTodoServiceServer) CreateTodoItem(context.Context, *CreateTodoItemRequest) (*CreateTodoItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTodoItem not implemented")
+}
+func (UnimplementedTodoServiceServer) GetTodoItemById(context.Context, *GetTodoItemRequest) (*GetTodoItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTodoItemById not implemented")
+}
+func (UnimplementedTodoServiceServer) GetTodoItems(context.Context, *GetTodoItemsRequest) (*GetTodoItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTodoItems not implemented")
+}
+func (UnimplementedTodoServiceServer) UpdateTodoItem(context.Context, *UpdateTodoItemRequest) (*UpdateTodoItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTodoItem not implemented")
+}
+func (UnimplementedTodoServiceServer) DeleteTodoItem(context.Context, *DeleteTodoItemRequest) (*DeleteTodoItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTodoItem not implemented")
+}
+func (UnimplementedTodoServiceServer) MoveTodoItem(context.Context, *MoveTodoItemRequest) (*MoveTodoItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveTodoItem not implemented")
+}
+func (UnimplementedTodoServiceServer) MoveTodoItems(context.Context, *MoveTodoItemsRequest) (*MoveTodoItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveTodoItems not implemented")
+}
+func (UnimplementedTodoServiceServer) CopyTodoItems(context.Context, *CopyTodoItemsRequest) (*CopyTodoItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyTodoItems not implemented")
+}
+func (UnimplementedTodoServiceServer) BatchUpdateTodoItems(context.Context, *BatchUpdateTodoItemsRequest) (*BatchUpdateTodoItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateTodoItems not implemented")
+}
+func (UnimplementedTodoServiceServer) GetDueItems(context.Context, *GetDueItemsRequest) (*GetDueItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDueItems not implemented")
+}
+func (UnimplementedTodoServiceServer) GetOverdueItems(context.Context, *GetOverdueItemsRequest) (*GetOverdueItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOverdueItems not implemented")
+}
+func (UnimplementedTodoServiceServer) GetUpcomingOccurrences(context.Context, *GetUpcomingOccurrencesRequest) (*GetUpcomingOccurrencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUpcomingOccurrences not implemented")
+}
+func (UnimplementedTodoServiceServer) SearchItems(context.Context, *SearchItemsRequest) (*SearchItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchItems not implemented")
+}
+func (UnimplementedTodoServiceServer) CreateTag(context.Context, *CreateTagRequest) (*CreateTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTag not implemented")
+}
+func (UnimplementedTodoServiceServer) GetTags(context.Context, *GetTagsRequest) (*GetTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTags not implemented")
+}
+func (UnimplementedTodoServiceServer) UpdateTag(context.Context, *UpdateTagRequest) (*UpdateTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTag not implemented")
+}
+func (UnimplementedTodoServiceServer) DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+}
+func (UnimplementedTodoServiceServer) AttachTag(context.Context, *AttachTagRequest) (*AttachTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachTag not implemented")
+}
+func (UnimplementedTodoServiceServer) DetachTag(context.Context, *DetachTagRequest) (*DetachTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachTag not implemented")
+}
+func (UnimplementedTodoServiceServer) AddComment(context.Context, *AddCommentRequest) (*AddCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddComment not implemented")
+}
+func (UnimplementedTodoServiceServer) ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComments not implemented")
+}
+func (UnimplementedTodoServiceServer) EditComment(context.Context, *EditCommentRequest) (*EditCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditComment not implemented")
+}
+func (UnimplementedTodoServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedTodoServiceServer) UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedTodoServiceServer) DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
+func (UnimplementedTodoServiceServer) ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttachments not implemented")
+}
+func (UnimplementedTodoServiceServer) DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
+func (UnimplementedTodoServiceServer) GetListActivity(context.Context, *GetListActivityRequest) (*GetListActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListActivity not implemented")
+}
+func (UnimplementedTodoServiceServer) WatchList(*WatchListRequest, grpc.ServerStreamingServer[WatchListResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchList not implemented")
+}
+func (UnimplementedTodoServiceServer) ExportLists(*ExportListsRequest, grpc.ServerStreamingServer[ExportListsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportLists not implemented")
+}
+func (UnimplementedTodoServiceServer) ImportLists(grpc.ClientStreamingServer[ImportListsRequest, ImportListsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportLists not implemented")
+}
+func (UnimplementedTodoServiceServer) GetCalendarFeed(context.Context, *GetCalendarFeedRequest) (*GetCalendarFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendarFeed not implemented")
+}
+func (UnimplementedTodoServiceServer) ResetCalendarFeed(context.Context, *ResetCalendarFeedRequest) (*ResetCalendarFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetCalendarFeed not implemented")
+}
+func (UnimplementedTodoServiceServer) GetCalendar(context.Context, *GetCalendarRequest) (*GetCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendar not implemented")
+}
+func (UnimplementedTodoServiceServer) SyncTodoTxt(context.Context, *SyncTodoTxtRequest) (*SyncTodoTxtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncTodoTxt not implemented")
+}
+func (UnimplementedTodoServiceServer) mustEmbedUnimplementedTodoServiceServer() {}
+func (UnimplementedTodoServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeTodoServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TodoServiceServer will
+// result in compilation errors.
+type UnsafeTodoServiceServer interface {
+	mustEmbedUnimplementedTodoServiceServer()
+}
+
+func RegisterTodoServiceServer(s grpc.ServiceRegistrar, srv TodoServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTodoServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TodoService_ServiceDesc, srv)
+}
+
+func _TodoService_CreateTodoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTodoListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).CreateTodoList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_CreateTodoList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).CreateTodoList(ctx, req.(*CreateTodoListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_GetTodoListById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTodoListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).GetTodoListById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_GetTodoListById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).GetTodoListById(ctx, req.(*GetTodoListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_GetTodoLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTodoListsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).GetTodoLists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_GetTodoLists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).GetTodoLists(ctx, req.(*GetTodoListsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_UpdateTodoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTodoListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).UpdateTodoList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_UpdateTodoList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).UpdateTodoList(ctx, req.(*UpdateTodoListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_DeleteTodoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTodoListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).DeleteTodoList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_DeleteTodoList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).DeleteTodoList(ctx, req.(*DeleteTodoListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ArchiveTodoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveTodoListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ArchiveTodoList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_ArchiveTodoList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ArchiveTodoList(ctx, req.(*ArchiveTodoListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_UnarchiveTodoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnarchiveTodoListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).UnarchiveTodoList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_UnarchiveTodoList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).UnarchiveTodoList(ctx, req.(*UnarchiveTodoListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_MoveTodoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveTodoListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).MoveTodoList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_MoveTodoList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).MoveTodoList(ctx, req.(*MoveTodoListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ListTrash(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_RestoreTodoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTodoListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).RestoreTodoList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_RestoreTodoList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).RestoreTodoList(ctx, req.(*RestoreTodoListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_RestoreTodoItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTodoItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).RestoreTodoItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_RestoreTodoItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).RestoreTodoItem(ctx, req.(*RestoreTodoItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_EmptyTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).EmptyTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_EmptyTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).EmptyTrash(ctx, req.(*EmptyTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ShareList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ShareList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_ShareList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ShareList(ctx, req.(*ShareListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_UpdateMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).UpdateMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_UpdateMemberRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).UpdateMemberRole(ctx, req.(*UpdateMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_RemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).RemoveMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_RemoveMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).RemoveMember(ctx, req.(*RemoveMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ListMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ListMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_ListMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ListMembers(ctx, req.(*ListMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_CreateInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).CreateInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_CreateInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).CreateInvitation(ctx, req.(*CreateInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_GetInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).GetInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_GetInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).GetInvitations(ctx, req.(*GetInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_AcceptInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).AcceptInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_AcceptInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).AcceptInvitation(ctx, req.(*AcceptInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_DeclineInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).DeclineInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_DeclineInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).DeclineInvitation(ctx, req.(*DeclineInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ActivateInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ActivateInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_ActivateInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ActivateInvitations(ctx, req.(*ActivateInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_CreateTodoItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTodoItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).CreateTodoItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_CreateTodoItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).CreateTodoItem(ctx, req.(*CreateTodoItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_GetTodoItemById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTodoItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).GetTodoItemById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_GetTodoItemById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).GetTodoItemById(ctx, req.(*GetTodoItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_GetTodoItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTodoItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).GetTodoItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_GetTodoItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).GetTodoItems(ctx, req.(*GetTodoItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_UpdateTodoItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTodoItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).UpdateTodoItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_UpdateTodoItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).UpdateTodoItem(ctx, req.(*UpdateTodoItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_DeleteTodoItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTodoItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).DeleteTodoItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_DeleteTodoItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).DeleteTodoItem(ctx, req.(*DeleteTodoItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_MoveTodoItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveTodoItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).MoveTodoItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_MoveTodoItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).MoveTodoItem(ctx, req.(*MoveTodoItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_MoveTodoItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveTodoItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).MoveTodoItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_MoveTodoItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).MoveTodoItems(ctx, req.(*MoveTodoItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_CopyTodoItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyTodoItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).CopyTodoItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_CopyTodoItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).CopyTodoItems(ctx, req.(*CopyTodoItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_BatchUpdateTodoItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateTodoItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).BatchUpdateTodoItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_BatchUpdateTodoItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).BatchUpdateTodoItems(ctx, req.(*BatchUpdateTodoItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_GetDueItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDueItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).GetDueItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_GetDueItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).GetDueItems(ctx, req.(*GetDueItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_GetOverdueItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOverdueItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).GetOverdueItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_GetOverdueItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).GetOverdueItems(ctx, req.(*GetOverdueItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_GetUpcomingOccurrences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUpcomingOccurrencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).GetUpcomingOccurrences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_GetUpcomingOccurrences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).GetUpcomingOccurrences(ctx, req.(*GetUpcomingOccurrencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_SearchItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).SearchItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_SearchItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).SearchItems(ctx, req.(*SearchItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_CreateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).CreateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_CreateTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).CreateTag(ctx, req.(*CreateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTodoServiceServer) GetTodoItemById(context.Context, *GetTodoItemRequest) (*GetTodoItemResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTodoItemById not implemented")
+
+func _TodoService_GetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).GetTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_GetTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).GetTags(ctx, req.(*GetTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTodoServiceServer) GetTodoItems(context.Context, *GetTodoItemsRequest) (*GetTodoItemsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTodoItems not implemented")
+
+func _TodoService_UpdateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).UpdateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_UpdateTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).UpdateTag(ctx, req.(*UpdateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTodoServiceServer) UpdateTodoItem(context.Context, *UpdateTodoItemRequest) (*UpdateTodoItemResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTodoItem not implemented")
+
+func _TodoService_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).DeleteTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_DeleteTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).DeleteTag(ctx, req.(*DeleteTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTodoServiceServer) DeleteTodoItem(context.Context, *DeleteTodoItemRequest) (*DeleteTodoItemResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTodoItem not implemented")
+
+func _TodoService_AttachTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).AttachTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_AttachTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).AttachTag(ctx, req.(*AttachTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedTodoServiceServer) mustEmbedUnimplementedTodoServiceServer() {}
-func (UnimplementedTodoServiceServer) testEmbeddedByValue()                     {}
 
-// UnsafeTodoServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to TodoServiceServer will
-// result in compilation errors.
-type UnsafeTodoServiceServer interface {
-	mustEmbedUnimplementedTodoServiceServer()
+func _TodoService_DetachTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetachTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).DetachTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_DetachTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).DetachTag(ctx, req.(*DetachTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterTodoServiceServer(s grpc.ServiceRegistrar, srv TodoServiceServer) {
-	// If the following call pancis, it indicates UnimplementedTodoServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _TodoService_AddComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&TodoService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(TodoServiceServer).AddComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TodoService_AddComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).AddComment(ctx, req.(*AddCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _TodoService_CreateTodoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTodoListRequest)
+func _TodoService_ListComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TodoServiceServer).CreateTodoList(ctx, in)
+		return srv.(TodoServiceServer).ListComments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TodoService_CreateTodoList_FullMethodName,
+		FullMethod: TodoService_ListComments_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TodoServiceServer).CreateTodoList(ctx, req.(*CreateTodoListRequest))
+		return srv.(TodoServiceServer).ListComments(ctx, req.(*ListCommentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TodoService_GetTodoListById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTodoListRequest)
+func _TodoService_EditComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TodoServiceServer).GetTodoListById(ctx, in)
+		return srv.(TodoServiceServer).EditComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TodoService_GetTodoListById_FullMethodName,
+		FullMethod: TodoService_EditComment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TodoServiceServer).GetTodoListById(ctx, req.(*GetTodoListRequest))
+		return srv.(TodoServiceServer).EditComment(ctx, req.(*EditCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TodoService_GetTodoLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTodoListsRequest)
+func _TodoService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TodoServiceServer).GetTodoLists(ctx, in)
+		return srv.(TodoServiceServer).DeleteComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TodoService_GetTodoLists_FullMethodName,
+		FullMethod: TodoService_DeleteComment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TodoServiceServer).GetTodoLists(ctx, req.(*GetTodoListsRequest))
+		return srv.(TodoServiceServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TodoService_UpdateTodoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTodoListRequest)
+func _TodoService_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TodoServiceServer).UploadAttachment(&grpc.GenericServerStream[UploadAttachmentRequest, UploadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TodoService_UploadAttachmentServer = grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]
+
+func _TodoService_DownloadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAttachmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TodoServiceServer).DownloadAttachment(m, &grpc.GenericServerStream[DownloadAttachmentRequest, DownloadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TodoService_DownloadAttachmentServer = grpc.ServerStreamingServer[DownloadAttachmentResponse]
+
+func _TodoService_ListAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachmentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TodoServiceServer).UpdateTodoList(ctx, in)
+		return srv.(TodoServiceServer).ListAttachments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TodoService_UpdateTodoList_FullMethodName,
+		FullMethod: TodoService_ListAttachments_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TodoServiceServer).UpdateTodoList(ctx, req.(*UpdateTodoListRequest))
+		return srv.(TodoServiceServer).ListAttachments(ctx, req.(*ListAttachmentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TodoService_DeleteTodoList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTodoListRequest)
+func _TodoService_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttachmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TodoServiceServer).DeleteTodoList(ctx, in)
+		return srv.(TodoServiceServer).DeleteAttachment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TodoService_DeleteTodoList_FullMethodName,
+		FullMethod: TodoService_DeleteAttachment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TodoServiceServer).DeleteTodoList(ctx, req.(*DeleteTodoListRequest))
+		return srv.(TodoServiceServer).DeleteAttachment(ctx, req.(*DeleteAttachmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TodoService_CreateTodoItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTodoItemRequest)
+func _TodoService_GetListActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListActivityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TodoServiceServer).CreateTodoItem(ctx, in)
+		return srv.(TodoServiceServer).GetListActivity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TodoService_CreateTodoItem_FullMethodName,
+		FullMethod: TodoService_GetListActivity_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TodoServiceServer).CreateTodoItem(ctx, req.(*CreateTodoItemRequest))
+		return srv.(TodoServiceServer).GetListActivity(ctx, req.(*GetListActivityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TodoService_GetTodoItemById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTodoItemRequest)
+func _TodoService_WatchList_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchListRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TodoServiceServer).WatchList(m, &grpc.GenericServerStream[WatchListRequest, WatchListResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TodoService_WatchListServer = grpc.ServerStreamingServer[WatchListResponse]
+
+func _TodoService_ExportLists_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportListsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TodoServiceServer).ExportLists(m, &grpc.GenericServerStream[ExportListsRequest, ExportListsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TodoService_ExportListsServer = grpc.ServerStreamingServer[ExportListsResponse]
+
+func _TodoService_ImportLists_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TodoServiceServer).ImportLists(&grpc.GenericServerStream[ImportListsRequest, ImportListsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TodoService_ImportListsServer = grpc.ClientStreamingServer[ImportListsRequest, ImportListsResponse]
+
+func _TodoService_GetCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalendarFeedRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TodoServiceServer).GetTodoItemById(ctx, in)
+		return srv.(TodoServiceServer).GetCalendarFeed(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TodoService_GetTodoItemById_FullMethodName,
+		FullMethod: TodoService_GetCalendarFeed_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TodoServiceServer).GetTodoItemById(ctx, req.(*GetTodoItemRequest))
+		return srv.(TodoServiceServer).GetCalendarFeed(ctx, req.(*GetCalendarFeedRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TodoService_GetTodoItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTodoItemsRequest)
+func _TodoService_ResetCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetCalendarFeedRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TodoServiceServer).GetTodoItems(ctx, in)
+		return srv.(TodoServiceServer).ResetCalendarFeed(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TodoService_GetTodoItems_FullMethodName,
+		FullMethod: TodoService_ResetCalendarFeed_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TodoServiceServer).GetTodoItems(ctx, req.(*GetTodoItemsRequest))
+		return srv.(TodoServiceServer).ResetCalendarFeed(ctx, req.(*ResetCalendarFeedRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TodoService_UpdateTodoItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTodoItemRequest)
+func _TodoService_GetCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalendarRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TodoServiceServer).UpdateTodoItem(ctx, in)
+		return srv.(TodoServiceServer).GetCalendar(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TodoService_UpdateTodoItem_FullMethodName,
+		FullMethod: TodoService_GetCalendar_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TodoServiceServer).UpdateTodoItem(ctx, req.(*UpdateTodoItemRequest))
+		return srv.(TodoServiceServer).GetCalendar(ctx, req.(*GetCalendarRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TodoService_DeleteTodoItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTodoItemRequest)
+func _TodoService_SyncTodoTxt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncTodoTxtRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TodoServiceServer).DeleteTodoItem(ctx, in)
+		return srv.(TodoServiceServer).SyncTodoTxt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TodoService_DeleteTodoItem_FullMethodName,
+		FullMethod: TodoService_SyncTodoTxt_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TodoServiceServer).DeleteTodoItem(ctx, req.(*DeleteTodoItemRequest))
+		return srv.(TodoServiceServer).SyncTodoTxt(ctx, req.(*SyncTodoTxtRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -437,6 +1991,70 @@ var TodoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTodoList",
 			Handler:    _TodoService_DeleteTodoList_Handler,
 		},
+		{
+			MethodName: "ArchiveTodoList",
+			Handler:    _TodoService_ArchiveTodoList_Handler,
+		},
+		{
+			MethodName: "UnarchiveTodoList",
+			Handler:    _TodoService_UnarchiveTodoList_Handler,
+		},
+		{
+			MethodName: "MoveTodoList",
+			Handler:    _TodoService_MoveTodoList_Handler,
+		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _TodoService_ListTrash_Handler,
+		},
+		{
+			MethodName: "RestoreTodoList",
+			Handler:    _TodoService_RestoreTodoList_Handler,
+		},
+		{
+			MethodName: "RestoreTodoItem",
+			Handler:    _TodoService_RestoreTodoItem_Handler,
+		},
+		{
+			MethodName: "EmptyTrash",
+			Handler:    _TodoService_EmptyTrash_Handler,
+		},
+		{
+			MethodName: "ShareList",
+			Handler:    _TodoService_ShareList_Handler,
+		},
+		{
+			MethodName: "UpdateMemberRole",
+			Handler:    _TodoService_UpdateMemberRole_Handler,
+		},
+		{
+			MethodName: "RemoveMember",
+			Handler:    _TodoService_RemoveMember_Handler,
+		},
+		{
+			MethodName: "ListMembers",
+			Handler:    _TodoService_ListMembers_Handler,
+		},
+		{
+			MethodName: "CreateInvitation",
+			Handler:    _TodoService_CreateInvitation_Handler,
+		},
+		{
+			MethodName: "GetInvitations",
+			Handler:    _TodoService_GetInvitations_Handler,
+		},
+		{
+			MethodName: "AcceptInvitation",
+			Handler:    _TodoService_AcceptInvitation_Handler,
+		},
+		{
+			MethodName: "DeclineInvitation",
+			Handler:    _TodoService_DeclineInvitation_Handler,
+		},
+		{
+			MethodName: "ActivateInvitations",
+			Handler:    _TodoService_ActivateInvitations_Handler,
+		},
 		{
 			MethodName: "CreateTodoItem",
 			Handler:    _TodoService_CreateTodoItem_Handler,
@@ -457,7 +2075,133 @@ var TodoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTodoItem",
 			Handler:    _TodoService_DeleteTodoItem_Handler,
 		},
+		{
+			MethodName: "MoveTodoItem",
+			Handler:    _TodoService_MoveTodoItem_Handler,
+		},
+		{
+			MethodName: "MoveTodoItems",
+			Handler:    _TodoService_MoveTodoItems_Handler,
+		},
+		{
+			MethodName: "CopyTodoItems",
+			Handler:    _TodoService_CopyTodoItems_Handler,
+		},
+		{
+			MethodName: "BatchUpdateTodoItems",
+			Handler:    _TodoService_BatchUpdateTodoItems_Handler,
+		},
+		{
+			MethodName: "GetDueItems",
+			Handler:    _TodoService_GetDueItems_Handler,
+		},
+		{
+			MethodName: "GetOverdueItems",
+			Handler:    _TodoService_GetOverdueItems_Handler,
+		},
+		{
+			MethodName: "GetUpcomingOccurrences",
+			Handler:    _TodoService_GetUpcomingOccurrences_Handler,
+		},
+		{
+			MethodName: "SearchItems",
+			Handler:    _TodoService_SearchItems_Handler,
+		},
+		{
+			MethodName: "CreateTag",
+			Handler:    _TodoService_CreateTag_Handler,
+		},
+		{
+			MethodName: "GetTags",
+			Handler:    _TodoService_GetTags_Handler,
+		},
+		{
+			MethodName: "UpdateTag",
+			Handler:    _TodoService_UpdateTag_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _TodoService_DeleteTag_Handler,
+		},
+		{
+			MethodName: "AttachTag",
+			Handler:    _TodoService_AttachTag_Handler,
+		},
+		{
+			MethodName: "DetachTag",
+			Handler:    _TodoService_DetachTag_Handler,
+		},
+		{
+			MethodName: "AddComment",
+			Handler:    _TodoService_AddComment_Handler,
+		},
+		{
+			MethodName: "ListComments",
+			Handler:    _TodoService_ListComments_Handler,
+		},
+		{
+			MethodName: "EditComment",
+			Handler:    _TodoService_EditComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _TodoService_DeleteComment_Handler,
+		},
+		{
+			MethodName: "ListAttachments",
+			Handler:    _TodoService_ListAttachments_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _TodoService_DeleteAttachment_Handler,
+		},
+		{
+			MethodName: "GetListActivity",
+			Handler:    _TodoService_GetListActivity_Handler,
+		},
+		{
+			MethodName: "GetCalendarFeed",
+			Handler:    _TodoService_GetCalendarFeed_Handler,
+		},
+		{
+			MethodName: "ResetCalendarFeed",
+			Handler:    _TodoService_ResetCalendarFeed_Handler,
+		},
+		{
+			MethodName: "GetCalendar",
+			Handler:    _TodoService_GetCalendar_Handler,
+		},
+		{
+			MethodName: "SyncTodoTxt",
+			Handler:    _TodoService_SyncTodoTxt_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _TodoService_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAttachment",
+			Handler:       _TodoService_DownloadAttachment_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchList",
+			Handler:       _TodoService_WatchList_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportLists",
+			Handler:       _TodoService_ExportLists_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportLists",
+			Handler:       _TodoService_ImportLists_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/pb/todo.proto",
 }
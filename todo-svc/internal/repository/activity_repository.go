@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/broker"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/domain"
+	"gorm.io/gorm"
+	"time"
+)
+
+var (
+	ErrActivityNotFound = errors.New("activity not found")
+)
+
+type ActivityPostgres struct {
+	db *gorm.DB
+}
+
+func NewActivityPostgres(db *gorm.DB) *ActivityPostgres {
+	return &ActivityPostgres{
+		db: db,
+	}
+}
+
+// GetAll returns the activity of the list, most recent first, together with
+// the total number of entries.
+func (ap *ActivityPostgres) GetAll(listId int64, limit int, after *domain.ActivityCursor) ([]*domain.Activity, int64, error) {
+	query := ap.db.Model(&domain.Activity{}).Where("list_id = ?", listId).Session(&gorm.Session{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if after != nil {
+		query = query.Where("id < ?", after.Id)
+	}
+
+	var activities []*domain.Activity
+	if err := query.Order("id DESC").Limit(limit).Find(&activities).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return activities, total, nil
+}
+
+func (ap *ActivityPostgres) GetById(activityId int64) (*domain.Activity, error) {
+	var activity domain.Activity
+	if err := ap.db.Where(&domain.Activity{Id: activityId}).First(&activity).Error; err != nil {
+		return nil, ErrActivityNotFound
+	}
+
+	return &activity, nil
+}
+
+// GetAfter returns up to limit entries of the list recorded after the given
+// one, oldest first.
+func (ap *ActivityPostgres) GetAfter(listId, afterId int64, limit int) ([]*domain.Activity, error) {
+	var activities []*domain.Activity
+	if err := ap.db.Where("list_id = ? AND id > ?", listId, afterId).Order("id").Limit(limit).Find(&activities).Error; err != nil {
+		return nil, err
+	}
+
+	return activities, nil
+}
+
+// ResumeAfter returns the entry to get the entries of the list after for a
+// watcher that saw afterId last. Entries are numbered when they are recorded
+// but only show up once their transaction commits, so entries recorded up to
+// overlap before afterId may have shown up since with a smaller number. The
+// watcher gets those again along with the ones it has seen meanwhile.
+func (ap *ActivityPostgres) ResumeAfter(listId, afterId int64, overlap time.Duration) (int64, error) {
+	var seen domain.Activity
+	if err := ap.db.Where("id = ? AND list_id = ?", afterId, listId).First(&seen).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return afterId, nil
+		}
+		return 0, err
+	}
+
+	var first *int64
+	if err := ap.db.Model(&domain.Activity{}).
+		Where("list_id = ? AND id < ? AND created_at >= ?", listId, afterId, seen.CreatedAt.Add(-overlap)).
+		Select("MIN(id)").Scan(&first).Error; err != nil {
+		return 0, err
+	}
+	if first == nil {
+		return afterId, nil
+	}
+
+	return *first - 1, nil
+}
+
+// record writes an entry about a change to the target in tx, so that it is
+// kept exactly when the change is. activity tells who made the change and
+// how, the rest of the entry is filled in from the arguments. before and
+// after are nil when the target did not exist before or after the change. A
+// nil activity stands for changes nobody made, such as cleanups, and is not
+// recorded. The entry is announced to the watchers of the list once tx is
+// committed.
+func record(tx *gorm.DB, activity *domain.Activity, listId int64, targetType domain.TargetType, targetId int64, before, after any) error {
+	if activity == nil {
+		return nil
+	}
+
+	// Methods that change several targets record each of them with a copy.
+	entry := domain.Activity{
+		ListId:     listId,
+		ActorId:    activity.ActorId,
+		Action:     activity.Action,
+		TargetType: targetType,
+		TargetId:   targetId,
+	}
+
+	var err error
+	if before != nil {
+		if entry.Before, err = json.Marshal(before); err != nil {
+			return err
+		}
+	}
+	if after != nil {
+		if entry.After, err = json.Marshal(after); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Create(&entry).Error; err != nil {
+		return err
+	}
+
+	event := broker.Event{ListId: listId, Sequence: entry.Id}
+	return tx.Exec("SELECT pg_notify(?, ?)", broker.Channel, broker.Payload(event)).Error
+}
+
+// recordItem records a change to an item in the list. Tags and subtasks are
+// left out of the snapshots, they are changed and recorded on their own.
+func recordItem(tx *gorm.DB, activity *domain.Activity, listId int64, before, after *domain.TodoItem) error {
+	var targetId int64
+	var beforeSnapshot, afterSnapshot any
+	if before != nil {
+		snapshot := *before
+		snapshot.Tags, snapshot.Children = nil, nil
+		targetId, beforeSnapshot = before.Id, snapshot
+	}
+	if after != nil {
+		snapshot := *after
+		snapshot.Tags, snapshot.Children = nil, nil
+		targetId, afterSnapshot = after.Id, snapshot
+	}
+
+	return record(tx, activity, listId, domain.TargetItem, targetId, beforeSnapshot, afterSnapshot)
+}
+
+// recordOnItem records a change to something attached to the item, in the
+// list the item belongs to.
+func recordOnItem(tx *gorm.DB, activity *domain.Activity, itemId int64, targetType domain.TargetType, targetId int64, before, after any) error {
+	if activity == nil {
+		return nil
+	}
+
+	var listIds []int64
+	if err := tx.Unscoped().Model(&domain.TodoItem{}).Where("id = ?", itemId).Pluck("list_id", &listIds).Error; err != nil {
+		return err
+	}
+	if len(listIds) == 0 {
+		return ErrTodoItemNotFound
+	}
+
+	return record(tx, activity, listIds[0], targetType, targetId, before, after)
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"errors"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/domain"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrAttachmentNotFound = errors.New("attachment not found")
+)
+
+type AttachmentPostgres struct {
+	db *gorm.DB
+}
+
+func NewAttachmentPostgres(db *gorm.DB) *AttachmentPostgres {
+	return &AttachmentPostgres{
+		db: db,
+	}
+}
+
+func (ap *AttachmentPostgres) Create(attachment *domain.Attachment, activity *domain.Activity) error {
+	tx := ap.db.Begin()
+
+	if err := tx.Create(attachment).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := recordOnItem(tx, activity, attachment.ItemId, domain.TargetAttachment, attachment.Id, nil, attachment); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (ap *AttachmentPostgres) GetAll(itemId int64) ([]*domain.Attachment, error) {
+	var attachments []*domain.Attachment
+	if err := ap.db.Where(&domain.Attachment{ItemId: itemId}).Order("id").Find(&attachments).Error; err != nil {
+		return nil, err
+	}
+
+	return attachments, nil
+}
+
+func (ap *AttachmentPostgres) GetById(attachmentId int64) (*domain.Attachment, error) {
+	var attachment domain.Attachment
+	if err := ap.db.Where(&domain.Attachment{Id: attachmentId}).First(&attachment).Error; err != nil {
+		return nil, ErrAttachmentNotFound
+	}
+
+	return &attachment, nil
+}
+
+// GetOrphans returns the attachments of items purged from the trash. Items
+// still in the trash keep their attachments.
+func (ap *AttachmentPostgres) GetOrphans() ([]*domain.Attachment, error) {
+	var attachments []*domain.Attachment
+	if err := ap.db.
+		Where("item_id NOT IN (?)", ap.db.Unscoped().Model(&domain.TodoItem{}).Select("id")).
+		Find(&attachments).Error; err != nil {
+		return nil, err
+	}
+
+	return attachments, nil
+}
+
+// Delete removes the metadata of the attachment. Orphans are deleted with a
+// nil activity, the items they belonged to are gone.
+func (ap *AttachmentPostgres) Delete(attachmentId int64, activity *domain.Activity) error {
+	tx := ap.db.Begin()
+
+	var attachment domain.Attachment
+	if err := tx.Where(&domain.Attachment{Id: attachmentId}).First(&attachment).Error; err != nil {
+		tx.Rollback()
+		return ErrAttachmentNotFound
+	}
+
+	if err := tx.Delete(&attachment).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := recordOnItem(tx, activity, attachment.ItemId, domain.TargetAttachment, attachmentId, attachment, nil); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"errors"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrCalendarFeedNotFound = errors.New("calendar feed not found")
+)
+
+type CalendarPostgres struct {
+	db *gorm.DB
+}
+
+func NewCalendarPostgres(db *gorm.DB) *CalendarPostgres {
+	return &CalendarPostgres{
+		db: db,
+	}
+}
+
+// GetOrCreate returns the feed of the user of feed, storing feed when the
+// user has none yet.
+func (cp *CalendarPostgres) GetOrCreate(feed *domain.CalendarFeed) (*domain.CalendarFeed, error) {
+	if err := cp.db.Clauses(clause.OnConflict{DoNothing: true}).Create(feed).Error; err != nil {
+		return nil, err
+	}
+
+	var stored domain.CalendarFeed
+	if err := cp.db.Where("user_id = ?", feed.UserId).First(&stored).Error; err != nil {
+		return nil, err
+	}
+
+	return &stored, nil
+}
+
+// Reset stores feed in place of the feed of its user.
+func (cp *CalendarPostgres) Reset(feed *domain.CalendarFeed) error {
+	return cp.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"token", "created_at"}),
+	}).Create(feed).Error
+}
+
+func (cp *CalendarPostgres) GetByToken(token string) (*domain.CalendarFeed, error) {
+	var feed domain.CalendarFeed
+	if err := cp.db.Where("token = ?", token).First(&feed).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCalendarFeedNotFound
+		}
+		return nil, err
+	}
+
+	return &feed, nil
+}
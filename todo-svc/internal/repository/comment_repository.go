@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"errors"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/domain"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrCommentNotFound = errors.New("comment not found")
+)
+
+type CommentPostgres struct {
+	db *gorm.DB
+}
+
+func NewCommentPostgres(db *gorm.DB) *CommentPostgres {
+	return &CommentPostgres{
+		db: db,
+	}
+}
+
+func (cp *CommentPostgres) Create(comment *domain.Comment, activity *domain.Activity) error {
+	tx := cp.db.Begin()
+
+	if err := tx.Create(comment).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := recordOnItem(tx, activity, comment.ItemId, domain.TargetComment, comment.Id, nil, comment); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// GetAll returns the comments of the item, oldest first, together with their
+// total number.
+func (cp *CommentPostgres) GetAll(itemId int64, limit int, after *domain.CommentCursor) ([]*domain.Comment, int64, error) {
+	query := cp.db.Model(&domain.Comment{}).Where("item_id = ?", itemId).Session(&gorm.Session{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if after != nil {
+		query = query.Where("id > ?", after.Id)
+	}
+
+	var comments []*domain.Comment
+	if err := query.Order("id").Limit(limit).Find(&comments).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return comments, total, nil
+}
+
+func (cp *CommentPostgres) GetById(commentId int64) (*domain.Comment, error) {
+	var comment domain.Comment
+	if err := cp.db.Where(&domain.Comment{Id: commentId}).First(&comment).Error; err != nil {
+		return nil, ErrCommentNotFound
+	}
+
+	return &comment, nil
+}
+
+func (cp *CommentPostgres) Update(comment *domain.Comment, activity *domain.Activity) error {
+	tx := cp.db.Begin()
+
+	var before domain.Comment
+	if err := tx.Where(&domain.Comment{Id: comment.Id}).First(&before).Error; err != nil {
+		tx.Rollback()
+		return ErrCommentNotFound
+	}
+
+	if err := tx.Save(comment).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := recordOnItem(tx, activity, comment.ItemId, domain.TargetComment, comment.Id, before, comment); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (cp *CommentPostgres) Delete(commentId int64, activity *domain.Activity) error {
+	tx := cp.db.Begin()
+
+	var comment domain.Comment
+	if err := tx.Where(&domain.Comment{Id: commentId}).First(&comment).Error; err != nil {
+		tx.Rollback()
+		return ErrCommentNotFound
+	}
+
+	if err := tx.Delete(&comment).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := recordOnItem(tx, activity, comment.ItemId, domain.TargetComment, commentId, comment, nil); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"errors"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+var (
+	ErrIdempotencyKeyUsed = errors.New("idempotency key already used")
+)
+
+type IdempotencyPostgres struct {
+	db *gorm.DB
+}
+
+func NewIdempotencyPostgres(db *gorm.DB) *IdempotencyPostgres {
+	return &IdempotencyPostgres{
+		db: db,
+	}
+}
+
+// Claim stores key before its request is handled. When the user stored the
+// same key since the given time, it returns the stored key along with
+// ErrIdempotencyKeyUsed instead. Older keys have expired and are replaced,
+// as are keys still without a response since pendingSince, whose request
+// never finished.
+func (ip *IdempotencyPostgres) Claim(key *domain.IdempotencyKey, since, pendingSince time.Time) (*domain.IdempotencyKey, error) {
+	tx := ip.db.Begin()
+
+	if err := tx.Where("user_id = ? AND key = ?", key.UserId, key.Key).
+		Where(tx.Where("created_at < ?", since).
+			Or("(response IS NULL OR length(response) = 0) AND created_at < ?", pendingSince)).
+		Delete(&domain.IdempotencyKey{}).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(key)
+	if result.Error != nil {
+		tx.Rollback()
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		var stored domain.IdempotencyKey
+		if err := tx.Where("user_id = ? AND key = ?", key.UserId, key.Key).First(&stored).Error; err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		tx.Rollback()
+		return &stored, ErrIdempotencyKeyUsed
+	}
+
+	return nil, tx.Commit().Error
+}
+
+// Complete stores the response to the request of the claimed key.
+func (ip *IdempotencyPostgres) Complete(key *domain.IdempotencyKey) error {
+	return ip.db.Model(&domain.IdempotencyKey{}).
+		Where("user_id = ? AND key = ?", key.UserId, key.Key).
+		Update("response", key.Response).Error
+}
+
+// Release deletes a claimed key whose request failed, so that it can be
+// retried.
+func (ip *IdempotencyPostgres) Release(key *domain.IdempotencyKey) error {
+	return ip.db.Where("user_id = ? AND key = ?", key.UserId, key.Key).
+		Delete(&domain.IdempotencyKey{}).Error
+}
+
+// Purge deletes the keys stored before the given time.
+func (ip *IdempotencyPostgres) Purge(before time.Time) error {
+	return ip.db.Where("created_at < ?", before).Delete(&domain.IdempotencyKey{}).Error
+}
@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"errors"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/domain"
+	"gorm.io/gorm"
+	"time"
+)
+
+var (
+	ErrInvitationNotFound = errors.New("invitation not found")
+)
+
+type InvitationPostgres struct {
+	db *gorm.DB
+}
+
+func NewInvitationPostgres(db *gorm.DB) *InvitationPostgres {
+	return &InvitationPostgres{
+		db: db,
+	}
+}
+
+// Create stores invitation, replacing any earlier invitation of the same
+// e-mail to the same list.
+func (ip *InvitationPostgres) Create(invitation *domain.Invitation, activity *domain.Activity) error {
+	tx := ip.db.Begin()
+
+	if err := tx.Where("list_id = ? AND email = ?", invitation.ListId, invitation.Email).
+		Delete(&domain.Invitation{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Create(invitation).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := recordInvitation(tx, activity, nil, invitation); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (ip *InvitationPostgres) GetByToken(token string) (*domain.Invitation, error) {
+	var invitation domain.Invitation
+	if err := ip.db.Where(&domain.Invitation{Token: token}).First(&invitation).Error; err != nil {
+		return nil, ErrInvitationNotFound
+	}
+
+	return &invitation, nil
+}
+
+func (ip *InvitationPostgres) GetByInvitee(userId int64) ([]*domain.Invitation, error) {
+	var invitations []*domain.Invitation
+	if err := ip.db.
+		Where("invitee_id = ? AND expires_at > ?", userId, time.Now()).
+		Order("created_at DESC, id DESC").
+		Find(&invitations).Error; err != nil {
+		return nil, err
+	}
+
+	return invitations, nil
+}
+
+func (ip *InvitationPostgres) Activate(email string, userId int64) error {
+	return ip.db.Model(&domain.Invitation{}).
+		Where("email = ? AND invitee_id IS NULL", email).
+		Update("invitee_id", userId).Error
+}
+
+// Accept makes the invitee a member of the list and removes the invitation.
+// Members keep their current role.
+func (ip *InvitationPostgres) Accept(invitation *domain.Invitation, activity *domain.Activity) error {
+	tx := ip.db.Begin()
+
+	position, err := listPositions(tx, *invitation.InviteeId).last()
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	member := domain.UsersList{
+		UserId:   *invitation.InviteeId,
+		ListId:   invitation.ListId,
+		Role:     invitation.Role,
+		Position: position,
+	}
+	if err := tx.Where("user_id = ? AND list_id = ?", member.UserId, member.ListId).
+		FirstOrCreate(&member).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Delete(&domain.Invitation{}, invitation.Id).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := recordInvitation(tx, activity, invitation, nil); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (ip *InvitationPostgres) Delete(invitationId int64, activity *domain.Activity) error {
+	tx := ip.db.Begin()
+
+	var invitation domain.Invitation
+	if err := tx.Where(&domain.Invitation{Id: invitationId}).First(&invitation).Error; err != nil {
+		tx.Rollback()
+		return ErrInvitationNotFound
+	}
+
+	if err := tx.Delete(&invitation).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := recordInvitation(tx, activity, &invitation, nil); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// recordInvitation records a change to an invitation. The token is left out
+// of the snapshots, as every member of the list can read them.
+func recordInvitation(tx *gorm.DB, activity *domain.Activity, before, after *domain.Invitation) error {
+	invitation := after
+	if invitation == nil {
+		invitation = before
+	}
+
+	var beforeSnapshot, afterSnapshot any
+	if before != nil {
+		snapshot := *before
+		snapshot.Token = ""
+		beforeSnapshot = snapshot
+	}
+	if after != nil {
+		snapshot := *after
+		snapshot.Token = ""
+		afterSnapshot = snapshot
+	}
+
+	return record(tx, activity, invitation.ListId, domain.TargetInvitation, invitation.Id, beforeSnapshot, afterSnapshot)
+}
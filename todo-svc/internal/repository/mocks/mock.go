@@ -6,6 +6,7 @@ package mock_repository
 
 import (
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/domain"
 	gomock "github.com/golang/mock/gomock"
@@ -34,61 +35,104 @@ func (m *MockTodoList) EXPECT() *MockTodoListMockRecorder {
 	return m.recorder
 }
 
+// AddMember mocks base method.
+func (m *MockTodoList) AddMember(member *domain.UsersList, activity *domain.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", member, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockTodoListMockRecorder) AddMember(member, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockTodoList)(nil).AddMember), member, activity)
+}
+
+// Archive mocks base method.
+func (m *MockTodoList) Archive(listId int64, activity *domain.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archive", listId, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Archive indicates an expected call of Archive.
+func (mr *MockTodoListMockRecorder) Archive(listId, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockTodoList)(nil).Archive), listId, activity)
+}
+
+// CheckNotArchived mocks base method.
+func (m *MockTodoList) CheckNotArchived(listId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckNotArchived", listId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckNotArchived indicates an expected call of CheckNotArchived.
+func (mr *MockTodoListMockRecorder) CheckNotArchived(listId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckNotArchived", reflect.TypeOf((*MockTodoList)(nil).CheckNotArchived), listId)
+}
+
 // CheckUserAccessToList mocks base method.
-func (m *MockTodoList) CheckUserAccessToList(userId, listId int64) error {
+func (m *MockTodoList) CheckUserAccessToList(userId, listId int64, role domain.Role) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckUserAccessToList", userId, listId)
+	ret := m.ctrl.Call(m, "CheckUserAccessToList", userId, listId, role)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CheckUserAccessToList indicates an expected call of CheckUserAccessToList.
-func (mr *MockTodoListMockRecorder) CheckUserAccessToList(userId, listId interface{}) *gomock.Call {
+func (mr *MockTodoListMockRecorder) CheckUserAccessToList(userId, listId, role interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckUserAccessToList", reflect.TypeOf((*MockTodoList)(nil).CheckUserAccessToList), userId, listId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckUserAccessToList", reflect.TypeOf((*MockTodoList)(nil).CheckUserAccessToList), userId, listId, role)
 }
 
 // Create mocks base method.
-func (m *MockTodoList) Create(userId int64, list *domain.TodoList) error {
+func (m *MockTodoList) Create(userId int64, list *domain.TodoList, activity *domain.Activity) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", userId, list)
+	ret := m.ctrl.Call(m, "Create", userId, list, activity)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockTodoListMockRecorder) Create(userId, list interface{}) *gomock.Call {
+func (mr *MockTodoListMockRecorder) Create(userId, list, activity interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTodoList)(nil).Create), userId, list)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTodoList)(nil).Create), userId, list, activity)
 }
 
 // Delete mocks base method.
-func (m *MockTodoList) Delete(listId int64) error {
+func (m *MockTodoList) Delete(listId, version int64, activity *domain.Activity) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", listId)
+	ret := m.ctrl.Call(m, "Delete", listId, version, activity)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockTodoListMockRecorder) Delete(listId interface{}) *gomock.Call {
+func (mr *MockTodoListMockRecorder) Delete(listId, version, activity interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTodoList)(nil).Delete), listId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTodoList)(nil).Delete), listId, version, activity)
 }
 
 // GetAll mocks base method.
-func (m *MockTodoList) GetAll(userId int64) ([]*domain.TodoList, error) {
+func (m *MockTodoList) GetAll(userId int64, filter domain.ListFilter, sort domain.ListSort, limit int, after *domain.ListCursor) ([]*domain.TodoList, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", userId)
+	ret := m.ctrl.Call(m, "GetAll", userId, filter, sort, limit, after)
 	ret0, _ := ret[0].([]*domain.TodoList)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockTodoListMockRecorder) GetAll(userId interface{}) *gomock.Call {
+func (mr *MockTodoListMockRecorder) GetAll(userId, filter, sort, limit, after interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockTodoList)(nil).GetAll), userId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockTodoList)(nil).GetAll), userId, filter, sort, limit, after)
 }
 
 // GetById mocks base method.
@@ -106,19 +150,90 @@ func (mr *MockTodoListMockRecorder) GetById(listId interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockTodoList)(nil).GetById), listId)
 }
 
+// GetMembers mocks base method.
+func (m *MockTodoList) GetMembers(listId int64) ([]*domain.UsersList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMembers", listId)
+	ret0, _ := ret[0].([]*domain.UsersList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMembers indicates an expected call of GetMembers.
+func (mr *MockTodoListMockRecorder) GetMembers(listId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembers", reflect.TypeOf((*MockTodoList)(nil).GetMembers), listId)
+}
+
+// Move mocks base method.
+func (m *MockTodoList) Move(userId, listId, afterId, beforeId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", userId, listId, afterId, beforeId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockTodoListMockRecorder) Move(userId, listId, afterId, beforeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockTodoList)(nil).Move), userId, listId, afterId, beforeId)
+}
+
+// RemoveMember mocks base method.
+func (m *MockTodoList) RemoveMember(listId, userId int64, activity *domain.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", listId, userId, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockTodoListMockRecorder) RemoveMember(listId, userId, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockTodoList)(nil).RemoveMember), listId, userId, activity)
+}
+
+// Unarchive mocks base method.
+func (m *MockTodoList) Unarchive(listId int64, activity *domain.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unarchive", listId, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unarchive indicates an expected call of Unarchive.
+func (mr *MockTodoListMockRecorder) Unarchive(listId, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unarchive", reflect.TypeOf((*MockTodoList)(nil).Unarchive), listId, activity)
+}
+
 // Update mocks base method.
-func (m *MockTodoList) Update(listId int64, input *domain.TodoList) (*domain.TodoList, error) {
+func (m *MockTodoList) Update(listId int64, input *domain.TodoList, activity *domain.Activity) (*domain.TodoList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", listId, input)
+	ret := m.ctrl.Call(m, "Update", listId, input, activity)
 	ret0, _ := ret[0].(*domain.TodoList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockTodoListMockRecorder) Update(listId, input interface{}) *gomock.Call {
+func (mr *MockTodoListMockRecorder) Update(listId, input, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTodoList)(nil).Update), listId, input, activity)
+}
+
+// UpdateMemberRole mocks base method.
+func (m *MockTodoList) UpdateMemberRole(listId, userId int64, role domain.Role, activity *domain.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMemberRole", listId, userId, role, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMemberRole indicates an expected call of UpdateMemberRole.
+func (mr *MockTodoListMockRecorder) UpdateMemberRole(listId, userId, role, activity interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTodoList)(nil).Update), listId, input)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMemberRole", reflect.TypeOf((*MockTodoList)(nil).UpdateMemberRole), listId, userId, role, activity)
 }
 
 // MockTodoItem is a mock of TodoItem interface.
@@ -144,53 +259,111 @@ func (m *MockTodoItem) EXPECT() *MockTodoItemMockRecorder {
 	return m.recorder
 }
 
+// ApplyBatch mocks base method.
+func (m *MockTodoItem) ApplyBatch(batch *domain.ItemBatch, activity *domain.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyBatch", batch, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyBatch indicates an expected call of ApplyBatch.
+func (mr *MockTodoItemMockRecorder) ApplyBatch(batch, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyBatch", reflect.TypeOf((*MockTodoItem)(nil).ApplyBatch), batch, activity)
+}
+
+// CompleteRecurring mocks base method.
+func (m *MockTodoItem) CompleteRecurring(input, next *domain.TodoItem, completeDescendants bool, columns []string, activity *domain.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteRecurring", input, next, completeDescendants, columns, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteRecurring indicates an expected call of CompleteRecurring.
+func (mr *MockTodoItemMockRecorder) CompleteRecurring(input, next, completeDescendants, columns, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteRecurring", reflect.TypeOf((*MockTodoItem)(nil).CompleteRecurring), input, next, completeDescendants, columns, activity)
+}
+
+// CompleteSubtree mocks base method.
+func (m *MockTodoItem) CompleteSubtree(input *domain.TodoItem, columns []string, activity *domain.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteSubtree", input, columns, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteSubtree indicates an expected call of CompleteSubtree.
+func (mr *MockTodoItemMockRecorder) CompleteSubtree(input, columns, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteSubtree", reflect.TypeOf((*MockTodoItem)(nil).CompleteSubtree), input, columns, activity)
+}
+
+// CopyToList mocks base method.
+func (m *MockTodoItem) CopyToList(userId int64, itemIds []int64, listId int64, activity *domain.Activity) ([]*domain.TodoItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyToList", userId, itemIds, listId, activity)
+	ret0, _ := ret[0].([]*domain.TodoItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyToList indicates an expected call of CopyToList.
+func (mr *MockTodoItemMockRecorder) CopyToList(userId, itemIds, listId, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyToList", reflect.TypeOf((*MockTodoItem)(nil).CopyToList), userId, itemIds, listId, activity)
+}
+
 // Create mocks base method.
-func (m *MockTodoItem) Create(item *domain.TodoItem) error {
+func (m *MockTodoItem) Create(item *domain.TodoItem, activity *domain.Activity) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", item)
+	ret := m.ctrl.Call(m, "Create", item, activity)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockTodoItemMockRecorder) Create(item interface{}) *gomock.Call {
+func (mr *MockTodoItemMockRecorder) Create(item, activity interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTodoItem)(nil).Create), item)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTodoItem)(nil).Create), item, activity)
 }
 
 // Delete mocks base method.
-func (m *MockTodoItem) Delete(itemId int64) error {
+func (m *MockTodoItem) Delete(itemId int64, reparentChildren bool, version int64, activity *domain.Activity) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", itemId)
+	ret := m.ctrl.Call(m, "Delete", itemId, reparentChildren, version, activity)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockTodoItemMockRecorder) Delete(itemId interface{}) *gomock.Call {
+func (mr *MockTodoItemMockRecorder) Delete(itemId, reparentChildren, version, activity interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTodoItem)(nil).Delete), itemId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTodoItem)(nil).Delete), itemId, reparentChildren, version, activity)
 }
 
 // GetAll mocks base method.
-func (m *MockTodoItem) GetAll(listId int64) ([]*domain.TodoItem, error) {
+func (m *MockTodoItem) GetAll(userId, listId int64, filter domain.ItemFilter, sort domain.ItemSort, limit int, after *domain.ItemCursor) ([]*domain.TodoItem, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", listId)
+	ret := m.ctrl.Call(m, "GetAll", userId, listId, filter, sort, limit, after)
 	ret0, _ := ret[0].([]*domain.TodoItem)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockTodoItemMockRecorder) GetAll(listId interface{}) *gomock.Call {
+func (mr *MockTodoItemMockRecorder) GetAll(userId, listId, filter, sort, limit, after interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockTodoItem)(nil).GetAll), listId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockTodoItem)(nil).GetAll), userId, listId, filter, sort, limit, after)
 }
 
 // GetById mocks base method.
-func (m *MockTodoItem) GetById(itemId int64) (*domain.TodoItem, int64, error) {
+func (m *MockTodoItem) GetById(userId, itemId int64) (*domain.TodoItem, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetById", itemId)
+	ret := m.ctrl.Call(m, "GetById", userId, itemId)
 	ret0, _ := ret[0].(*domain.TodoItem)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -198,21 +371,984 @@ func (m *MockTodoItem) GetById(itemId int64) (*domain.TodoItem, int64, error) {
 }
 
 // GetById indicates an expected call of GetById.
-func (mr *MockTodoItemMockRecorder) GetById(itemId interface{}) *gomock.Call {
+func (mr *MockTodoItemMockRecorder) GetById(userId, itemId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockTodoItem)(nil).GetById), userId, itemId)
+}
+
+// GetByIds mocks base method.
+func (m *MockTodoItem) GetByIds(userId int64, itemIds []int64) ([]*domain.TodoItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIds", userId, itemIds)
+	ret0, _ := ret[0].([]*domain.TodoItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIds indicates an expected call of GetByIds.
+func (mr *MockTodoItemMockRecorder) GetByIds(userId, itemIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIds", reflect.TypeOf((*MockTodoItem)(nil).GetByIds), userId, itemIds)
+}
+
+// GetDepth mocks base method.
+func (m *MockTodoItem) GetDepth(itemId int64) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDepth", itemId)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDepth indicates an expected call of GetDepth.
+func (mr *MockTodoItemMockRecorder) GetDepth(itemId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDepth", reflect.TypeOf((*MockTodoItem)(nil).GetDepth), itemId)
+}
+
+// GetDescendants mocks base method.
+func (m *MockTodoItem) GetDescendants(userId, itemId int64) ([]*domain.TodoItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDescendants", userId, itemId)
+	ret0, _ := ret[0].([]*domain.TodoItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDescendants indicates an expected call of GetDescendants.
+func (mr *MockTodoItemMockRecorder) GetDescendants(userId, itemId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDescendants", reflect.TypeOf((*MockTodoItem)(nil).GetDescendants), userId, itemId)
+}
+
+// GetDue mocks base method.
+func (m *MockTodoItem) GetDue(userId int64, after, before *time.Time, includeDone bool) ([]*domain.TodoItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDue", userId, after, before, includeDone)
+	ret0, _ := ret[0].([]*domain.TodoItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDue indicates an expected call of GetDue.
+func (mr *MockTodoItemMockRecorder) GetDue(userId, after, before, includeDone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDue", reflect.TypeOf((*MockTodoItem)(nil).GetDue), userId, after, before, includeDone)
+}
+
+// Move mocks base method.
+func (m *MockTodoItem) Move(item *domain.TodoItem, afterId, beforeId int64, activity *domain.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", item, afterId, beforeId, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockTodoItemMockRecorder) Move(item, afterId, beforeId, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockTodoItem)(nil).Move), item, afterId, beforeId, activity)
+}
+
+// MoveToList mocks base method.
+func (m *MockTodoItem) MoveToList(userId int64, itemIds []int64, listId int64, activity *domain.Activity) ([]*domain.TodoItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveToList", userId, itemIds, listId, activity)
+	ret0, _ := ret[0].([]*domain.TodoItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveToList indicates an expected call of MoveToList.
+func (mr *MockTodoItemMockRecorder) MoveToList(userId, itemIds, listId, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveToList", reflect.TypeOf((*MockTodoItem)(nil).MoveToList), userId, itemIds, listId, activity)
+}
+
+// Search mocks base method.
+func (m *MockTodoItem) Search(userId int64, query string, limit int) ([]*domain.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", userId, query, limit)
+	ret0, _ := ret[0].([]*domain.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockTodoItemMockRecorder) Search(userId, query, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockTodoItem)(nil).Search), userId, query, limit)
+}
+
+// Update mocks base method.
+func (m *MockTodoItem) Update(input *domain.TodoItem, columns []string, activity *domain.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", input, columns, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTodoItemMockRecorder) Update(input, columns, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTodoItem)(nil).Update), input, columns, activity)
+}
+
+// MockTag is a mock of Tag interface.
+type MockTag struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagMockRecorder
+}
+
+// MockTagMockRecorder is the mock recorder for MockTag.
+type MockTagMockRecorder struct {
+	mock *MockTag
+}
+
+// NewMockTag creates a new mock instance.
+func NewMockTag(ctrl *gomock.Controller) *MockTag {
+	mock := &MockTag{ctrl: ctrl}
+	mock.recorder = &MockTagMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTag) EXPECT() *MockTagMockRecorder {
+	return m.recorder
+}
+
+// Attach mocks base method.
+func (m *MockTag) Attach(itemId, tagId int64, activity *domain.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Attach", itemId, tagId, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Attach indicates an expected call of Attach.
+func (mr *MockTagMockRecorder) Attach(itemId, tagId, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Attach", reflect.TypeOf((*MockTag)(nil).Attach), itemId, tagId, activity)
+}
+
+// Create mocks base method.
+func (m *MockTag) Create(tag *domain.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTagMockRecorder) Create(tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTag)(nil).Create), tag)
+}
+
+// Delete mocks base method.
+func (m *MockTag) Delete(tagId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", tagId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTagMockRecorder) Delete(tagId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTag)(nil).Delete), tagId)
+}
+
+// Detach mocks base method.
+func (m *MockTag) Detach(itemId, tagId int64, activity *domain.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Detach", itemId, tagId, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Detach indicates an expected call of Detach.
+func (mr *MockTagMockRecorder) Detach(itemId, tagId, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Detach", reflect.TypeOf((*MockTag)(nil).Detach), itemId, tagId, activity)
+}
+
+// GetAll mocks base method.
+func (m *MockTag) GetAll(userId int64) ([]*domain.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", userId)
+	ret0, _ := ret[0].([]*domain.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockTagMockRecorder) GetAll(userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockTag)(nil).GetAll), userId)
+}
+
+// GetById mocks base method.
+func (m *MockTag) GetById(tagId int64) (*domain.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", tagId)
+	ret0, _ := ret[0].(*domain.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockTagMockRecorder) GetById(tagId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockTodoItem)(nil).GetById), itemId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockTag)(nil).GetById), tagId)
 }
 
 // Update mocks base method.
-func (m *MockTodoItem) Update(input *domain.TodoItem) error {
+func (m *MockTag) Update(tag *domain.Tag) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", input)
+	ret := m.ctrl.Call(m, "Update", tag)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockTodoItemMockRecorder) Update(input interface{}) *gomock.Call {
+func (mr *MockTagMockRecorder) Update(tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTag)(nil).Update), tag)
+}
+
+// MockInvitation is a mock of Invitation interface.
+type MockInvitation struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvitationMockRecorder
+}
+
+// MockInvitationMockRecorder is the mock recorder for MockInvitation.
+type MockInvitationMockRecorder struct {
+	mock *MockInvitation
+}
+
+// NewMockInvitation creates a new mock instance.
+func NewMockInvitation(ctrl *gomock.Controller) *MockInvitation {
+	mock := &MockInvitation{ctrl: ctrl}
+	mock.recorder = &MockInvitationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvitation) EXPECT() *MockInvitationMockRecorder {
+	return m.recorder
+}
+
+// Accept mocks base method.
+func (m *MockInvitation) Accept(invitation *domain.Invitation, activity *domain.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", invitation, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Accept indicates an expected call of Accept.
+func (mr *MockInvitationMockRecorder) Accept(invitation, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockInvitation)(nil).Accept), invitation, activity)
+}
+
+// Activate mocks base method.
+func (m *MockInvitation) Activate(email string, userId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Activate", email, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Activate indicates an expected call of Activate.
+func (mr *MockInvitationMockRecorder) Activate(email, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Activate", reflect.TypeOf((*MockInvitation)(nil).Activate), email, userId)
+}
+
+// Create mocks base method.
+func (m *MockInvitation) Create(invitation *domain.Invitation, activity *domain.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", invitation, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockInvitationMockRecorder) Create(invitation, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockInvitation)(nil).Create), invitation, activity)
+}
+
+// Delete mocks base method.
+func (m *MockInvitation) Delete(invitationId int64, activity *domain.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", invitationId, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockInvitationMockRecorder) Delete(invitationId, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockInvitation)(nil).Delete), invitationId, activity)
+}
+
+// GetByInvitee mocks base method.
+func (m *MockInvitation) GetByInvitee(userId int64) ([]*domain.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByInvitee", userId)
+	ret0, _ := ret[0].([]*domain.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByInvitee indicates an expected call of GetByInvitee.
+func (mr *MockInvitationMockRecorder) GetByInvitee(userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByInvitee", reflect.TypeOf((*MockInvitation)(nil).GetByInvitee), userId)
+}
+
+// GetByToken mocks base method.
+func (m *MockInvitation) GetByToken(token string) (*domain.Invitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByToken", token)
+	ret0, _ := ret[0].(*domain.Invitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByToken indicates an expected call of GetByToken.
+func (mr *MockInvitationMockRecorder) GetByToken(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByToken", reflect.TypeOf((*MockInvitation)(nil).GetByToken), token)
+}
+
+// MockComment is a mock of Comment interface.
+type MockComment struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentMockRecorder
+}
+
+// MockCommentMockRecorder is the mock recorder for MockComment.
+type MockCommentMockRecorder struct {
+	mock *MockComment
+}
+
+// NewMockComment creates a new mock instance.
+func NewMockComment(ctrl *gomock.Controller) *MockComment {
+	mock := &MockComment{ctrl: ctrl}
+	mock.recorder = &MockCommentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockComment) EXPECT() *MockCommentMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockComment) Create(comment *domain.Comment, activity *domain.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", comment, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCommentMockRecorder) Create(comment, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockComment)(nil).Create), comment, activity)
+}
+
+// Delete mocks base method.
+func (m *MockComment) Delete(commentId int64, activity *domain.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", commentId, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCommentMockRecorder) Delete(commentId, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockComment)(nil).Delete), commentId, activity)
+}
+
+// GetAll mocks base method.
+func (m *MockComment) GetAll(itemId int64, limit int, after *domain.CommentCursor) ([]*domain.Comment, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", itemId, limit, after)
+	ret0, _ := ret[0].([]*domain.Comment)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockCommentMockRecorder) GetAll(itemId, limit, after interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockComment)(nil).GetAll), itemId, limit, after)
+}
+
+// GetById mocks base method.
+func (m *MockComment) GetById(commentId int64) (*domain.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", commentId)
+	ret0, _ := ret[0].(*domain.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockCommentMockRecorder) GetById(commentId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockComment)(nil).GetById), commentId)
+}
+
+// Update mocks base method.
+func (m *MockComment) Update(comment *domain.Comment, activity *domain.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", comment, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCommentMockRecorder) Update(comment, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockComment)(nil).Update), comment, activity)
+}
+
+// MockAttachment is a mock of Attachment interface.
+type MockAttachment struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentMockRecorder
+}
+
+// MockAttachmentMockRecorder is the mock recorder for MockAttachment.
+type MockAttachmentMockRecorder struct {
+	mock *MockAttachment
+}
+
+// NewMockAttachment creates a new mock instance.
+func NewMockAttachment(ctrl *gomock.Controller) *MockAttachment {
+	mock := &MockAttachment{ctrl: ctrl}
+	mock.recorder = &MockAttachmentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachment) EXPECT() *MockAttachmentMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAttachment) Create(attachment *domain.Attachment, activity *domain.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", attachment, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAttachmentMockRecorder) Create(attachment, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAttachment)(nil).Create), attachment, activity)
+}
+
+// Delete mocks base method.
+func (m *MockAttachment) Delete(attachmentId int64, activity *domain.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", attachmentId, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAttachmentMockRecorder) Delete(attachmentId, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAttachment)(nil).Delete), attachmentId, activity)
+}
+
+// GetAll mocks base method.
+func (m *MockAttachment) GetAll(itemId int64) ([]*domain.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", itemId)
+	ret0, _ := ret[0].([]*domain.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockAttachmentMockRecorder) GetAll(itemId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockAttachment)(nil).GetAll), itemId)
+}
+
+// GetById mocks base method.
+func (m *MockAttachment) GetById(attachmentId int64) (*domain.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", attachmentId)
+	ret0, _ := ret[0].(*domain.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockAttachmentMockRecorder) GetById(attachmentId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockAttachment)(nil).GetById), attachmentId)
+}
+
+// GetOrphans mocks base method.
+func (m *MockAttachment) GetOrphans() ([]*domain.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrphans")
+	ret0, _ := ret[0].([]*domain.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrphans indicates an expected call of GetOrphans.
+func (mr *MockAttachmentMockRecorder) GetOrphans() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrphans", reflect.TypeOf((*MockAttachment)(nil).GetOrphans))
+}
+
+// MockActivity is a mock of Activity interface.
+type MockActivity struct {
+	ctrl     *gomock.Controller
+	recorder *MockActivityMockRecorder
+}
+
+// MockActivityMockRecorder is the mock recorder for MockActivity.
+type MockActivityMockRecorder struct {
+	mock *MockActivity
+}
+
+// NewMockActivity creates a new mock instance.
+func NewMockActivity(ctrl *gomock.Controller) *MockActivity {
+	mock := &MockActivity{ctrl: ctrl}
+	mock.recorder = &MockActivityMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActivity) EXPECT() *MockActivityMockRecorder {
+	return m.recorder
+}
+
+// GetAfter mocks base method.
+func (m *MockActivity) GetAfter(listId, afterId int64, limit int) ([]*domain.Activity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAfter", listId, afterId, limit)
+	ret0, _ := ret[0].([]*domain.Activity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAfter indicates an expected call of GetAfter.
+func (mr *MockActivityMockRecorder) GetAfter(listId, afterId, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAfter", reflect.TypeOf((*MockActivity)(nil).GetAfter), listId, afterId, limit)
+}
+
+// GetAll mocks base method.
+func (m *MockActivity) GetAll(listId int64, limit int, after *domain.ActivityCursor) ([]*domain.Activity, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", listId, limit, after)
+	ret0, _ := ret[0].([]*domain.Activity)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockActivityMockRecorder) GetAll(listId, limit, after interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockActivity)(nil).GetAll), listId, limit, after)
+}
+
+// GetById mocks base method.
+func (m *MockActivity) GetById(activityId int64) (*domain.Activity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", activityId)
+	ret0, _ := ret[0].(*domain.Activity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockActivityMockRecorder) GetById(activityId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockActivity)(nil).GetById), activityId)
+}
+
+// ResumeAfter mocks base method.
+func (m *MockActivity) ResumeAfter(listId, afterId int64, overlap time.Duration) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeAfter", listId, afterId, overlap)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeAfter indicates an expected call of ResumeAfter.
+func (mr *MockActivityMockRecorder) ResumeAfter(listId, afterId, overlap interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeAfter", reflect.TypeOf((*MockActivity)(nil).ResumeAfter), listId, afterId, overlap)
+}
+
+// MockTrash is a mock of Trash interface.
+type MockTrash struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrashMockRecorder
+}
+
+// MockTrashMockRecorder is the mock recorder for MockTrash.
+type MockTrashMockRecorder struct {
+	mock *MockTrash
+}
+
+// NewMockTrash creates a new mock instance.
+func NewMockTrash(ctrl *gomock.Controller) *MockTrash {
+	mock := &MockTrash{ctrl: ctrl}
+	mock.recorder = &MockTrashMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrash) EXPECT() *MockTrashMockRecorder {
+	return m.recorder
+}
+
+// Empty mocks base method.
+func (m *MockTrash) Empty(userId int64, activity *domain.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Empty", userId, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Empty indicates an expected call of Empty.
+func (mr *MockTrashMockRecorder) Empty(userId, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Empty", reflect.TypeOf((*MockTrash)(nil).Empty), userId, activity)
+}
+
+// GetItem mocks base method.
+func (m *MockTrash) GetItem(itemId int64) (*domain.TodoItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItem", itemId)
+	ret0, _ := ret[0].(*domain.TodoItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItem indicates an expected call of GetItem.
+func (mr *MockTrashMockRecorder) GetItem(itemId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItem", reflect.TypeOf((*MockTrash)(nil).GetItem), itemId)
+}
+
+// GetItems mocks base method.
+func (m *MockTrash) GetItems(userId int64) ([]*domain.TodoItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItems", userId)
+	ret0, _ := ret[0].([]*domain.TodoItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItems indicates an expected call of GetItems.
+func (mr *MockTrashMockRecorder) GetItems(userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItems", reflect.TypeOf((*MockTrash)(nil).GetItems), userId)
+}
+
+// GetLists mocks base method.
+func (m *MockTrash) GetLists(userId int64) ([]*domain.TodoList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLists", userId)
+	ret0, _ := ret[0].([]*domain.TodoList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLists indicates an expected call of GetLists.
+func (mr *MockTrashMockRecorder) GetLists(userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLists", reflect.TypeOf((*MockTrash)(nil).GetLists), userId)
+}
+
+// Purge mocks base method.
+func (m *MockTrash) Purge(before time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", before)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockTrashMockRecorder) Purge(before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockTrash)(nil).Purge), before)
+}
+
+// RestoreItem mocks base method.
+func (m *MockTrash) RestoreItem(item *domain.TodoItem, activity *domain.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreItem", item, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreItem indicates an expected call of RestoreItem.
+func (mr *MockTrashMockRecorder) RestoreItem(item, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreItem", reflect.TypeOf((*MockTrash)(nil).RestoreItem), item, activity)
+}
+
+// RestoreList mocks base method.
+func (m *MockTrash) RestoreList(userId, listId int64, activity *domain.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreList", userId, listId, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreList indicates an expected call of RestoreList.
+func (mr *MockTrashMockRecorder) RestoreList(userId, listId, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreList", reflect.TypeOf((*MockTrash)(nil).RestoreList), userId, listId, activity)
+}
+
+// MockTransfer is a mock of Transfer interface.
+type MockTransfer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferMockRecorder
+}
+
+// MockTransferMockRecorder is the mock recorder for MockTransfer.
+type MockTransferMockRecorder struct {
+	mock *MockTransfer
+}
+
+// NewMockTransfer creates a new mock instance.
+func NewMockTransfer(ctrl *gomock.Controller) *MockTransfer {
+	mock := &MockTransfer{ctrl: ctrl}
+	mock.recorder = &MockTransferMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransfer) EXPECT() *MockTransferMockRecorder {
+	return m.recorder
+}
+
+// GetLists mocks base method.
+func (m *MockTransfer) GetLists(userId int64, listIds []int64) ([]*domain.TodoList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLists", userId, listIds)
+	ret0, _ := ret[0].([]*domain.TodoList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLists indicates an expected call of GetLists.
+func (mr *MockTransferMockRecorder) GetLists(userId, listIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLists", reflect.TypeOf((*MockTransfer)(nil).GetLists), userId, listIds)
+}
+
+// Import mocks base method.
+func (m *MockTransfer) Import(userId int64, lists []*domain.TodoList, activity *domain.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", userId, lists, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockTransferMockRecorder) Import(userId, lists, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockTransfer)(nil).Import), userId, lists, activity)
+}
+
+// ImportItems mocks base method.
+func (m *MockTransfer) ImportItems(userId, listId int64, items []*domain.TodoItem, activity *domain.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportItems", userId, listId, items, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportItems indicates an expected call of ImportItems.
+func (mr *MockTransferMockRecorder) ImportItems(userId, listId, items, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportItems", reflect.TypeOf((*MockTransfer)(nil).ImportItems), userId, listId, items, activity)
+}
+
+// SyncItems mocks base method.
+func (m *MockTransfer) SyncItems(userId, listId int64, sync *domain.ItemSync, activity *domain.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncItems", userId, listId, sync, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncItems indicates an expected call of SyncItems.
+func (mr *MockTransferMockRecorder) SyncItems(userId, listId, sync, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncItems", reflect.TypeOf((*MockTransfer)(nil).SyncItems), userId, listId, sync, activity)
+}
+
+// MockCalendar is a mock of Calendar interface.
+type MockCalendar struct {
+	ctrl     *gomock.Controller
+	recorder *MockCalendarMockRecorder
+}
+
+// MockCalendarMockRecorder is the mock recorder for MockCalendar.
+type MockCalendarMockRecorder struct {
+	mock *MockCalendar
+}
+
+// NewMockCalendar creates a new mock instance.
+func NewMockCalendar(ctrl *gomock.Controller) *MockCalendar {
+	mock := &MockCalendar{ctrl: ctrl}
+	mock.recorder = &MockCalendarMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCalendar) EXPECT() *MockCalendarMockRecorder {
+	return m.recorder
+}
+
+// GetByToken mocks base method.
+func (m *MockCalendar) GetByToken(token string) (*domain.CalendarFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByToken", token)
+	ret0, _ := ret[0].(*domain.CalendarFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByToken indicates an expected call of GetByToken.
+func (mr *MockCalendarMockRecorder) GetByToken(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByToken", reflect.TypeOf((*MockCalendar)(nil).GetByToken), token)
+}
+
+// GetOrCreate mocks base method.
+func (m *MockCalendar) GetOrCreate(feed *domain.CalendarFeed) (*domain.CalendarFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrCreate", feed)
+	ret0, _ := ret[0].(*domain.CalendarFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrCreate indicates an expected call of GetOrCreate.
+func (mr *MockCalendarMockRecorder) GetOrCreate(feed interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrCreate", reflect.TypeOf((*MockCalendar)(nil).GetOrCreate), feed)
+}
+
+// Reset mocks base method.
+func (m *MockCalendar) Reset(feed *domain.CalendarFeed) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reset", feed)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockCalendarMockRecorder) Reset(feed interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockCalendar)(nil).Reset), feed)
+}
+
+// MockIdempotency is a mock of Idempotency interface.
+type MockIdempotency struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyMockRecorder
+}
+
+// MockIdempotencyMockRecorder is the mock recorder for MockIdempotency.
+type MockIdempotencyMockRecorder struct {
+	mock *MockIdempotency
+}
+
+// NewMockIdempotency creates a new mock instance.
+func NewMockIdempotency(ctrl *gomock.Controller) *MockIdempotency {
+	mock := &MockIdempotency{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotency) EXPECT() *MockIdempotencyMockRecorder {
+	return m.recorder
+}
+
+// Claim mocks base method.
+func (m *MockIdempotency) Claim(key *domain.IdempotencyKey, since, pendingSince time.Time) (*domain.IdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", key, since, pendingSince)
+	ret0, _ := ret[0].(*domain.IdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockIdempotencyMockRecorder) Claim(key, since, pendingSince interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockIdempotency)(nil).Claim), key, since, pendingSince)
+}
+
+// Complete mocks base method.
+func (m *MockIdempotency) Complete(key *domain.IdempotencyKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockIdempotencyMockRecorder) Complete(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockIdempotency)(nil).Complete), key)
+}
+
+// Purge mocks base method.
+func (m *MockIdempotency) Purge(before time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", before)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockIdempotencyMockRecorder) Purge(before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockIdempotency)(nil).Purge), before)
+}
+
+// Release mocks base method.
+func (m *MockIdempotency) Release(key *domain.IdempotencyKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockIdempotencyMockRecorder) Release(key interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTodoItem)(nil).Update), input)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockIdempotency)(nil).Release), key)
 }
@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"errors"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/domain"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/pkg/lexorank"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrNeighbourNotFound = errors.New("neighbour not found")
+	ErrNeighbourOrder    = errors.New("neighbours are not in order")
+)
+
+// positionColumn compares positions byte by byte, which is the order lexorank
+// keys are built for.
+const positionColumn = `position COLLATE "C"`
+
+// positions orders rows sharing a scope, e.g. the items of a list or the
+// lists of a user. Moving a row only changes its own position.
+type positions struct {
+	scope func() *gorm.DB
+	key   string
+}
+
+func itemPositions(db *gorm.DB, listId int64) positions {
+	return positions{
+		scope: func() *gorm.DB {
+			return db.Model(&domain.TodoItem{}).Where("list_id = ?", listId)
+		},
+		key: "id",
+	}
+}
+
+func listPositions(db *gorm.DB, userId int64) positions {
+	return positions{
+		scope: func() *gorm.DB {
+			return db.Model(&domain.UsersList{}).Where("user_id = ?", userId)
+		},
+		key: "list_id",
+	}
+}
+
+// last returns a position after all rows of the scope.
+func (p positions) last() (string, error) {
+	last, err := p.bound("MAX", 0, "", "")
+	if err != nil {
+		return "", err
+	}
+	return lexorank.Between(last, "")
+}
+
+// between returns a position for the row with the given key that puts it
+// after the row afterKey and before the row beforeKey. A zero key leaves that
+// side open, the row then goes right after afterKey or right before
+// beforeKey, and to the end when both are zero.
+func (p positions) between(key, afterKey, beforeKey int64) (string, error) {
+	after, err := p.of(afterKey)
+	if err != nil {
+		return "", err
+	}
+	before, err := p.of(beforeKey)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case afterKey == 0 && beforeKey == 0:
+		after, err = p.bound("MAX", key, "", "")
+	case beforeKey == 0:
+		before, err = p.bound("MIN", key, ">", after)
+	case afterKey == 0:
+		after, err = p.bound("MAX", key, "<", before)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	position, err := lexorank.Between(after, before)
+	if errors.Is(err, lexorank.ErrOrder) {
+		return "", ErrNeighbourOrder
+	}
+	return position, err
+}
+
+// of returns the position of the row with the given key, or an empty string
+// for a zero key.
+func (p positions) of(key int64) (string, error) {
+	if key == 0 {
+		return "", nil
+	}
+
+	var found []string
+	if err := p.scope().Where(p.key+" = ?", key).Pluck("position", &found).Error; err != nil {
+		return "", err
+	}
+	if len(found) == 0 {
+		return "", ErrNeighbourNotFound
+	}
+
+	return found[0], nil
+}
+
+// bound returns the lowest or highest position of the scope, leaving out the
+// row with key and, with op, only looking at positions compared to value.
+func (p positions) bound(aggregate string, key int64, op string, value string) (string, error) {
+	query := p.scope().Where(p.key+" <> ? AND position <> ''", key)
+	if op != "" {
+		query = query.Where(positionColumn+" "+op+" ?", value)
+	}
+
+	var position string
+	if err := query.Select("COALESCE(" + aggregate + "(" + positionColumn + "), '')").Row().Scan(&position); err != nil {
+		return "", err
+	}
+
+	return position, nil
+}
+
+// AssignPositions appends items and memberships saved before manual ordering
+// was introduced to their list, respectively to the lists of their user, in
+// the order they were created.
+func AssignPositions(db *gorm.DB) error {
+	var items []*domain.TodoItem
+	if err := db.Unscoped().Select("id", "list_id").Where("position = ''").Order("id").Find(&items).Error; err != nil {
+		return err
+	}
+	for _, item := range items {
+		position, err := itemPositions(db.Unscoped(), item.ListId).last()
+		if err != nil {
+			return err
+		}
+		if err := db.Unscoped().Model(item).Update("position", position).Error; err != nil {
+			return err
+		}
+	}
+
+	var members []*domain.UsersList
+	if err := db.Where("position = ''").Order("list_id").Find(&members).Error; err != nil {
+		return err
+	}
+	for _, member := range members {
+		position, err := listPositions(db, member.UserId).last()
+		if err != nil {
+			return err
+		}
+		if err := db.Model(member).Update("position", position).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -3,35 +3,145 @@ package repository
 import (
 	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/domain"
 	"gorm.io/gorm"
+	"time"
 )
 
 //go:generate mockgen -source=repository.go -destination=mocks/mock.go
 
 type TodoList interface {
-	Create(userId int64, list *domain.TodoList) error
-	GetAll(userId int64) ([]*domain.TodoList, error)
+	Create(userId int64, list *domain.TodoList, activity *domain.Activity) error
+	GetAll(userId int64, filter domain.ListFilter, sort domain.ListSort, limit int, after *domain.ListCursor) ([]*domain.TodoList, int64, error)
 	GetById(listId int64) (*domain.TodoList, error)
-	Delete(listId int64) error
-	Update(listId int64, input *domain.TodoList) (*domain.TodoList, error)
-	CheckUserAccessToList(userId int64, listId int64) error
+	Delete(listId int64, version int64, activity *domain.Activity) error
+	Update(listId int64, input *domain.TodoList, activity *domain.Activity) (*domain.TodoList, error)
+	Archive(listId int64, activity *domain.Activity) error
+	Unarchive(listId int64, activity *domain.Activity) error
+	CheckNotArchived(listId int64) error
+	Move(userId int64, listId int64, afterId int64, beforeId int64) error
+	CheckUserAccessToList(userId int64, listId int64, role domain.Role) error
+	AddMember(member *domain.UsersList, activity *domain.Activity) error
+	GetMembers(listId int64) ([]*domain.UsersList, error)
+	UpdateMemberRole(listId int64, userId int64, role domain.Role, activity *domain.Activity) error
+	RemoveMember(listId int64, userId int64, activity *domain.Activity) error
 }
 
 type TodoItem interface {
-	Create(item *domain.TodoItem) error
-	GetAll(listId int64) ([]*domain.TodoItem, error)
-	GetById(itemId int64) (*domain.TodoItem, int64, error)
-	Delete(itemId int64) error
-	Update(input *domain.TodoItem) error
+	Create(item *domain.TodoItem, activity *domain.Activity) error
+	GetAll(userId int64, listId int64, filter domain.ItemFilter, sort domain.ItemSort, limit int, after *domain.ItemCursor) ([]*domain.TodoItem, int64, error)
+	GetById(userId int64, itemId int64) (*domain.TodoItem, int64, error)
+	GetByIds(userId int64, itemIds []int64) ([]*domain.TodoItem, error)
+	Delete(itemId int64, reparentChildren bool, version int64, activity *domain.Activity) error
+	Update(input *domain.TodoItem, columns []string, activity *domain.Activity) error
+	Move(item *domain.TodoItem, afterId int64, beforeId int64, activity *domain.Activity) error
+	MoveToList(userId int64, itemIds []int64, listId int64, activity *domain.Activity) ([]*domain.TodoItem, error)
+	CopyToList(userId int64, itemIds []int64, listId int64, activity *domain.Activity) ([]*domain.TodoItem, error)
+	ApplyBatch(batch *domain.ItemBatch, activity *domain.Activity) error
+	CompleteSubtree(input *domain.TodoItem, columns []string, activity *domain.Activity) error
+	CompleteRecurring(input *domain.TodoItem, next *domain.TodoItem, completeDescendants bool, columns []string, activity *domain.Activity) error
+	GetDescendants(userId int64, itemId int64) ([]*domain.TodoItem, error)
+	GetDepth(itemId int64) (int, error)
+	GetDue(userId int64, after, before *time.Time, includeDone bool) ([]*domain.TodoItem, error)
+	Search(userId int64, query string, limit int) ([]*domain.SearchResult, error)
+}
+
+type Tag interface {
+	Create(tag *domain.Tag) error
+	GetAll(userId int64) ([]*domain.Tag, error)
+	GetById(tagId int64) (*domain.Tag, error)
+	Update(tag *domain.Tag) error
+	Delete(tagId int64) error
+	Attach(itemId int64, tagId int64, activity *domain.Activity) error
+	Detach(itemId int64, tagId int64, activity *domain.Activity) error
+}
+
+type Invitation interface {
+	Create(invitation *domain.Invitation, activity *domain.Activity) error
+	GetByToken(token string) (*domain.Invitation, error)
+	GetByInvitee(userId int64) ([]*domain.Invitation, error)
+	Activate(email string, userId int64) error
+	Accept(invitation *domain.Invitation, activity *domain.Activity) error
+	Delete(invitationId int64, activity *domain.Activity) error
+}
+
+type Comment interface {
+	Create(comment *domain.Comment, activity *domain.Activity) error
+	GetAll(itemId int64, limit int, after *domain.CommentCursor) ([]*domain.Comment, int64, error)
+	GetById(commentId int64) (*domain.Comment, error)
+	Update(comment *domain.Comment, activity *domain.Activity) error
+	Delete(commentId int64, activity *domain.Activity) error
+}
+
+type Attachment interface {
+	Create(attachment *domain.Attachment, activity *domain.Activity) error
+	GetAll(itemId int64) ([]*domain.Attachment, error)
+	GetById(attachmentId int64) (*domain.Attachment, error)
+	GetOrphans() ([]*domain.Attachment, error)
+	Delete(attachmentId int64, activity *domain.Activity) error
+}
+
+type Activity interface {
+	GetAll(listId int64, limit int, after *domain.ActivityCursor) ([]*domain.Activity, int64, error)
+	GetById(activityId int64) (*domain.Activity, error)
+	GetAfter(listId, afterId int64, limit int) ([]*domain.Activity, error)
+	ResumeAfter(listId, afterId int64, overlap time.Duration) (int64, error)
+}
+
+type Trash interface {
+	GetLists(userId int64) ([]*domain.TodoList, error)
+	GetItems(userId int64) ([]*domain.TodoItem, error)
+	GetItem(itemId int64) (*domain.TodoItem, error)
+	RestoreList(userId int64, listId int64, activity *domain.Activity) error
+	RestoreItem(item *domain.TodoItem, activity *domain.Activity) error
+	Empty(userId int64, activity *domain.Activity) error
+	Purge(before time.Time) error
+}
+
+type Transfer interface {
+	GetLists(userId int64, listIds []int64) ([]*domain.TodoList, error)
+	Import(userId int64, lists []*domain.TodoList, activity *domain.Activity) error
+	ImportItems(userId int64, listId int64, items []*domain.TodoItem, activity *domain.Activity) error
+	SyncItems(userId int64, listId int64, sync *domain.ItemSync, activity *domain.Activity) error
+}
+
+type Calendar interface {
+	GetOrCreate(feed *domain.CalendarFeed) (*domain.CalendarFeed, error)
+	Reset(feed *domain.CalendarFeed) error
+	GetByToken(token string) (*domain.CalendarFeed, error)
+}
+
+type Idempotency interface {
+	Claim(key *domain.IdempotencyKey, since, pendingSince time.Time) (*domain.IdempotencyKey, error)
+	Complete(key *domain.IdempotencyKey) error
+	Release(key *domain.IdempotencyKey) error
+	Purge(before time.Time) error
 }
 
 type Repository struct {
 	TodoList
 	TodoItem
+	Tag
+	Invitation
+	Comment
+	Attachment
+	Activity
+	Trash
+	Transfer
+	Calendar
+	Idempotency
 }
 
 func NewRepository(db *gorm.DB) *Repository {
 	return &Repository{
-		TodoList: NewTodoListPostgres(db),
-		TodoItem: NewTodoItemPostgres(db),
+		TodoList:    NewTodoListPostgres(db),
+		TodoItem:    NewTodoItemPostgres(db),
+		Tag:         NewTagPostgres(db),
+		Invitation:  NewInvitationPostgres(db),
+		Comment:     NewCommentPostgres(db),
+		Attachment:  NewAttachmentPostgres(db),
+		Activity:    NewActivityPostgres(db),
+		Trash:       NewTrashPostgres(db),
+		Transfer:    NewTransferPostgres(db),
+		Calendar:    NewCalendarPostgres(db),
+		Idempotency: NewIdempotencyPostgres(db),
 	}
 }
@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"errors"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/domain"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrTagNotFound = errors.New("tag not found")
+	ErrTagExists   = errors.New("tag with this name already exists")
+)
+
+type TagPostgres struct {
+	db *gorm.DB
+}
+
+func NewTagPostgres(db *gorm.DB) *TagPostgres {
+	return &TagPostgres{
+		db: db,
+	}
+}
+
+func (tp *TagPostgres) Create(tag *domain.Tag) error {
+	if err := tp.checkNameIsFree(tag); err != nil {
+		return err
+	}
+
+	return tp.db.Create(tag).Error
+}
+
+func (tp *TagPostgres) GetAll(userId int64) ([]*domain.Tag, error) {
+	var tags []*domain.Tag
+	if err := tp.db.Where(&domain.Tag{UserId: userId}).Order("name").Find(&tags).Error; err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+func (tp *TagPostgres) GetById(tagId int64) (*domain.Tag, error) {
+	var tag domain.Tag
+	if err := tp.db.Where(&domain.Tag{Id: tagId}).First(&tag).Error; err != nil {
+		return nil, ErrTagNotFound
+	}
+
+	return &tag, nil
+}
+
+func (tp *TagPostgres) Update(tag *domain.Tag) error {
+	if err := tp.checkNameIsFree(tag); err != nil {
+		return err
+	}
+
+	return tp.db.Save(tag).Error
+}
+
+func (tp *TagPostgres) Delete(tagId int64) error {
+	tx := tp.db.Begin()
+
+	if err := tx.Where("tag_id = ?", tagId).Delete(&domain.ItemsTag{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Delete(&domain.Tag{Id: tagId}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// Attach tags the item, attaching a tag twice changes nothing.
+func (tp *TagPostgres) Attach(itemId int64, tagId int64, activity *domain.Activity) error {
+	tx := tp.db.Begin()
+
+	itemTag := domain.ItemsTag{
+		ItemId: itemId,
+		TagId:  tagId,
+	}
+	result := tx.Where(&itemTag).FirstOrCreate(&itemTag)
+	if result.Error != nil {
+		tx.Rollback()
+		return result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		if err := recordTag(tx, activity, itemId, tagId, true); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit().Error
+}
+
+func (tp *TagPostgres) Detach(itemId int64, tagId int64, activity *domain.Activity) error {
+	tx := tp.db.Begin()
+
+	result := tx.Where("item_id = ? AND tag_id = ?", itemId, tagId).Delete(&domain.ItemsTag{})
+	if result.Error != nil {
+		tx.Rollback()
+		return result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		if err := recordTag(tx, activity, itemId, tagId, false); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit().Error
+}
+
+// recordTag records tagging or untagging an item. The snapshot holds the tag
+// itself, since tags belong to a single user and other members of the list
+// cannot look them up.
+func recordTag(tx *gorm.DB, activity *domain.Activity, itemId int64, tagId int64, attached bool) error {
+	var tag domain.Tag
+	if err := tx.Where(&domain.Tag{Id: tagId}).First(&tag).Error; err != nil {
+		return ErrTagNotFound
+	}
+
+	if attached {
+		return recordOnItem(tx, activity, itemId, domain.TargetTag, tagId, nil, tag)
+	}
+	return recordOnItem(tx, activity, itemId, domain.TargetTag, tagId, tag, nil)
+}
+
+// checkNameIsFree makes sure the user has no other tag with the same name.
+func (tp *TagPostgres) checkNameIsFree(tag *domain.Tag) error {
+	var count int64
+	if err := tp.db.Model(&domain.Tag{}).
+		Where("user_id = ? AND name = ? AND id <> ?", tag.UserId, tag.Name, tag.Id).
+		Count(&count).Error; err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return ErrTagExists
+	}
+
+	return nil
+}
@@ -4,6 +4,10 @@ import (
 	"errors"
 	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/domain"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"slices"
+	"strings"
+	"time"
 )
 
 var (
@@ -13,6 +17,35 @@ var (
 	ErrListItemNotFound = errors.New("list item not found")
 )
 
+const descendantIdsQuery = `
+	WITH RECURSIVE subtree AS (
+		SELECT id FROM todo_items WHERE parent_id = ?
+		UNION ALL
+		SELECT t.id FROM todo_items t JOIN subtree s ON t.parent_id = s.id
+	)
+	SELECT id FROM subtree`
+
+// searchConfig is the text search configuration used for indexing and
+// querying, so that e.g. "invoices" also finds "invoice".
+const searchConfig = "english"
+
+const searchVector = `
+	setweight(to_tsvector('` + searchConfig + `', coalesce(title, '')), 'A') ||
+	setweight(to_tsvector('` + searchConfig + `', coalesce(description, '')), 'B')`
+
+const searchQuery = `
+	SELECT todo_items.*, todo_lists.title AS list_title,
+		ts_rank(todo_items.search_vector, query) AS rank,
+		ts_headline('` + searchConfig + `', concat_ws(' - ', todo_items.title, NULLIF(todo_items.description, '')), query,
+			'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=20, MinWords=5') AS snippet
+	FROM todo_items
+	JOIN todo_lists ON todo_lists.id = todo_items.list_id AND todo_lists.deleted_at IS NULL
+	JOIN users_lists ON users_lists.list_id = todo_items.list_id AND users_lists.user_id = ?,
+		websearch_to_tsquery('` + searchConfig + `', ?) query
+	WHERE todo_items.search_vector @@ query AND todo_items.deleted_at IS NULL
+	ORDER BY rank DESC, todo_items.id DESC
+	LIMIT ?`
+
 type ItemPostgres struct {
 	db *gorm.DB
 }
@@ -23,13 +56,24 @@ func NewTodoItemPostgres(db *gorm.DB) *ItemPostgres {
 	}
 }
 
-func (ip *ItemPostgres) Create(item *domain.TodoItem) error {
+func (ip *ItemPostgres) Create(item *domain.TodoItem, activity *domain.Activity) error {
+	tx := ip.db.Begin()
+
 	var list domain.TodoList
-	if err := ip.db.Where(&domain.TodoList{Id: item.ListId}).First(&list).Error; err != nil {
+	if err := tx.Where(&domain.TodoList{Id: item.ListId}).First(&list).Error; err != nil {
+		tx.Rollback()
 		return ErrTodoListNotFound
 	}
 
-	if err := ip.db.Create(&item).Error; err != nil {
+	position, err := itemPositions(tx, item.ListId).last()
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	item.Position = position
+
+	if err := tx.Create(&item).Error; err != nil {
+		tx.Rollback()
 		return ErrCreateTodoItem
 	}
 
@@ -37,31 +81,372 @@ func (ip *ItemPostgres) Create(item *domain.TodoItem) error {
 		ListId: item.ListId,
 		ItemId: item.Id,
 	}
-	if err := ip.db.Create(&listItem).Error; err != nil {
+	if err := tx.Create(&listItem).Error; err != nil {
+		tx.Rollback()
 		return ErrCreateListItem
 	}
 
-	return nil
+	if err := indexForSearch(tx, item.Id); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := recordItem(tx, activity, item.ListId, nil, item); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
 }
 
-func (ip *ItemPostgres) GetAll(listId int64) ([]*domain.TodoItem, error) {
-	var listItems []domain.ListsItem
-	if result := ip.db.Where(&domain.ListsItem{ListId: listId}).Find(&listItems); result.Error != nil {
-		return nil, result.Error
+// GetAll returns up to limit items of the list that come after the cursor in
+// the given sort order, along with the number of items matching filter. Items
+// come with the tags the user put on them.
+func (ip *ItemPostgres) GetAll(userId int64, listId int64, filter domain.ItemFilter, sort domain.ItemSort, limit int, after *domain.ItemCursor) ([]*domain.TodoItem, int64, error) {
+	query := ip.db.Model(&domain.TodoItem{}).
+		Joins("JOIN lists_items ON lists_items.item_id = todo_items.id").
+		Where("lists_items.list_id = ?", listId)
+
+	if len(filter.TagIds) > 0 {
+		query = query.Where("todo_items.id IN (?)", taggedWithAll(ip.db, filter.TagIds))
+	}
+	if filter.Title != "" {
+		query = query.Where("todo_items.title ILIKE ?", containsPattern(filter.Title))
+	}
+	if filter.Done != nil {
+		query = query.Where("todo_items.done = ?", *filter.Done)
+	}
+	query = query.Session(&gorm.Session{})
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if after != nil {
+		query = query.Where(itemsAfter(sort, after))
 	}
 
 	var items []*domain.TodoItem
-	for _, listItem := range listItems {
-		var item *domain.TodoItem
-		if result := ip.db.Where(&domain.TodoItem{Id: listItem.ItemId}).First(&item); result.Error == nil {
+	if err := query.Select("todo_items.*").Order(itemOrder(sort)).Limit(limit).Find(&items).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := loadTags(ip.db, userId, items); err != nil {
+		return nil, 0, err
+	}
+
+	return items, total, nil
+}
+
+// itemOrder builds the ORDER BY clause for sort. Items without a due date
+// always go last and the id keeps the order stable between requests.
+func itemOrder(sort domain.ItemSort) string {
+	direction := "ASC"
+	if sort.Descending {
+		direction = "DESC"
+	}
+
+	var column string
+	switch sort.Field {
+	case domain.ItemSortPriority:
+		column = "todo_items.priority " + direction
+	case domain.ItemSortCreated:
+		column = "todo_items.created_at " + direction
+	case domain.ItemSortDue:
+		column = "todo_items.due_at " + direction + " NULLS LAST"
+	case domain.ItemSortTitle:
+		column = "LOWER(todo_items.title) " + direction
+	default:
+		column = "todo_items." + positionColumn + " " + direction
+	}
+
+	return column + ", todo_items.id " + direction
+}
+
+// itemsAfter builds the keyset condition selecting the items that follow the
+// cursor in the order built by itemOrder.
+func itemsAfter(sort domain.ItemSort, after *domain.ItemCursor) clause.Expression {
+	op := ">"
+	if sort.Descending {
+		op = "<"
+	}
+
+	switch sort.Field {
+	case domain.ItemSortPriority:
+		return gorm.Expr("(todo_items.priority, todo_items.id) "+op+" (?, ?)", after.Priority, after.Id)
+	case domain.ItemSortCreated:
+		return gorm.Expr("(todo_items.created_at, todo_items.id) "+op+" (?, ?)", after.CreatedAt, after.Id)
+	case domain.ItemSortDue:
+		// Items without a due date come last in both directions.
+		if after.DueAt == nil {
+			return gorm.Expr("todo_items.due_at IS NULL AND todo_items.id "+op+" ?", after.Id)
+		}
+		return gorm.Expr("((todo_items.due_at, todo_items.id) "+op+" (?, ?) OR todo_items.due_at IS NULL)", after.DueAt, after.Id)
+	case domain.ItemSortTitle:
+		return gorm.Expr("(LOWER(todo_items.title), todo_items.id) "+op+" (LOWER(?), ?)", after.Title, after.Id)
+	default:
+		return gorm.Expr("(todo_items."+positionColumn+", todo_items.id) "+op+" (?::text COLLATE \"C\", ?)", after.Position, after.Id)
+	}
+}
+
+// Move changes where the item goes in its list, see positions.between for
+// afterId and beforeId.
+func (ip *ItemPostgres) Move(item *domain.TodoItem, afterId int64, beforeId int64, activity *domain.Activity) error {
+	tx := ip.db.Begin()
+
+	position, err := itemPositions(tx, item.ListId).between(item.Id, afterId, beforeId)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	before := *item
+	if err := tx.Model(item).Updates(map[string]any{
+		"position": position,
+		"version":  gorm.Expr("version + 1"),
+	}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	item.Position = position
+	item.Version++
+
+	if err := recordItem(tx, activity, item.ListId, &before, item); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// MoveToList moves the items with their subtasks to the end of the list,
+// keeping their order. Subtasks moved without their parent become top-level
+// items. It returns the items with the given ids and the tags the user put on
+// them. Each of them is recorded in the list it comes from and the list it
+// goes to.
+func (ip *ItemPostgres) MoveToList(userId int64, itemIds []int64, listId int64, activity *domain.Activity) ([]*domain.TodoItem, error) {
+	tx := ip.db.Begin()
+
+	items, err := subtrees(tx, itemIds)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	moved := make(map[int64]bool, len(items))
+	ids := make([]int64, 0, len(items))
+	for _, item := range items {
+		moved[item.Id] = true
+		ids = append(ids, item.Id)
+	}
+
+	for _, item := range items {
+		position, err := itemPositions(tx, listId).last()
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		before := *item
+		updates := map[string]any{"list_id": listId, "position": position, "version": gorm.Expr("version + 1")}
+		if item.ParentId != nil && !moved[*item.ParentId] {
+			updates["parent_id"] = nil
+			item.ParentId = nil
+		}
+		if err := tx.Model(item).Updates(updates).Error; err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		item.ListId, item.Position = listId, position
+		item.Version++
+
+		if !slices.Contains(itemIds, item.Id) {
+			continue
+		}
+		if before.ListId != listId {
+			if err := recordItem(tx, activity, before.ListId, &before, item); err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+		}
+		if err := recordItem(tx, activity, listId, &before, item); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if err := tx.Model(&domain.ListsItem{}).Where("item_id IN ?", ids).Update("list_id", listId).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	return ip.GetByIds(userId, itemIds)
+}
+
+// CopyToList copies the items with their subtasks and tags to the end of the
+// list, keeping their order. It returns the copies of the items with the
+// given ids and the tags the user put on them, which are recorded in the
+// list.
+func (ip *ItemPostgres) CopyToList(userId int64, itemIds []int64, listId int64, activity *domain.Activity) ([]*domain.TodoItem, error) {
+	tx := ip.db.Begin()
+
+	items, err := subtrees(tx, itemIds)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	// Parents come first in the order of subtrees, so the copy of a parent
+	// exists by the time its subtasks are copied.
+	copies := make(map[int64]int64, len(items))
+	for _, item := range items {
+		position, err := itemPositions(tx, listId).last()
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		originalId := item.Id
+		item.Id = 0
+		item.ListId = listId
+		item.Position = position
+		item.CreatedAt = time.Time{}
+		if item.ParentId != nil {
+			if parentId, ok := copies[*item.ParentId]; ok {
+				item.ParentId = &parentId
+			} else {
+				item.ParentId = nil
+			}
+		}
+
+		if err := tx.Create(item).Error; err != nil {
+			tx.Rollback()
+			return nil, ErrCreateTodoItem
+		}
+		copies[originalId] = item.Id
+
+		if err := tx.Create(&domain.ListsItem{ListId: listId, ItemId: item.Id}).Error; err != nil {
+			tx.Rollback()
+			return nil, ErrCreateListItem
+		}
+
+		if err := tx.Exec("INSERT INTO items_tags (item_id, tag_id) SELECT ?, tag_id FROM items_tags WHERE item_id = ?",
+			item.Id, originalId).Error; err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		if err := indexForSearch(tx, item.Id); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		if slices.Contains(itemIds, originalId) {
+			if err := recordItem(tx, activity, listId, nil, item); err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	copyIds := make([]int64, 0, len(itemIds))
+	for _, id := range itemIds {
+		copyIds = append(copyIds, copies[id])
+	}
+
+	return ip.GetByIds(userId, copyIds)
+}
+
+// subtrees returns the items with the given ids and all of their subtasks,
+// each item once and parents before their subtasks. Items of the same list
+// keep their order.
+func subtrees(tx *gorm.DB, itemIds []int64) ([]*domain.TodoItem, error) {
+	ids := append([]int64{}, itemIds...)
+	for _, id := range itemIds {
+		var descendantIds []int64
+		if err := tx.Raw(descendantIdsQuery, id).Scan(&descendantIds).Error; err != nil {
+			return nil, err
+		}
+		ids = append(ids, descendantIds...)
+	}
+
+	var items []*domain.TodoItem
+	if err := tx.Where("id IN ?", ids).
+		Order("list_id, " + positionColumn + ", id").
+		Find(&items).Error; err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, ErrTodoItemNotFound
+	}
+
+	byId := make(map[int64]*domain.TodoItem, len(items))
+	for _, item := range items {
+		byId[item.Id] = item
+	}
+
+	ordered := make([]*domain.TodoItem, 0, len(items))
+	added := make(map[int64]bool, len(items))
+	var add func(item *domain.TodoItem)
+	add = func(item *domain.TodoItem) {
+		if added[item.Id] {
+			return
+		}
+		if item.ParentId != nil {
+			if parent, ok := byId[*item.ParentId]; ok {
+				add(parent)
+			}
+		}
+		added[item.Id] = true
+		ordered = append(ordered, item)
+	}
+	for _, item := range items {
+		add(item)
+	}
+
+	return ordered, nil
+}
+
+// GetByIds returns the items with the given ids in that order, with the tags
+// the user put on them. Ids of missing items are skipped.
+func (ip *ItemPostgres) GetByIds(userId int64, itemIds []int64) ([]*domain.TodoItem, error) {
+	var found []*domain.TodoItem
+	if err := ip.db.Where("id IN ?", itemIds).Find(&found).Error; err != nil {
+		return nil, err
+	}
+
+	byId := make(map[int64]*domain.TodoItem, len(found))
+	for _, item := range found {
+		byId[item.Id] = item
+	}
+
+	items := make([]*domain.TodoItem, 0, len(itemIds))
+	for _, id := range itemIds {
+		if item, ok := byId[id]; ok {
 			items = append(items, item)
+			delete(byId, id)
 		}
 	}
 
+	if err := loadTags(ip.db, userId, items); err != nil {
+		return nil, err
+	}
+
 	return items, nil
 }
 
-func (ip *ItemPostgres) GetById(itemId int64) (*domain.TodoItem, int64, error) {
+// GetById returns the item with the tags the user put on it, along with the
+// id of its list.
+func (ip *ItemPostgres) GetById(userId int64, itemId int64) (*domain.TodoItem, int64, error) {
 	var item domain.TodoItem
 	if err := ip.db.Where(&domain.TodoItem{Id: itemId}).First(&item).Error; err != nil {
 		return nil, 0, ErrTodoItemNotFound
@@ -72,34 +457,410 @@ func (ip *ItemPostgres) GetById(itemId int64) (*domain.TodoItem, int64, error) {
 		return nil, 0, ErrListItemNotFound
 	}
 
+	if err := loadTags(ip.db, userId, []*domain.TodoItem{&item}); err != nil {
+		return nil, 0, err
+	}
+
 	return &item, listItem.ListId, nil
 }
 
-func (ip *ItemPostgres) Delete(itemId int64) error {
+// Delete moves the item to the trash, along with its subtree unless its
+// children are moved up to its parent. Unless version is zero it must be the
+// current version of the item.
+func (ip *ItemPostgres) Delete(itemId int64, reparentChildren bool, version int64, activity *domain.Activity) error {
+	tx := ip.db.Begin()
+
 	var item domain.TodoItem
-	if result := ip.db.Where(&domain.TodoItem{Id: itemId}).First(&item); result.Error != nil {
-		return result.Error
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(&domain.TodoItem{Id: itemId}).First(&item).Error; err != nil {
+		tx.Rollback()
+		return ErrTodoItemNotFound
+	}
+
+	if version != 0 && version != item.Version {
+		tx.Rollback()
+		return ErrVersionConflict
 	}
 
-	var listItem domain.ListsItem
-	if result := ip.db.Where(&domain.ListsItem{ItemId: itemId}).First(&listItem); result.Error != nil {
-		return result.Error
+	ids := []int64{itemId}
+	if reparentChildren {
+		if err := tx.Model(&domain.TodoItem{}).
+			Where("parent_id = ?", itemId).
+			Updates(map[string]any{
+				"parent_id": item.ParentId,
+				"version":   gorm.Expr("version + 1"),
+			}).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+	} else {
+		var descendantIds []int64
+		if err := tx.Raw(descendantIdsQuery, itemId).Scan(&descendantIds).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+		ids = append(ids, descendantIds...)
 	}
 
-	if err := ip.db.Delete(&listItem).Error; err != nil {
+	if err := tx.Where("id IN ?", ids).Delete(&domain.TodoItem{}).Error; err != nil {
+		tx.Rollback()
 		return err
 	}
 
-	if err := ip.db.Delete(&item).Error; err != nil {
+	if err := recordItem(tx, activity, item.ListId, &item, nil); err != nil {
+		tx.Rollback()
 		return err
 	}
 
-	return nil
+	return tx.Commit().Error
 }
 
-func (ip *ItemPostgres) Update(input *domain.TodoItem) error {
-	if err := ip.db.Save(&input).Error; err != nil {
+// Update saves the named columns of input, all of them when there are none.
+func (ip *ItemPostgres) Update(input *domain.TodoItem, columns []string, activity *domain.Activity) error {
+	tx := ip.db.Begin()
+
+	if err := saveItem(tx, input, columns, activity); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := indexForSearch(tx, input.Id); err != nil {
+		tx.Rollback()
 		return err
 	}
+
+	return tx.Commit().Error
+}
+
+func (ip *ItemPostgres) CompleteSubtree(input *domain.TodoItem, columns []string, activity *domain.Activity) error {
+	tx := ip.db.Begin()
+
+	if err := saveItem(tx, input, columns, activity); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := indexForSearch(tx, input.Id); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := completeDescendants(tx, input.Id); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// CompleteRecurring saves the completed occurrence input of a recurring item
+// and creates the next occurrence with the same tags in one transaction.
+func (ip *ItemPostgres) CompleteRecurring(input *domain.TodoItem, next *domain.TodoItem, withDescendants bool, columns []string, activity *domain.Activity) error {
+	tx := ip.db.Begin()
+
+	if err := saveItem(tx, input, columns, activity); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := indexForSearch(tx, input.Id); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if withDescendants {
+		if err := completeDescendants(tx, input.Id); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := createOccurrence(tx, input, next); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// ApplyBatch saves, creates and deletes the items of batch in one
+// transaction. Batches never change titles or descriptions, so saved items
+// keep their search vector. Every saved and deleted item is recorded once,
+// subtasks deleted along with their parent are not recorded again.
+func (ip *ItemPostgres) ApplyBatch(batch *domain.ItemBatch, activity *domain.Activity) error {
+	tx := ip.db.Begin()
+
+	for _, item := range batch.Save {
+		if err := saveItem(tx, item, nil, activity); err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		if next, ok := batch.Next[item.Id]; ok {
+			if err := createOccurrence(tx, item, next); err != nil {
+				tx.Rollback()
+				return err
+			}
+		}
+	}
+
+	// Subtasks go to the trash with their parents, so the batch may name
+	// items that are already there.
+	trashed := make(map[int64]bool)
+	for _, itemId := range batch.Delete {
+		if trashed[itemId] {
+			continue
+		}
+
+		var item domain.TodoItem
+		if err := tx.Where(&domain.TodoItem{Id: itemId}).First(&item).Error; err != nil {
+			tx.Rollback()
+			return ErrTodoItemNotFound
+		}
+
+		var ids []int64
+		if err := tx.Raw(descendantIdsQuery, itemId).Scan(&ids).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+		ids = append(ids, itemId)
+
+		if err := tx.Where("id IN ?", ids).Delete(&domain.TodoItem{}).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+		for _, id := range ids {
+			trashed[id] = true
+		}
+
+		if err := recordItem(tx, activity, item.ListId, &item, nil); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit().Error
+}
+
+// saveItem saves the changed item and records it along with the state it was
+// in before. The item must not have been changed since it was read, which its
+// version tells. Only the named columns are written, all of them when there
+// are none.
+func saveItem(tx *gorm.DB, item *domain.TodoItem, columns []string, activity *domain.Activity) error {
+	var before domain.TodoItem
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(&domain.TodoItem{Id: item.Id}).First(&before).Error; err != nil {
+		return ErrTodoItemNotFound
+	}
+
+	if item.Version != before.Version {
+		return ErrVersionConflict
+	}
+	item.Version++
+
+	if len(columns) != 0 {
+		if err := tx.Model(item).Select(append(slices.Clip(columns), "version")).Updates(item).Error; err != nil {
+			return err
+		}
+	} else if err := tx.Save(item).Error; err != nil {
+		return err
+	}
+
+	return recordItem(tx, activity, item.ListId, &before, item)
+}
+
+// createOccurrence creates next, the occurrence following the completed
+// item, right after it with the same tags, those of every member included.
+func createOccurrence(tx *gorm.DB, completed *domain.TodoItem, next *domain.TodoItem) error {
+	position, err := itemPositions(tx, next.ListId).between(0, completed.Id, 0)
+	if err != nil {
+		return err
+	}
+	next.Position = position
+
+	if err := tx.Create(next).Error; err != nil {
+		return ErrCreateTodoItem
+	}
+
+	listItem := domain.ListsItem{
+		ListId: next.ListId,
+		ItemId: next.Id,
+	}
+	if err := tx.Create(&listItem).Error; err != nil {
+		return ErrCreateListItem
+	}
+
+	if err := indexForSearch(tx, next.Id); err != nil {
+		return err
+	}
+
+	return tx.Exec("INSERT INTO items_tags (item_id, tag_id) SELECT ?, tag_id FROM items_tags WHERE item_id = ?",
+		next.Id, completed.Id).Error
+}
+
+func completeDescendants(tx *gorm.DB, itemId int64) error {
+	return tx.Model(&domain.TodoItem{}).
+		Where("id IN (?)", tx.Raw(descendantIdsQuery, itemId)).
+		Updates(map[string]any{
+			"done":    true,
+			"version": gorm.Expr("version + 1"),
+		}).Error
+}
+
+// GetDescendants returns the subtasks of the item at any depth, with the
+// tags the user put on them.
+func (ip *ItemPostgres) GetDescendants(userId int64, itemId int64) ([]*domain.TodoItem, error) {
+	var items []*domain.TodoItem
+	if err := ip.db.
+		Where("id IN (?)", ip.db.Raw(descendantIdsQuery, itemId)).
+		Order("id").
+		Find(&items).Error; err != nil {
+		return nil, err
+	}
+
+	if err := loadTags(ip.db, userId, items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (ip *ItemPostgres) GetDepth(itemId int64) (int, error) {
+	var depth int
+	if err := ip.db.Raw(`
+		WITH RECURSIVE ancestors AS (
+			SELECT id, parent_id, 1 AS depth FROM todo_items WHERE id = ?
+			UNION ALL
+			SELECT t.id, t.parent_id, a.depth + 1 FROM todo_items t JOIN ancestors a ON t.id = a.parent_id
+		)
+		SELECT COALESCE(MAX(depth), 0) FROM ancestors`, itemId).Scan(&depth).Error; err != nil {
+		return 0, err
+	}
+
+	if depth == 0 {
+		return 0, ErrTodoItemNotFound
+	}
+
+	return depth, nil
+}
+
+// GetDue returns the items with a due date from all lists of the user, in
+// due order, with the tags the user put on them.
+func (ip *ItemPostgres) GetDue(userId int64, after, before *time.Time, includeDone bool) ([]*domain.TodoItem, error) {
+	query := ip.db.Model(&domain.TodoItem{}).
+		Select("todo_items.*").
+		Joins("JOIN users_lists ON users_lists.list_id = todo_items.list_id").
+		Where("users_lists.user_id = ? AND todo_items.due_at IS NOT NULL", userId)
+
+	if after != nil {
+		query = query.Where("todo_items.due_at >= ?", *after)
+	}
+	if before != nil {
+		query = query.Where("todo_items.due_at < ?", *before)
+	}
+	if !includeDone {
+		query = query.Where("todo_items.done = ?", false)
+	}
+
+	var items []*domain.TodoItem
+	if err := query.Order("todo_items.due_at, todo_items.id").Find(&items).Error; err != nil {
+		return nil, err
+	}
+
+	if err := loadTags(ip.db, userId, items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+type itemTag struct {
+	domain.Tag
+	ItemId int64
+}
+
+// Search returns up to limit items from lists the user is a member of that
+// match query, best matches first. query uses the syntax of web search
+// engines, e.g. `invoice -paid "due date"`.
+func (ip *ItemPostgres) Search(userId int64, query string, limit int) ([]*domain.SearchResult, error) {
+	var results []*domain.SearchResult
+	if err := ip.db.Raw(searchQuery, userId, query, limit).Scan(&results).Error; err != nil {
+		return nil, err
+	}
+
+	items := make([]*domain.TodoItem, len(results))
+	for i, result := range results {
+		items[i] = &result.Item
+	}
+	if err := loadTags(ip.db, userId, items); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// indexForSearch recomputes the search vector of the items with ids.
+func indexForSearch(db *gorm.DB, ids ...int64) error {
+	return db.Exec("UPDATE todo_items SET search_vector = "+searchVector+" WHERE id IN ?", ids).Error
+}
+
+// IndexItemsForSearch computes the search vector of items saved before
+// full-text search was introduced.
+func IndexItemsForSearch(db *gorm.DB) error {
+	return db.Exec("UPDATE todo_items SET search_vector = " + searchVector + " WHERE search_vector IS NULL").Error
+}
+
+// loadTags fills in the tags the user put on the given items with a single
+// query. Tags are private to whoever created them, so those of other members
+// of shared lists are left out.
+func loadTags(db *gorm.DB, userId int64, items []*domain.TodoItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	byId := make(map[int64]*domain.TodoItem, len(items))
+	ids := make([]int64, 0, len(items))
+	for _, item := range items {
+		byId[item.Id] = item
+		ids = append(ids, item.Id)
+	}
+
+	var rows []itemTag
+	if err := db.Model(&domain.Tag{}).
+		Select("tags.*, items_tags.item_id").
+		Joins("JOIN items_tags ON items_tags.tag_id = tags.id").
+		Where("items_tags.item_id IN ? AND tags.user_id = ?", ids, userId).
+		Order("tags.name").
+		Scan(&rows).Error; err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		item := byId[row.ItemId]
+		item.Tags = append(item.Tags, row.Tag)
+	}
+
 	return nil
 }
+
+// taggedWithAll returns a subquery selecting the ids of items carrying every
+// one of tagIds.
+func taggedWithAll(db *gorm.DB, tagIds []int64) *gorm.DB {
+	return db.Model(&domain.ItemsTag{}).
+		Select("item_id").
+		Where("tag_id IN ?", tagIds).
+		Group("item_id").
+		Having("COUNT(DISTINCT tag_id) = ?", len(uniqueIds(tagIds)))
+}
+
+// containsPattern returns an ILIKE pattern matching values that contain s.
+func containsPattern(s string) string {
+	s = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+	return "%" + s + "%"
+}
+
+func uniqueIds(ids []int64) map[int64]struct{} {
+	set := make(map[int64]struct{}, len(ids))
+	for _, id := range ids {
+		set[id] = struct{}{}
+	}
+	return set
+}
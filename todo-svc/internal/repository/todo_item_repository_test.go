@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"database/sql/driver"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"testing"
+)
+
+func setupMockDB() (*gorm.DB, sqlmock.Sqlmock, error) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return gormDB, mock, nil
+}
+
+func TestItemPostgres_ApplyBatch(t *testing.T) {
+	gormDB, mock, err := setupMockDB()
+	assert.NoError(t, err)
+
+	defer func() {
+		sqlDB, _ := gormDB.DB()
+		sqlDB.Close()
+	}()
+
+	itemRepo := NewTodoItemPostgres(gormDB)
+
+	expectItem := func(id int64) {
+		mock.ExpectQuery(`SELECT \* FROM "todo_items" WHERE "todo_items"."id" = \$1 AND "todo_items"."deleted_at" IS NULL`).
+			WithArgs(id, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "list_id"}).AddRow(id, 1))
+	}
+	expectDescendants := func(id int64, descendants ...int64) {
+		rows := sqlmock.NewRows([]string{"id"})
+		for _, descendant := range descendants {
+			rows.AddRow(descendant)
+		}
+		mock.ExpectQuery(`WITH RECURSIVE subtree AS`).WithArgs(id).WillReturnRows(rows)
+	}
+	expectTrash := func(ids ...driver.Value) {
+		mock.ExpectExec(`UPDATE "todo_items" SET "deleted_at"=\$1 WHERE id IN \(.+\) AND "todo_items"."deleted_at" IS NULL`).
+			WithArgs(append([]driver.Value{sqlmock.AnyArg()}, ids...)...).
+			WillReturnResult(sqlmock.NewResult(0, int64(len(ids))))
+	}
+
+	tests := []struct {
+		name      string
+		batch     *domain.ItemBatch
+		mockSetup func()
+	}{
+		{
+			name:  "Parent before its subtask",
+			batch: &domain.ItemBatch{Delete: []int64{1, 2}},
+			mockSetup: func() {
+				mock.ExpectBegin()
+				expectItem(1)
+				expectDescendants(1, 2)
+				expectTrash(int64(2), int64(1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:  "Subtask before its parent",
+			batch: &domain.ItemBatch{Delete: []int64{2, 1}},
+			mockSetup: func() {
+				mock.ExpectBegin()
+				expectItem(2)
+				expectDescendants(2)
+				expectTrash(int64(2))
+				expectItem(1)
+				expectDescendants(1, 2)
+				expectTrash(int64(2), int64(1))
+				mock.ExpectCommit()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+
+			err := itemRepo.ApplyBatch(tt.batch, nil)
+
+			assert.NoError(t, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -2,14 +2,20 @@ package repository
 
 import (
 	"errors"
-	"fmt"
 	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/domain"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
 )
 
 var (
 	ErrTodoListNotFound  = errors.New("todo list not found")
 	ErrUsersListNotFound = errors.New("users list not found")
+	ErrInsufficientRole  = errors.New("user role does not allow this action")
+	ErrListArchived      = errors.New("list is archived")
+	ErrMemberExists      = errors.New("user is already a member of the list")
+	ErrLastOwner         = errors.New("list must keep at least one owner")
+	ErrVersionConflict   = errors.New("changed since the given version")
 )
 
 type ListPostgres struct {
@@ -22,22 +28,35 @@ func NewTodoListPostgres(db *gorm.DB) *ListPostgres {
 	}
 }
 
-func (lp *ListPostgres) Create(userId int64, list *domain.TodoList) error {
+func (lp *ListPostgres) Create(userId int64, list *domain.TodoList, activity *domain.Activity) error {
 	tx := lp.db.Begin()
 	if err := tx.Create(list).Error; err != nil {
 		tx.Rollback()
 		return err
 	}
 
+	position, err := listPositions(tx, userId).last()
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
 	userList := domain.UsersList{
-		UserId: userId,
-		ListId: list.Id,
+		UserId:   userId,
+		ListId:   list.Id,
+		Role:     domain.RoleOwner,
+		Position: position,
 	}
 	if err := tx.Create(&userList).Error; err != nil {
 		tx.Rollback()
 		return err
 	}
 
+	if err := record(tx, activity, list.Id, domain.TargetList, list.Id, nil, list); err != nil {
+		tx.Rollback()
+		return err
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		return err
 	}
@@ -45,20 +64,49 @@ func (lp *ListPostgres) Create(userId int64, list *domain.TodoList) error {
 	return nil
 }
 
-func (lp *ListPostgres) GetAll(userId int64) ([]*domain.TodoList, error) {
-	var userLists []domain.UsersList
-	if err := lp.db.Where(&domain.UsersList{UserId: userId}).Find(&userLists).Error; err != nil {
-		return nil, ErrUsersListNotFound
+// GetAll returns up to limit lists of the user that come after the cursor in
+// the given sort order, along with the number of lists matching filter.
+func (lp *ListPostgres) GetAll(userId int64, filter domain.ListFilter, sort domain.ListSort, limit int, after *domain.ListCursor) ([]*domain.TodoList, int64, error) {
+	query := lp.db.Model(&domain.TodoList{}).
+		Joins("JOIN users_lists ON users_lists.list_id = todo_lists.id").
+		Where("users_lists.user_id = ?", userId)
+
+	if len(filter.TagIds) > 0 {
+		query = query.Where("todo_lists.id IN (?)", lp.db.Model(&domain.TodoItem{}).
+			Select("list_id").
+			Where("id IN (?)", taggedWithAll(lp.db, filter.TagIds)))
+	}
+	if filter.Title != "" {
+		query = query.Where("todo_lists.title ILIKE ?", containsPattern(filter.Title))
+	}
+	if !filter.IncludeArchived {
+		query = query.Where("todo_lists.archived_at IS NULL")
 	}
+	query = query.Session(&gorm.Session{})
 
-	var todoLists []*domain.TodoList
-	for _, userList := range userLists {
-		var list *domain.TodoList
-		if err := lp.db.Where(&domain.TodoList{Id: userList.ListId}).First(&list).Error; err == nil {
-			todoLists = append(todoLists, list)
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, ErrUsersListNotFound
+	}
+
+	order := "todo_lists.id"
+	if sort == domain.ListSortManual {
+		order = "users_lists." + positionColumn + ", todo_lists.id"
+	}
+
+	if after != nil {
+		if sort == domain.ListSortManual {
+			query = query.Where("(users_lists."+positionColumn+", todo_lists.id) > (?::text COLLATE \"C\", ?)", after.Position, after.Id)
+		} else {
+			query = query.Where("todo_lists.id > ?", after.Id)
 		}
 	}
-	return todoLists, nil
+
+	var todoLists []*domain.TodoList
+	if err := query.Select("todo_lists.*, users_lists.position").Order(order).Limit(limit).Find(&todoLists).Error; err != nil {
+		return nil, 0, ErrUsersListNotFound
+	}
+	return todoLists, total, nil
 }
 
 func (lp *ListPostgres) GetById(listId int64) (*domain.TodoList, error) {
@@ -69,7 +117,19 @@ func (lp *ListPostgres) GetById(listId int64) (*domain.TodoList, error) {
 	return &list, nil
 }
 
-func (lp *ListPostgres) Delete(listId int64) error {
+// Archive makes the items of the list read-only, archiving an archived list
+// keeps the time it was first archived at.
+func (lp *ListPostgres) Archive(listId int64, activity *domain.Activity) error {
+	now := time.Now()
+	return lp.setArchivedAt(listId, &now, activity)
+}
+
+func (lp *ListPostgres) Unarchive(listId int64, activity *domain.Activity) error {
+	return lp.setArchivedAt(listId, nil, activity)
+}
+
+// setArchivedAt archives or unarchives the list unless it already is.
+func (lp *ListPostgres) setArchivedAt(listId int64, archivedAt *time.Time, activity *domain.Activity) error {
 	tx := lp.db.Begin()
 
 	var list domain.TodoList
@@ -78,23 +138,72 @@ func (lp *ListPostgres) Delete(listId int64) error {
 		return ErrTodoListNotFound
 	}
 
-	if err := tx.Where("list_id = ?", listId).Delete(&domain.TodoItem{}).Error; err != nil {
+	if (list.ArchivedAt == nil) == (archivedAt == nil) {
+		tx.Rollback()
+		return nil
+	}
+
+	before := list
+	if err := tx.Model(&list).Updates(map[string]any{
+		"archived_at": archivedAt,
+		"version":     gorm.Expr("version + 1"),
+	}).Error; err != nil {
 		tx.Rollback()
 		return err
 	}
+	list.ArchivedAt = archivedAt
+	list.Version++
+
+	if err := record(tx, activity, listId, domain.TargetList, listId, before, list); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (lp *ListPostgres) CheckNotArchived(listId int64) error {
+	var list domain.TodoList
+	if err := lp.db.Select("archived_at").Where("id = ?", listId).First(&list).Error; err != nil {
+		return ErrTodoListNotFound
+	}
+
+	if list.ArchivedAt != nil {
+		return ErrListArchived
+	}
+
+	return nil
+}
+
+// Delete moves the list and its items to the trash. Unless version is zero
+// it must be the current version of the list.
+func (lp *ListPostgres) Delete(listId int64, version int64, activity *domain.Activity) error {
+	tx := lp.db.Begin()
+
+	var list domain.TodoList
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(&domain.TodoList{Id: listId}).First(&list).Error; err != nil {
+		tx.Rollback()
+		return ErrTodoListNotFound
+	}
+
+	if version != 0 && version != list.Version {
+		tx.Rollback()
+		return ErrVersionConflict
+	}
 
-	var userList domain.UsersList
-	if err := tx.Where(&domain.UsersList{ListId: listId}).First(&userList).Error; err != nil {
+	now := time.Now()
+	if err := tx.Model(&domain.TodoItem{}).Where("list_id = ?", listId).Update("deleted_at", now).Error; err != nil {
 		tx.Rollback()
 		return err
 	}
 
-	if err := tx.Delete(&userList).Error; err != nil {
+	before := list
+	if err := tx.Model(&list).Update("deleted_at", now).Error; err != nil {
 		tx.Rollback()
 		return err
 	}
 
-	if err := tx.Delete(&list).Error; err != nil {
+	if err := record(tx, activity, listId, domain.TargetList, listId, before, nil); err != nil {
 		tx.Rollback()
 		return err
 	}
@@ -102,31 +211,207 @@ func (lp *ListPostgres) Delete(listId int64) error {
 	return tx.Commit().Error
 }
 
-func (lp *ListPostgres) Update(listId int64, input *domain.TodoList) (*domain.TodoList, error) {
+// Update changes the title of the list. Unless input.Version is zero it
+// must be the current version of the list.
+func (lp *ListPostgres) Update(listId int64, input *domain.TodoList, activity *domain.Activity) (*domain.TodoList, error) {
+	tx := lp.db.Begin()
+
 	var list domain.TodoList
-	if err := lp.db.Where(&domain.TodoList{Id: listId}).First(&list).Error; err != nil {
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(&domain.TodoList{Id: listId}).First(&list).Error; err != nil {
+		tx.Rollback()
 		return nil, ErrTodoListNotFound
 	}
 
+	if input.Version != 0 && input.Version != list.Version {
+		tx.Rollback()
+		return nil, ErrVersionConflict
+	}
+
+	before := list
 	list.Title = input.Title
-	if err := lp.db.Save(&list).Error; err != nil {
+	list.Version++
+	if err := tx.Save(&list).Error; err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := record(tx, activity, listId, domain.TargetList, listId, before, list); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
 		return nil, err
 	}
 
 	return &list, nil
 }
 
-func (lp *ListPostgres) CheckUserAccessToList(userId int64, listId int64) error {
+func (lp *ListPostgres) CheckUserAccessToList(userId int64, listId int64, role domain.Role) error {
+	var member domain.UsersList
+	if err := lp.db.Where("user_id = ? AND list_id = ?", userId, listId).First(&member).Error; err != nil {
+		return ErrUsersListNotFound
+	}
+
+	if member.Role < role {
+		return ErrInsufficientRole
+	}
+
 	var count int64
+	if err := lp.db.Model(&domain.TodoList{}).Where("id = ?", listId).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return ErrTodoListNotFound
+	}
 
-	if err := lp.db.Model(&domain.UsersList{}).
-		Where("user_id = ? AND list_id = ?", userId, listId).
+	return nil
+}
+
+func (lp *ListPostgres) AddMember(member *domain.UsersList, activity *domain.Activity) error {
+	tx := lp.db.Begin()
+
+	var count int64
+	if err := tx.Model(&domain.UsersList{}).
+		Where("user_id = ? AND list_id = ?", member.UserId, member.ListId).
 		Count(&count).Error; err != nil {
+		tx.Rollback()
 		return err
 	}
 
-	if count == 0 {
-		return fmt.Errorf("user does not have access to the list")
+	if count != 0 {
+		tx.Rollback()
+		return ErrMemberExists
+	}
+
+	position, err := listPositions(tx, member.UserId).last()
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	member.Position = position
+
+	if err := tx.Create(member).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := record(tx, activity, member.ListId, domain.TargetMember, member.UserId, nil, member); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// Move changes where the list goes among the lists of the user, see
+// positions.between for afterId and beforeId.
+func (lp *ListPostgres) Move(userId int64, listId int64, afterId int64, beforeId int64) error {
+	tx := lp.db.Begin()
+
+	position, err := listPositions(tx, userId).between(listId, afterId, beforeId)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	result := tx.Model(&domain.UsersList{}).
+		Where("user_id = ? AND list_id = ?", userId, listId).
+		Update("position", position)
+	if result.Error != nil {
+		tx.Rollback()
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		return ErrUsersListNotFound
+	}
+
+	return tx.Commit().Error
+}
+
+func (lp *ListPostgres) GetMembers(listId int64) ([]*domain.UsersList, error) {
+	var members []*domain.UsersList
+	if err := lp.db.Where("list_id = ?", listId).Order("role DESC, user_id").Find(&members).Error; err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+func (lp *ListPostgres) UpdateMemberRole(listId int64, userId int64, role domain.Role, activity *domain.Activity) error {
+	tx := lp.db.Begin()
+
+	var member domain.UsersList
+	if err := tx.Where("user_id = ? AND list_id = ?", userId, listId).First(&member).Error; err != nil {
+		tx.Rollback()
+		return ErrUsersListNotFound
+	}
+
+	if member.Role == domain.RoleOwner && role != domain.RoleOwner {
+		if err := checkOtherOwners(tx, listId, userId); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	before := member
+	if err := tx.Model(&member).Update("role", role).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	member.Role = role
+
+	if err := record(tx, activity, listId, domain.TargetMember, userId, before, member); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (lp *ListPostgres) RemoveMember(listId int64, userId int64, activity *domain.Activity) error {
+	tx := lp.db.Begin()
+
+	var member domain.UsersList
+	if err := tx.Where("user_id = ? AND list_id = ?", userId, listId).First(&member).Error; err != nil {
+		tx.Rollback()
+		return ErrUsersListNotFound
+	}
+
+	if member.Role == domain.RoleOwner {
+		if err := checkOtherOwners(tx, listId, userId); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Delete(&member).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := record(tx, activity, listId, domain.TargetMember, userId, member, nil); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// checkOtherOwners makes sure the list keeps an owner once userId stops being
+// one. The owners are locked so that two owners cannot demote each other at
+// the same time.
+func checkOtherOwners(tx *gorm.DB, listId int64, userId int64) error {
+	var ownerIds []int64
+	if err := tx.Model(&domain.UsersList{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("list_id = ? AND role = ? AND user_id <> ?", listId, domain.RoleOwner, userId).
+		Pluck("user_id", &ownerIds).Error; err != nil {
+		return err
+	}
+
+	if len(ownerIds) == 0 {
+		return ErrLastOwner
 	}
 
 	return nil
@@ -0,0 +1,268 @@
+package repository
+
+import (
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TransferPostgres struct {
+	db *gorm.DB
+}
+
+func NewTransferPostgres(db *gorm.DB) *TransferPostgres {
+	return &TransferPostgres{
+		db: db,
+	}
+}
+
+// GetLists returns the lists of the user with the given ids, all of them when
+// there are none, in the user's order. The items of every list come as a
+// tree in their order, with the tags the user put on them. Tags belong to
+// whoever created them, so those of other members of shared lists are left
+// out.
+func (tp *TransferPostgres) GetLists(userId int64, listIds []int64) ([]*domain.TodoList, error) {
+	query := tp.db.Model(&domain.TodoList{}).
+		Joins("JOIN users_lists ON users_lists.list_id = todo_lists.id").
+		Where("users_lists.user_id = ?", userId)
+	if len(listIds) > 0 {
+		query = query.Where("todo_lists.id IN ?", listIds)
+	}
+
+	var lists []*domain.TodoList
+	if err := query.Select("todo_lists.*, users_lists.position").
+		Order("users_lists." + positionColumn + ", todo_lists.id").
+		Find(&lists).Error; err != nil {
+		return nil, err
+	}
+	if len(lists) == 0 {
+		return lists, nil
+	}
+
+	ids := make([]int64, len(lists))
+	for i, list := range lists {
+		ids[i] = list.Id
+	}
+
+	var items []*domain.TodoItem
+	if err := tp.db.Where("list_id IN ?", ids).
+		Order(positionColumn + ", id").
+		Find(&items).Error; err != nil {
+		return nil, err
+	}
+	if err := loadTags(tp.db, userId, items); err != nil {
+		return nil, err
+	}
+
+	byId := make(map[int64]*domain.TodoItem, len(items))
+	for _, item := range items {
+		byId[item.Id] = item
+	}
+
+	// Subtasks are linked to their parents before the top-level items are
+	// copied into their lists, so that the copies share them.
+	top := make(map[int64][]*domain.TodoItem, len(lists))
+	for _, item := range items {
+		if item.ParentId != nil {
+			if parent, ok := byId[*item.ParentId]; ok {
+				parent.Children = append(parent.Children, item)
+				continue
+			}
+		}
+		top[item.ListId] = append(top[item.ListId], item)
+	}
+	for _, list := range lists {
+		for _, item := range top[list.Id] {
+			list.Items = append(list.Items, *item)
+		}
+	}
+
+	return lists, nil
+}
+
+// Import creates the lists with their items, subtasks and tags in one
+// transaction, owned by the user. Tags are matched to the tags of the user by
+// name and created when missing. The ids of everything created are set on
+// lists and every creation is recorded.
+func (tp *TransferPostgres) Import(userId int64, lists []*domain.TodoList, activity *domain.Activity) error {
+	tx := tp.db.Begin()
+
+	tagIds := make(map[string]int64)
+	for _, list := range lists {
+		if err := tx.Omit(clause.Associations).Create(list).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		position, err := listPositions(tx, userId).last()
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		member := domain.UsersList{
+			UserId:   userId,
+			ListId:   list.Id,
+			Role:     domain.RoleOwner,
+			Position: position,
+		}
+		if err := tx.Create(&member).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		// The items are recorded one by one as they are created.
+		snapshot := *list
+		snapshot.Items = nil
+		if err := record(tx, activity, list.Id, domain.TargetList, list.Id, nil, snapshot); err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		for i := range list.Items {
+			if err := importItem(tx, userId, list.Id, &list.Items[i], nil, tagIds, activity); err != nil {
+				tx.Rollback()
+				return err
+			}
+		}
+	}
+
+	return tx.Commit().Error
+}
+
+// ImportItems adds the items with their subtasks and tags to the end of an
+// existing list in one transaction, the way Import adds them to new lists.
+func (tp *TransferPostgres) ImportItems(userId int64, listId int64, items []*domain.TodoItem, activity *domain.Activity) error {
+	tx := tp.db.Begin()
+
+	tagIds := make(map[string]int64)
+	for _, item := range items {
+		if err := importItem(tx, userId, listId, item, nil, tagIds, activity); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit().Error
+}
+
+// SyncItems applies sync to the list in one transaction. Updated items must
+// be of the list and hold the version they were read at. The tags the user
+// put on them are replaced with the tags they hold, tags of other members
+// of the list stay. Every saved and created item is recorded, created ones
+// as such.
+func (tp *TransferPostgres) SyncItems(userId int64, listId int64, sync *domain.ItemSync, activity *domain.Activity) error {
+	tx := tp.db.Begin()
+
+	tagIds := make(map[string]int64)
+	for _, item := range sync.Update {
+		if item.ListId != listId {
+			tx.Rollback()
+			return ErrTodoItemNotFound
+		}
+
+		if err := saveItem(tx, item, nil, activity); err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		if err := tx.Where("item_id = ? AND tag_id IN (?)", item.Id,
+			tx.Model(&domain.Tag{}).Select("id").Where("user_id = ?", userId)).
+			Delete(&domain.ItemsTag{}).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		if err := attachTags(tx, userId, item, tagIds); err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		if err := indexForSearch(tx, item.Id); err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		if next, ok := sync.Next[item.Id]; ok {
+			if err := createOccurrence(tx, item, next); err != nil {
+				tx.Rollback()
+				return err
+			}
+		}
+	}
+
+	created := activity
+	if activity != nil {
+		created = &domain.Activity{ActorId: activity.ActorId, Action: domain.ActionCreated}
+	}
+	for _, item := range sync.Create {
+		if err := importItem(tx, userId, listId, item, nil, tagIds, created); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit().Error
+}
+
+// importItem creates item at the end of the list, then its subtasks.
+func importItem(tx *gorm.DB, userId, listId int64, item *domain.TodoItem, parentId *int64, tagIds map[string]int64, activity *domain.Activity) error {
+	position, err := itemPositions(tx, listId).last()
+	if err != nil {
+		return err
+	}
+	item.ListId = listId
+	item.ParentId = parentId
+	item.Position = position
+
+	if err := tx.Create(item).Error; err != nil {
+		return ErrCreateTodoItem
+	}
+
+	if err := tx.Create(&domain.ListsItem{ListId: listId, ItemId: item.Id}).Error; err != nil {
+		return ErrCreateListItem
+	}
+
+	if err := attachTags(tx, userId, item, tagIds); err != nil {
+		return err
+	}
+
+	if err := indexForSearch(tx, item.Id); err != nil {
+		return err
+	}
+
+	if err := recordItem(tx, activity, listId, nil, item); err != nil {
+		return err
+	}
+
+	for _, child := range item.Children {
+		if err := importItem(tx, userId, listId, child, &item.Id, tagIds, activity); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// attachTags attaches the tags of item to it. Tags are matched to the tags of
+// the user by name and created when missing, tagIds remembers the ids of the
+// names matched so far.
+func attachTags(tx *gorm.DB, userId int64, item *domain.TodoItem, tagIds map[string]int64) error {
+	for i := range item.Tags {
+		tag := &item.Tags[i]
+		if id, ok := tagIds[tag.Name]; ok {
+			tag.Id, tag.UserId = id, userId
+		} else {
+			if err := tx.Where(domain.Tag{UserId: userId, Name: tag.Name}).FirstOrCreate(tag).Error; err != nil {
+				return err
+			}
+			tagIds[tag.Name] = tag.Id
+		}
+
+		if err := tx.Create(&domain.ItemsTag{ItemId: item.Id, TagId: tag.Id}).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
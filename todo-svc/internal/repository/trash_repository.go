@@ -0,0 +1,280 @@
+package repository
+
+import (
+	"errors"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/domain"
+	"gorm.io/gorm"
+	"time"
+)
+
+var (
+	ErrListTrashed = errors.New("list of the item is in the trash")
+)
+
+type TrashPostgres struct {
+	db *gorm.DB
+}
+
+func NewTrashPostgres(db *gorm.DB) *TrashPostgres {
+	return &TrashPostgres{
+		db: db,
+	}
+}
+
+// GetLists returns the trashed lists the user owns, most recently deleted
+// first.
+func (tp *TrashPostgres) GetLists(userId int64) ([]*domain.TodoList, error) {
+	var lists []*domain.TodoList
+	if err := tp.db.Unscoped().
+		Where("id IN (?)", trashedLists(tp.db, userId).Select("todo_lists.id")).
+		Order("deleted_at DESC, id").
+		Find(&lists).Error; err != nil {
+		return nil, err
+	}
+
+	return lists, nil
+}
+
+// GetItems returns the items the user can edit that were deleted on their
+// own, items of trashed lists come back with their list.
+func (tp *TrashPostgres) GetItems(userId int64) ([]*domain.TodoItem, error) {
+	var items []*domain.TodoItem
+	if err := tp.db.Unscoped().
+		Where("id IN (?)", trashedItems(tp.db, userId).Select("todo_items.id")).
+		Order("deleted_at DESC, id").
+		Find(&items).Error; err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (tp *TrashPostgres) GetItem(itemId int64) (*domain.TodoItem, error) {
+	var item domain.TodoItem
+	if err := tp.db.Unscoped().
+		Where("id = ? AND deleted_at IS NOT NULL", itemId).
+		First(&item).Error; err != nil {
+		return nil, ErrTodoItemNotFound
+	}
+
+	return &item, nil
+}
+
+// RestoreList takes the list out of the trash together with the items that
+// were trashed along with it. Only the owner of the list can restore it.
+func (tp *TrashPostgres) RestoreList(userId int64, listId int64, activity *domain.Activity) error {
+	tx := tp.db.Begin()
+
+	var list domain.TodoList
+	if err := trashedLists(tx, userId).
+		Select("todo_lists.*").
+		Where("todo_lists.id = ?", listId).
+		First(&list).Error; err != nil {
+		tx.Rollback()
+		return ErrTodoListNotFound
+	}
+
+	if err := tx.Unscoped().Model(&domain.TodoItem{}).
+		Where("list_id = ? AND deleted_at = ?", listId, list.DeletedAt).
+		Update("deleted_at", nil).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	before := list
+	if err := tx.Unscoped().Model(&list).Update("deleted_at", nil).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	list.DeletedAt = gorm.DeletedAt{}
+
+	if err := record(tx, activity, listId, domain.TargetList, listId, before, list); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// RestoreItem takes the item out of the trash together with the subtree
+// trashed along with it. Items whose parent is still in the trash become
+// top-level items.
+func (tp *TrashPostgres) RestoreItem(item *domain.TodoItem, activity *domain.Activity) error {
+	tx := tp.db.Begin()
+
+	var count int64
+	if err := tx.Model(&domain.TodoList{}).Where("id = ?", item.ListId).Count(&count).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	if count == 0 {
+		tx.Rollback()
+		return ErrListTrashed
+	}
+
+	if err := tx.Unscoped().Model(&domain.TodoItem{}).
+		Where("id IN (?) AND deleted_at = ?", tx.Raw(descendantIdsQuery, item.Id), item.DeletedAt).
+		Update("deleted_at", nil).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	updates := map[string]any{"deleted_at": nil}
+	if item.ParentId != nil {
+		if err := tx.Model(&domain.TodoItem{}).Where("id = ?", *item.ParentId).Count(&count).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+		if count == 0 {
+			updates["parent_id"] = nil
+		}
+	}
+
+	before := *item
+	if err := tx.Unscoped().Model(item).Updates(updates).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	item.DeletedAt = gorm.DeletedAt{}
+	if _, ok := updates["parent_id"]; ok {
+		item.ParentId = nil
+	}
+
+	if err := recordItem(tx, activity, item.ListId, &before, item); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// Empty permanently deletes everything GetLists and GetItems return. Purged
+// items are recorded in their lists, purged lists take their activity with
+// them.
+func (tp *TrashPostgres) Empty(userId int64, activity *domain.Activity) error {
+	tx := tp.db.Begin()
+
+	var listIds []int64
+	if err := trashedLists(tx, userId).Pluck("todo_lists.id", &listIds).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	var items []*domain.TodoItem
+	if err := trashedItems(tx, userId).Select("todo_items.*").Find(&items).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	itemIds := make([]int64, 0, len(items))
+	for _, item := range items {
+		itemIds = append(itemIds, item.Id)
+	}
+
+	if err := purge(tx, listIds, itemIds); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, item := range items {
+		if err := recordItem(tx, activity, item.ListId, item, nil); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit().Error
+}
+
+// Purge permanently deletes lists and items trashed before the given time.
+func (tp *TrashPostgres) Purge(before time.Time) error {
+	tx := tp.db.Begin()
+
+	var listIds, itemIds []int64
+	if err := tx.Unscoped().Model(&domain.TodoList{}).
+		Where("deleted_at < ?", before).
+		Pluck("id", &listIds).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Unscoped().Model(&domain.TodoItem{}).
+		Where("deleted_at < ?", before).
+		Pluck("id", &itemIds).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := purge(tx, listIds, itemIds); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+// trashedLists selects the trashed lists owned by the user.
+func trashedLists(db *gorm.DB, userId int64) *gorm.DB {
+	return db.Unscoped().Model(&domain.TodoList{}).
+		Joins("JOIN users_lists ON users_lists.list_id = todo_lists.id").
+		Where("users_lists.user_id = ? AND users_lists.role = ? AND todo_lists.deleted_at IS NOT NULL", userId, domain.RoleOwner)
+}
+
+// trashedItems selects the items trashed on their own from lists the user can
+// edit.
+func trashedItems(db *gorm.DB, userId int64) *gorm.DB {
+	return db.Unscoped().Model(&domain.TodoItem{}).
+		Joins("JOIN todo_lists ON todo_lists.id = todo_items.list_id AND todo_lists.deleted_at IS NULL").
+		Joins("JOIN users_lists ON users_lists.list_id = todo_items.list_id").
+		Where("users_lists.user_id = ? AND users_lists.role >= ? AND todo_items.deleted_at IS NOT NULL", userId, domain.RoleEditor)
+}
+
+// purge deletes the lists, all of their items and the items with itemIds for
+// good, along with the comments of the items and the activity of the lists.
+func purge(tx *gorm.DB, listIds []int64, itemIds []int64) error {
+	if len(listIds) > 0 {
+		var listItemIds []int64
+		if err := tx.Unscoped().Model(&domain.TodoItem{}).
+			Where("list_id IN ?", listIds).
+			Pluck("id", &listItemIds).Error; err != nil {
+			return err
+		}
+		itemIds = append(itemIds, listItemIds...)
+	}
+
+	if len(itemIds) > 0 {
+		if err := tx.Where("item_id IN ?", itemIds).Delete(&domain.ItemsTag{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("item_id IN ?", itemIds).Delete(&domain.ListsItem{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("item_id IN ?", itemIds).Delete(&domain.Comment{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Where("id IN ?", itemIds).Delete(&domain.TodoItem{}).Error; err != nil {
+			return err
+		}
+	}
+
+	if len(listIds) > 0 {
+		if err := tx.Where("list_id IN ?", listIds).Delete(&domain.UsersList{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("list_id IN ?", listIds).Delete(&domain.Invitation{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("list_id IN ?", listIds).Delete(&domain.Activity{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Where("id IN ?", listIds).Delete(&domain.TodoList{}).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
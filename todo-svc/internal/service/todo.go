@@ -1,32 +1,180 @@
 package service
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/broker"
 	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/domain"
 	pb "github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/pb"
 	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/repository"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/storage"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/transfer"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/pkg/pagination"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/pkg/recurrence"
 	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/pkg/utils"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"log"
+	"mime"
 	"net/http"
+	"net/mail"
+	"os"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
 )
 
 var (
-	errForbidden    = "User does not have access to this list"
-	errListNotFound = "List not found"
-	errItemNotFound = "Item not found"
+	errForbidden       = "User does not have access to this list"
+	errListNotFound    = "List not found"
+	errItemNotFound    = "Item not found"
+	errInvalidSchedule = "Start date must not be after due date"
+	errInvalidTimeZone = "Unknown time zone"
+	errInvalidRange    = "due_after must be before due_before"
+	errInvalidPriority = "Unknown priority"
+	errInvalidSort     = "Unknown sort order"
+	errTagNotFound     = "Tag not found"
+	errInvalidTagName  = "Tag name must be between 1 and 64 characters"
+	errInvalidTitle    = fmt.Sprintf("Title must be between 1 and %d characters", maxTitleLength)
+	errParentNotFound  = "Parent item not found"
+	errParentList      = "Parent item must be in the same list"
+	errTooDeep         = fmt.Sprintf("Subtasks can be nested at most %d levels deep", domain.MaxItemDepth)
+	errInvalidRule     = "Invalid recurrence rule"
+	errRuleFrequency   = "Items can repeat at most hourly"
+	errRuleEnd         = "Recurrence can end after a number of occurrences or at a date, not both"
+	errRecurrenceDue   = "Recurring items need a due date"
+	errNotRecurring    = "Item does not repeat"
+	errRole            = "User role does not allow this action"
+	errInvalidRole     = "Unknown role"
+	errMemberNotFound  = "Member not found"
+	errMemberExists    = "User is already a member of this list"
+	errLastOwner       = "A list must keep at least one owner"
+	errInvalidEmail    = "Invalid e-mail address"
+	errInvitationFound = "Invitation not found"
+	errInvitationGone  = "Invitation has expired"
+	errPageSize        = "Page size must not be negative"
+	errPageToken       = "Invalid page token"
+	errCompletion      = "Unknown completion filter"
+	errEmptyQuery      = "Search query must not be empty"
+	errListTrashed     = "The list of the item is in the trash, restore the list instead"
+	errListArchived    = "The list is archived, unarchive it to change its items"
+	errNeighbour       = "Neighbour not found"
+	errNeighbourOrder  = "after_id must come before before_id"
+	errMoveSelf        = "An item cannot be moved next to itself"
+	errNoItems         = "At least one item id is required"
+	errTooManyItems    = fmt.Sprintf("At most %d items can be moved or copied at once", maxTransferItems)
+	errTooManyBatch    = fmt.Sprintf("At most %d items can be changed at once", maxBatchItems)
+	errBatchOperation  = "Unknown batch operation"
+	errBatchFields     = "Fields are required to set fields"
+	errBatchFailed     = "Some items failed, no item was changed"
+	errBatchAborted    = "Not changed because other items failed"
+	errCommentNotFound = "Comment not found"
+	errCommentBody     = fmt.Sprintf("Comment must be between 1 and %d characters", maxCommentLength)
+	errCommentEdit     = "Only the author can edit a comment"
+	errCommentDelete   = "Only the author or an owner of the list can delete a comment"
+	errAttachmentFound = "Attachment not found"
+	errAttachmentInfo  = "An upload must start with the attachment info"
+	errAttachmentEmpty = "Attachment must not be empty"
+	errFileName        = fmt.Sprintf("File name must be between 1 and %d characters", maxFileNameLength)
+	errStaleVersion    = "Changed since the given version, reload and try again"
+	errVersionConflict = "Changed by someone else meanwhile, try again"
+	errWatchStopped    = "Stopped watching the list, reconnect with the last sequence to resume"
+	errUpdateMask      = "Update mask names a field that cannot be updated"
+	errIdempotencyKey  = "Idempotency key must be at most 255 characters"
+	errKeyReused       = "Idempotency key was used for a different request"
+	errKeyPending      = "A request with this idempotency key is still being handled"
+	errUnknownFormat   = "Unknown format, use json, csv, markdown, ics or todotxt"
+	errImportInfo      = "An import must start with the import info"
+	errImportEmpty     = "Nothing to import"
+	errImportSize      = fmt.Sprintf("Imports can be at most %d bytes", maxImportSize)
+	errImportItems     = fmt.Sprintf("Imports can hold at most %d items", maxImportItems)
+	errFeedNotFound    = "Calendar feed not found"
+)
+
+const (
+	maxTagNameLength   = 64
+	maxTitleLength     = 255
+	defaultOccurrences = 10
+	maxOccurrences     = 50
+	invitationTTL      = 7 * 24 * time.Hour
+	defaultPageSize    = 50
+	maxPageSize        = 200
+	defaultResults     = 20
+	maxResults         = 100
+	maxTransferItems   = 100
+	maxBatchItems      = 500
+	maxCommentLength   = 10000
+	maxFileNameLength  = 255
+	attachmentChunk    = 64 << 10
+	watchBatch         = 100
+	watchOverlap       = time.Minute
+	maxImportSize      = 10 << 20
+	maxImportItems     = 5000
+	calendarName       = "Todo"
+	// sniffLength is how much of a file http.DetectContentType looks at.
+	sniffLength = 512
 )
 
 type Server struct {
-	ListRepo repository.TodoList
-	ItemRepo repository.TodoItem
+	ListRepo       repository.TodoList
+	ItemRepo       repository.TodoItem
+	TagRepo        repository.Tag
+	InvitationRepo repository.Invitation
+	CommentRepo    repository.Comment
+	AttachmentRepo repository.Attachment
+	ActivityRepo   repository.Activity
+	TrashRepo      repository.Trash
+	TransferRepo   repository.Transfer
+	CalendarRepo   repository.Calendar
+	// Responses to requests with an idempotency key are replayed to retries
+	// for the TTL. Requests that have no response yet keep their key for
+	// PendingKeyTTL, in case they never finish.
+	IdempotencyRepo repository.Idempotency
+	IdempotencyTTL  time.Duration
+	PendingKeyTTL   time.Duration
+	Storage         storage.Storage
+	Events          *broker.Broker
+	Attachments     AttachmentPolicy
 	pb.UnimplementedTodoServiceServer
 	Mapper utils.Mapper
 }
 
+// AttachmentPolicy limits the files that can be attached to items.
+type AttachmentPolicy struct {
+	MaxSize int64
+	// Media types such as "image/png", compared to the type sniffed from
+	// the contents.
+	AllowedTypes []string
+}
+
 func (s *Server) CreateTodoList(ctx context.Context, in *pb.CreateTodoListRequest) (*pb.CreateTodoListResponse, error) {
+	return idempotent(s, ctx, in.UserId, in, s.createTodoList)
+}
+
+func (s *Server) createTodoList(ctx context.Context, in *pb.CreateTodoListRequest) (*pb.CreateTodoListResponse, error) {
+	if !validTitle(in.Title) {
+		return &pb.CreateTodoListResponse{
+			Status: http.StatusBadRequest,
+			Error:  errInvalidTitle,
+		}, nil
+	}
+
 	var list domain.TodoList
 	list.Title = in.Title
 
-	if err := s.ListRepo.Create(in.UserId, &list); err != nil {
+	if err := s.ListRepo.Create(in.UserId, &list, activity(in.UserId, domain.ActionCreated)); err != nil {
 		return &pb.CreateTodoListResponse{
 			Status: http.StatusInternalServerError,
 			Error:  err.Error(),
@@ -35,8 +183,9 @@ func (s *Server) CreateTodoList(ctx context.Context, in *pb.CreateTodoListReques
 
 	return &pb.CreateTodoListResponse{
 		List: &pb.TodoList{
-			Id:    list.Id,
-			Title: list.Title,
+			Id:      list.Id,
+			Title:   list.Title,
+			Version: list.Version,
 		},
 		Status: http.StatusCreated,
 	}, nil
@@ -51,24 +200,57 @@ func (s *Server) GetTodoListById(ctx context.Context, in *pb.GetTodoListRequest)
 		}, nil
 	}
 
-	if s.ListRepo.CheckUserAccessToList(in.UserId, list.Id) != nil {
+	if err := s.ListRepo.CheckUserAccessToList(in.UserId, list.Id, domain.RoleViewer); err != nil {
 		return &pb.GetTodoListResponse{
 			Status: http.StatusForbidden,
-			Error:  errForbidden,
+			Error:  accessError(err),
 		}, nil
 	}
 
 	return &pb.GetTodoListResponse{
 		List: &pb.TodoList{
-			Id:    list.Id,
-			Title: list.Title,
+			Id:         list.Id,
+			Title:      list.Title,
+			ArchivedAt: utils.ToTimestamp(list.ArchivedAt),
+			Version:    list.Version,
 		},
 		Status: http.StatusOK,
 	}, nil
 }
 
 func (s *Server) GetTodoLists(ctx context.Context, in *pb.GetTodoListsRequest) (*pb.GetTodoListsResponse, error) {
-	todoLists, err := s.ListRepo.GetAll(in.UserId)
+	size, ok := pageSize(in.PageSize)
+	if !ok {
+		return &pb.GetTodoListsResponse{
+			Status: http.StatusBadRequest,
+			Error:  errPageSize,
+		}, nil
+	}
+
+	if _, ok := pb.ListSort_name[int32(in.Sort)]; !ok {
+		return &pb.GetTodoListsResponse{
+			Status: http.StatusBadRequest,
+			Error:  errInvalidSort,
+		}, nil
+	}
+	sort := domain.ListSort(in.Sort)
+
+	var after *domain.ListCursor
+	if in.PageToken != "" {
+		after = &domain.ListCursor{}
+		if err := pagination.Decode(in.PageToken, after); err != nil || after.Sort != sort {
+			return &pb.GetTodoListsResponse{
+				Status: http.StatusBadRequest,
+				Error:  errPageToken,
+			}, nil
+		}
+	}
+
+	todoLists, total, err := s.ListRepo.GetAll(in.UserId, domain.ListFilter{
+		TagIds:          in.TagIds,
+		Title:           strings.TrimSpace(in.Title),
+		IncludeArchived: in.IncludeArchived,
+	}, sort, size+1, after)
 	if err != nil {
 		return &pb.GetTodoListsResponse{
 			Status: http.StatusNotFound,
@@ -76,44 +258,89 @@ func (s *Server) GetTodoLists(ctx context.Context, in *pb.GetTodoListsRequest) (
 		}, nil
 	}
 
+	var nextPageToken string
+	if len(todoLists) > size {
+		todoLists = todoLists[:size]
+		nextPageToken, err = pagination.Encode(listCursor(todoLists[size-1], sort))
+		if err != nil {
+			return &pb.GetTodoListsResponse{
+				Status: http.StatusInternalServerError,
+				Error:  err.Error(),
+			}, nil
+		}
+	}
+
 	lists := s.Mapper.FromDomainLists(todoLists)
 
 	return &pb.GetTodoListsResponse{
-		Lists:  lists,
-		Status: http.StatusOK,
+		Lists:         lists,
+		Status:        http.StatusOK,
+		NextPageToken: nextPageToken,
+		TotalCount:    total,
 	}, nil
 }
 
 func (s *Server) UpdateTodoList(ctx context.Context, in *pb.UpdateTodoListRequest) (*pb.UpdateTodoListResponse, error) {
-	list, err := s.ListRepo.Update(in.Id, &domain.TodoList{
-		Title: in.Title,
-	})
-	if err != nil {
+	if _, ok := maskedColumns(in.UpdateMask, listColumns); !ok {
 		return &pb.UpdateTodoListResponse{
-			Status: http.StatusInternalServerError,
-			Error:  err.Error(),
+			Status: http.StatusBadRequest,
+			Error:  errUpdateMask,
 		}, nil
 	}
 
-	err = s.ListRepo.CheckUserAccessToList(in.UserId, in.Id)
-	if err != nil {
+	if err := s.ListRepo.CheckUserAccessToList(in.UserId, in.Id, domain.RoleOwner); err != nil {
 		return &pb.UpdateTodoListResponse{
 			Status: http.StatusForbidden,
-			Error:  errForbidden,
+			Error:  accessError(err),
+		}, nil
+	}
+
+	list, err := s.ListRepo.Update(in.Id, &domain.TodoList{
+		Title:   in.Title,
+		Version: in.Version,
+	}, activity(in.UserId, domain.ActionUpdated))
+	if errors.Is(err, repository.ErrVersionConflict) {
+		status, msg := versionError(in.Version)
+		return &pb.UpdateTodoListResponse{
+			Status: status,
+			Error:  msg,
+		}, nil
+	}
+	if err != nil {
+		return &pb.UpdateTodoListResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
 		}, nil
 	}
 
 	return &pb.UpdateTodoListResponse{
 		List: &pb.TodoList{
-			Id:    list.Id,
-			Title: list.Title,
+			Id:         list.Id,
+			Title:      list.Title,
+			ArchivedAt: utils.ToTimestamp(list.ArchivedAt),
+			Version:    list.Version,
 		},
 		Status: http.StatusOK,
 	}, nil
 }
 
 func (s *Server) DeleteTodoList(ctx context.Context, in *pb.DeleteTodoListRequest) (*pb.DeleteTodoListResponse, error) {
-	if err := s.ListRepo.Delete(in.Id); err != nil {
+	if err := s.ListRepo.CheckUserAccessToList(in.UserId, in.Id, domain.RoleOwner); err != nil {
+		return &pb.DeleteTodoListResponse{
+			Status: http.StatusForbidden,
+			Error:  accessError(err),
+		}, nil
+	}
+
+	err := s.ListRepo.Delete(in.Id, in.Version, activity(in.UserId, domain.ActionDeleted))
+	if errors.Is(err, repository.ErrVersionConflict) {
+		status, msg := versionError(in.Version)
+		return &pb.DeleteTodoListResponse{
+			Status: status,
+			Error:  msg,
+		}, nil
+	}
+	if err != nil {
 		return &pb.DeleteTodoListResponse{
 			Status: http.StatusInternalServerError,
 			Error:  err.Error(),
@@ -126,158 +353,3159 @@ func (s *Server) DeleteTodoList(ctx context.Context, in *pb.DeleteTodoListReques
 	}, nil
 }
 
-func (s *Server) CreateTodoItem(ctx context.Context, in *pb.CreateTodoItemRequest) (*pb.CreateTodoItemResponse, error) {
-	if err := s.ListRepo.CheckUserAccessToList(in.UserId, in.ListId); err != nil {
-		return &pb.CreateTodoItemResponse{
-			Item:   nil,
-			Status: http.StatusForbidden,
-			Error:  err.Error(),
+func (s *Server) ArchiveTodoList(ctx context.Context, in *pb.ArchiveTodoListRequest) (*pb.ArchiveTodoListResponse, error) {
+	if err := s.ListRepo.CheckUserAccessToList(in.UserId, in.Id, domain.RoleOwner); err != nil {
+		return &pb.ArchiveTodoListResponse{
+			Success: false,
+			Status:  http.StatusForbidden,
+			Error:   accessError(err),
 		}, nil
 	}
 
-	item := &domain.TodoItem{
-		Title:       in.Title,
-		Description: in.Description,
-		Done:        false,
-		ListId:      in.ListId,
-	}
-
-	if err := s.ItemRepo.Create(item); err != nil {
-		return &pb.CreateTodoItemResponse{
-			Item:   nil,
-			Status: http.StatusInternalServerError,
-			Error:  err.Error(),
+	if err := s.ListRepo.Archive(in.Id, activity(in.UserId, domain.ActionArchived)); err != nil {
+		return &pb.ArchiveTodoListResponse{
+			Success: false,
+			Status:  http.StatusInternalServerError,
+			Error:   err.Error(),
 		}, nil
 	}
 
-	return &pb.CreateTodoItemResponse{
-		Item: &pb.TodoItem{
-			Id:          item.Id,
-			Title:       item.Title,
-			Description: item.Description,
-		},
-		Status: http.StatusOK,
+	return &pb.ArchiveTodoListResponse{
+		Success: true,
+		Status:  http.StatusOK,
 	}, nil
 }
 
-func (s *Server) GetTodoItemById(ctx context.Context, in *pb.GetTodoItemRequest) (*pb.GetTodoItemResponse, error) {
-	item, _, err := s.ItemRepo.GetById(in.Id)
-	if err != nil {
-		return &pb.GetTodoItemResponse{
-			Item:   nil,
-			Status: http.StatusNotFound,
-			Error:  errItemNotFound,
+func (s *Server) UnarchiveTodoList(ctx context.Context, in *pb.UnarchiveTodoListRequest) (*pb.UnarchiveTodoListResponse, error) {
+	if err := s.ListRepo.CheckUserAccessToList(in.UserId, in.Id, domain.RoleOwner); err != nil {
+		return &pb.UnarchiveTodoListResponse{
+			Success: false,
+			Status:  http.StatusForbidden,
+			Error:   accessError(err),
 		}, nil
 	}
 
-	if err := s.ListRepo.CheckUserAccessToList(in.UserId, item.ListId); err != nil {
-		return &pb.GetTodoItemResponse{
-			Item:   nil,
-			Status: http.StatusForbidden,
-			Error:  errForbidden,
+	if err := s.ListRepo.Unarchive(in.Id, activity(in.UserId, domain.ActionUnarchived)); err != nil {
+		return &pb.UnarchiveTodoListResponse{
+			Success: false,
+			Status:  http.StatusInternalServerError,
+			Error:   err.Error(),
 		}, nil
 	}
 
-	return &pb.GetTodoItemResponse{
-		Item: &pb.TodoItem{
-			Id:          item.Id,
-			Title:       item.Title,
-			Description: item.Description,
-		},
-		Status: http.StatusOK,
+	return &pb.UnarchiveTodoListResponse{
+		Success: true,
+		Status:  http.StatusOK,
 	}, nil
 }
 
-func (s *Server) GetTodoItems(ctx context.Context, in *pb.GetTodoItemsRequest) (*pb.GetTodoItemsResponse, error) {
-	if err := s.ListRepo.CheckUserAccessToList(in.UserId, in.ListId); err != nil {
-		return &pb.GetTodoItemsResponse{
-			Status: http.StatusForbidden,
-			Error:  errForbidden,
+func (s *Server) MoveTodoList(ctx context.Context, in *pb.MoveTodoListRequest) (*pb.MoveTodoListResponse, error) {
+	if in.AfterId == in.Id || in.BeforeId == in.Id {
+		return &pb.MoveTodoListResponse{
+			Success: false,
+			Status:  http.StatusBadRequest,
+			Error:   errMoveSelf,
 		}, nil
 	}
 
-	listItems, err := s.ItemRepo.GetAll(in.ListId)
-	if err != nil {
-		return &pb.GetTodoItemsResponse{
-			Status: http.StatusInternalServerError,
-			Error:  errItemNotFound,
+	if err := s.ListRepo.CheckUserAccessToList(in.UserId, in.Id, domain.RoleViewer); err != nil {
+		return &pb.MoveTodoListResponse{
+			Success: false,
+			Status:  http.StatusForbidden,
+			Error:   accessError(err),
 		}, nil
 	}
 
-	items := s.Mapper.FromDomainItems(listItems)
+	if err := s.ListRepo.Move(in.UserId, in.Id, in.AfterId, in.BeforeId); err != nil {
+		status, msg := moveErrorStatus(err)
+		return &pb.MoveTodoListResponse{
+			Success: false,
+			Status:  status,
+			Error:   msg,
+		}, nil
+	}
 
-	return &pb.GetTodoItemsResponse{
-		Items:  items,
-		Status: http.StatusOK,
+	return &pb.MoveTodoListResponse{
+		Success: true,
+		Status:  http.StatusOK,
 	}, nil
 }
 
-func (s *Server) UpdateTodoItem(ctx context.Context, in *pb.UpdateTodoItemRequest) (*pb.UpdateTodoItemResponse, error) {
-	item, listId, err := s.ItemRepo.GetById(in.Id)
+func (s *Server) ListTrash(ctx context.Context, in *pb.ListTrashRequest) (*pb.ListTrashResponse, error) {
+	lists, err := s.TrashRepo.GetLists(in.UserId)
 	if err != nil {
-		return &pb.UpdateTodoItemResponse{
-			Status: http.StatusNotFound,
-			Error:  errItemNotFound,
+		return &pb.ListTrashResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
 		}, nil
 	}
 
-	if err := s.ListRepo.CheckUserAccessToList(in.UserId, listId); err != nil {
-		return &pb.UpdateTodoItemResponse{
-			Status: http.StatusForbidden,
-			Error:  errForbidden,
+	items, err := s.TrashRepo.GetItems(in.UserId)
+	if err != nil {
+		return &pb.ListTrashResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
 		}, nil
 	}
 
-	item.Id = in.Id
-	item.Title = in.Title
-	item.Description = in.Description
-	item.Done = in.Completed
+	return &pb.ListTrashResponse{
+		Lists:  s.Mapper.FromDomainLists(lists),
+		Items:  s.Mapper.FromDomainItems(items),
+		Status: http.StatusOK,
+	}, nil
+}
 
-	if err := s.ItemRepo.Update(item); err != nil {
-		return &pb.UpdateTodoItemResponse{
-			Status: http.StatusInternalServerError,
-			Error:  err.Error(),
+func (s *Server) RestoreTodoList(ctx context.Context, in *pb.RestoreTodoListRequest) (*pb.RestoreTodoListResponse, error) {
+	if err := s.TrashRepo.RestoreList(in.UserId, in.Id, activity(in.UserId, domain.ActionRestored)); err != nil {
+		if errors.Is(err, repository.ErrTodoListNotFound) {
+			return &pb.RestoreTodoListResponse{
+				Success: false,
+				Status:  http.StatusNotFound,
+				Error:   errListNotFound,
+			}, nil
+		}
+		return &pb.RestoreTodoListResponse{
+			Success: false,
+			Status:  http.StatusInternalServerError,
+			Error:   err.Error(),
 		}, nil
 	}
 
-	return &pb.UpdateTodoItemResponse{
-		Item: &pb.TodoItem{
-			Id:          item.Id,
-			Title:       item.Title,
-			Description: item.Description,
-		},
-		Status: http.StatusOK,
+	return &pb.RestoreTodoListResponse{
+		Success: true,
+		Status:  http.StatusOK,
 	}, nil
 }
 
-func (s *Server) DeleteTodoItem(ctx context.Context, in *pb.DeleteTodoItemRequest) (*pb.DeleteTodoItemResponse, error) {
-	item, listId, err := s.ItemRepo.GetById(in.Id)
+func (s *Server) RestoreTodoItem(ctx context.Context, in *pb.RestoreTodoItemRequest) (*pb.RestoreTodoItemResponse, error) {
+	item, err := s.TrashRepo.GetItem(in.Id)
 	if err != nil {
-		return &pb.DeleteTodoItemResponse{
+		return &pb.RestoreTodoItemResponse{
 			Success: false,
 			Status:  http.StatusNotFound,
 			Error:   errItemNotFound,
 		}, nil
 	}
 
-	if err := s.ListRepo.CheckUserAccessToList(in.UserId, listId); err != nil {
-		return &pb.DeleteTodoItemResponse{
+	if err := s.ListRepo.CheckUserAccessToList(in.UserId, item.ListId, domain.RoleEditor); err != nil {
+		if errors.Is(err, repository.ErrTodoListNotFound) {
+			return &pb.RestoreTodoItemResponse{
+				Success: false,
+				Status:  http.StatusConflict,
+				Error:   errListTrashed,
+			}, nil
+		}
+		return &pb.RestoreTodoItemResponse{
 			Success: false,
 			Status:  http.StatusForbidden,
-			Error:   errForbidden,
+			Error:   accessError(err),
 		}, nil
 	}
 
-	if err := s.ItemRepo.Delete(item.Id); err != nil {
-		return &pb.DeleteTodoItemResponse{
+	if err := s.ListRepo.CheckNotArchived(item.ListId); err != nil {
+		return &pb.RestoreTodoItemResponse{
+			Success: false,
+			Status:  http.StatusConflict,
+			Error:   errListArchived,
+		}, nil
+	}
+
+	if err := s.TrashRepo.RestoreItem(item, activity(in.UserId, domain.ActionRestored)); err != nil {
+		if errors.Is(err, repository.ErrListTrashed) {
+			return &pb.RestoreTodoItemResponse{
+				Success: false,
+				Status:  http.StatusConflict,
+				Error:   errListTrashed,
+			}, nil
+		}
+		return &pb.RestoreTodoItemResponse{
 			Success: false,
 			Status:  http.StatusInternalServerError,
 			Error:   err.Error(),
 		}, nil
 	}
 
-	return &pb.DeleteTodoItemResponse{
+	return &pb.RestoreTodoItemResponse{
+		Success: true,
+		Status:  http.StatusOK,
+	}, nil
+}
+
+func (s *Server) EmptyTrash(ctx context.Context, in *pb.EmptyTrashRequest) (*pb.EmptyTrashResponse, error) {
+	if err := s.TrashRepo.Empty(in.UserId, activity(in.UserId, domain.ActionPurged)); err != nil {
+		return &pb.EmptyTrashResponse{
+			Success: false,
+			Status:  http.StatusInternalServerError,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &pb.EmptyTrashResponse{
+		Success: true,
+		Status:  http.StatusOK,
+	}, nil
+}
+
+func (s *Server) ShareList(ctx context.Context, in *pb.ShareListRequest) (*pb.ShareListResponse, error) {
+	if !domain.Role(in.Role).Valid() {
+		return &pb.ShareListResponse{
+			Status: http.StatusBadRequest,
+			Error:  errInvalidRole,
+		}, nil
+	}
+
+	if err := s.ListRepo.CheckUserAccessToList(in.UserId, in.ListId, domain.RoleOwner); err != nil {
+		return &pb.ShareListResponse{
+			Status: http.StatusForbidden,
+			Error:  accessError(err),
+		}, nil
+	}
+
+	member := &domain.UsersList{
+		UserId: in.MemberId,
+		ListId: in.ListId,
+		Role:   domain.Role(in.Role),
+	}
+	if err := s.ListRepo.AddMember(member, activity(in.UserId, domain.ActionAdded)); err != nil {
+		return &pb.ShareListResponse{
+			Status: memberErrorStatus(err),
+			Error:  memberError(err),
+		}, nil
+	}
+
+	return &pb.ShareListResponse{
+		Member: s.Mapper.FromDomainMembers([]*domain.UsersList{member})[0],
+		Status: http.StatusCreated,
+	}, nil
+}
+
+func (s *Server) UpdateMemberRole(ctx context.Context, in *pb.UpdateMemberRoleRequest) (*pb.UpdateMemberRoleResponse, error) {
+	if !domain.Role(in.Role).Valid() {
+		return &pb.UpdateMemberRoleResponse{
+			Status: http.StatusBadRequest,
+			Error:  errInvalidRole,
+		}, nil
+	}
+
+	if err := s.ListRepo.CheckUserAccessToList(in.UserId, in.ListId, domain.RoleOwner); err != nil {
+		return &pb.UpdateMemberRoleResponse{
+			Status: http.StatusForbidden,
+			Error:  accessError(err),
+		}, nil
+	}
+
+	if err := s.ListRepo.UpdateMemberRole(in.ListId, in.MemberId, domain.Role(in.Role), activity(in.UserId, domain.ActionUpdated)); err != nil {
+		return &pb.UpdateMemberRoleResponse{
+			Status: memberErrorStatus(err),
+			Error:  memberError(err),
+		}, nil
+	}
+
+	return &pb.UpdateMemberRoleResponse{
+		Member: &pb.ListMember{
+			UserId: in.MemberId,
+			Role:   in.Role,
+		},
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (s *Server) RemoveMember(ctx context.Context, in *pb.RemoveMemberRequest) (*pb.RemoveMemberResponse, error) {
+	role := domain.RoleOwner
+	if in.MemberId == in.UserId {
+		role = domain.RoleViewer
+	}
+
+	if err := s.ListRepo.CheckUserAccessToList(in.UserId, in.ListId, role); err != nil {
+		return &pb.RemoveMemberResponse{
+			Success: false,
+			Status:  http.StatusForbidden,
+			Error:   accessError(err),
+		}, nil
+	}
+
+	if err := s.ListRepo.RemoveMember(in.ListId, in.MemberId, activity(in.UserId, domain.ActionRemoved)); err != nil {
+		return &pb.RemoveMemberResponse{
+			Success: false,
+			Status:  memberErrorStatus(err),
+			Error:   memberError(err),
+		}, nil
+	}
+
+	return &pb.RemoveMemberResponse{
 		Success: true,
 		Status:  http.StatusOK,
 	}, nil
 }
+
+func (s *Server) ListMembers(ctx context.Context, in *pb.ListMembersRequest) (*pb.ListMembersResponse, error) {
+	if err := s.ListRepo.CheckUserAccessToList(in.UserId, in.ListId, domain.RoleViewer); err != nil {
+		return &pb.ListMembersResponse{
+			Status: http.StatusForbidden,
+			Error:  accessError(err),
+		}, nil
+	}
+
+	members, err := s.ListRepo.GetMembers(in.ListId)
+	if err != nil {
+		return &pb.ListMembersResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.ListMembersResponse{
+		Members: s.Mapper.FromDomainMembers(members),
+		Status:  http.StatusOK,
+	}, nil
+}
+
+func (s *Server) GetListActivity(ctx context.Context, in *pb.GetListActivityRequest) (*pb.GetListActivityResponse, error) {
+	size, ok := pageSize(in.PageSize)
+	if !ok {
+		return &pb.GetListActivityResponse{
+			Status: http.StatusBadRequest,
+			Error:  errPageSize,
+		}, nil
+	}
+
+	var after *domain.ActivityCursor
+	if in.PageToken != "" {
+		after = &domain.ActivityCursor{}
+		if err := pagination.Decode(in.PageToken, after); err != nil {
+			return &pb.GetListActivityResponse{
+				Status: http.StatusBadRequest,
+				Error:  errPageToken,
+			}, nil
+		}
+	}
+
+	if err := s.ListRepo.CheckUserAccessToList(in.UserId, in.ListId, domain.RoleViewer); err != nil {
+		return &pb.GetListActivityResponse{
+			Status: http.StatusForbidden,
+			Error:  accessError(err),
+		}, nil
+	}
+
+	activities, total, err := s.ActivityRepo.GetAll(in.ListId, size+1, after)
+	if err != nil {
+		return &pb.GetListActivityResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	var nextPageToken string
+	if len(activities) > size {
+		activities = activities[:size]
+		nextPageToken, err = pagination.Encode(domain.ActivityCursor{Id: activities[size-1].Id})
+		if err != nil {
+			return &pb.GetListActivityResponse{
+				Status: http.StatusInternalServerError,
+				Error:  err.Error(),
+			}, nil
+		}
+	}
+
+	return &pb.GetListActivityResponse{
+		Activities:    s.Mapper.FromDomainActivities(activities),
+		Status:        http.StatusOK,
+		NextPageToken: nextPageToken,
+		TotalCount:    total,
+	}, nil
+}
+
+func (s *Server) WatchList(in *pb.WatchListRequest, stream pb.TodoService_WatchListServer) error {
+	if err := s.ListRepo.CheckUserAccessToList(in.UserId, in.ListId, domain.RoleViewer); err != nil {
+		return stream.Send(&pb.WatchListResponse{
+			Status: http.StatusForbidden,
+			Error:  accessError(err),
+		})
+	}
+
+	// Subscribing before catching up makes sure nothing recorded meanwhile
+	// is missed, entries that were caught up with are skipped when they are
+	// announced.
+	sub := s.Events.Subscribe(in.ListId)
+	defer sub.Close()
+
+	if err := stream.Send(&pb.WatchListResponse{Status: http.StatusOK}); err != nil {
+		return err
+	}
+
+	sent := make(map[int64]bool)
+	after := in.AfterSequence
+	if after > 0 {
+		// Resuming a little earlier picks up entries that were committed
+		// late, the watcher skips the ones it has already.
+		var err error
+		if after, err = s.ActivityRepo.ResumeAfter(in.ListId, after, watchOverlap); err != nil {
+			return stream.Send(&pb.WatchListResponse{
+				Status: http.StatusInternalServerError,
+				Error:  err.Error(),
+			})
+		}
+	}
+	for caughtUp := in.AfterSequence == 0; !caughtUp; {
+		activities, err := s.ActivityRepo.GetAfter(in.ListId, after, watchBatch)
+		if err != nil {
+			return stream.Send(&pb.WatchListResponse{
+				Status: http.StatusInternalServerError,
+				Error:  err.Error(),
+			})
+		}
+
+		for _, activity := range activities {
+			if done, err := s.sendListEvent(stream, in.UserId, activity); done || err != nil {
+				return err
+			}
+			sent[activity.Id] = true
+		}
+
+		caughtUp = len(activities) < watchBatch
+		if !caughtUp {
+			after = activities[len(activities)-1].Id
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-sub.C:
+			if !ok {
+				log.Println("stopped watching list : ", sub.Err())
+				return stream.Send(&pb.WatchListResponse{
+					Status: http.StatusServiceUnavailable,
+					Error:  errWatchStopped,
+				})
+			}
+			if sent[event.Sequence] {
+				continue
+			}
+
+			activity, err := s.ActivityRepo.GetById(event.Sequence)
+			if err != nil {
+				return stream.Send(&pb.WatchListResponse{
+					Status: http.StatusInternalServerError,
+					Error:  err.Error(),
+				})
+			}
+			if done, err := s.sendListEvent(stream, in.UserId, activity); done || err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// sendListEvent sends what the activity entry means to a watcher of the
+// list. It reports whether watching is over because the list is gone or the
+// user is no longer a member of it.
+func (s *Server) sendListEvent(stream pb.TodoService_WatchListServer, userId int64, activity *domain.Activity) (bool, error) {
+	switch {
+	case activity.TargetType == domain.TargetList && activity.Action == domain.ActionDeleted:
+		return true, stream.Send(&pb.WatchListResponse{
+			Status: http.StatusNotFound,
+			Error:  errListNotFound,
+		})
+	case activity.TargetType == domain.TargetMember && activity.Action == domain.ActionRemoved && activity.TargetId == userId:
+		return true, stream.Send(&pb.WatchListResponse{
+			Status: http.StatusForbidden,
+			Error:  errForbidden,
+		})
+	}
+
+	event, err := s.listEvent(activity)
+	if err != nil {
+		return true, stream.Send(&pb.WatchListResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		})
+	}
+	if event == nil {
+		return false, nil
+	}
+
+	return false, stream.Send(&pb.WatchListResponse{
+		Event:  event,
+		Status: http.StatusOK,
+	})
+}
+
+// listEvent tells from the snapshots of the entry how the list changed, nil
+// when it is not a change watchers are told about.
+func (s *Server) listEvent(activity *domain.Activity) (*pb.ListEvent, error) {
+	event := &pb.ListEvent{
+		Sequence:  activity.Id,
+		ListId:    activity.ListId,
+		ActorId:   activity.ActorId,
+		CreatedAt: timestamppb.New(activity.CreatedAt),
+	}
+
+	switch activity.TargetType {
+	case domain.TargetItem:
+		// Items are purged from the trash, watchers saw them deleted before.
+		if activity.Action == domain.ActionPurged {
+			return nil, nil
+		}
+
+		before, err := snapshot[domain.TodoItem](activity.Before)
+		if err != nil {
+			return nil, err
+		}
+		after, err := snapshot[domain.TodoItem](activity.After)
+		if err != nil {
+			return nil, err
+		}
+
+		// Items moved between lists are recorded in both of them, restored
+		// items were in the trash before.
+		wasIn := before != nil && before.ListId == activity.ListId && !before.DeletedAt.Valid
+		isIn := after != nil && after.ListId == activity.ListId && !after.DeletedAt.Valid
+		switch {
+		case !wasIn && isIn:
+			event.Type, event.Item = pb.ListEvent_ITEM_CREATED, s.Mapper.FromDomainItem(after)
+		case wasIn && isIn:
+			event.Type, event.Item = pb.ListEvent_ITEM_UPDATED, s.Mapper.FromDomainItem(after)
+		case wasIn && !isIn:
+			event.Type, event.Item = pb.ListEvent_ITEM_DELETED, s.Mapper.FromDomainItem(before)
+		default:
+			return nil, nil
+		}
+	case domain.TargetList:
+		if activity.Action != domain.ActionUpdated {
+			return nil, nil
+		}
+
+		before, err := snapshot[domain.TodoList](activity.Before)
+		if err != nil {
+			return nil, err
+		}
+		after, err := snapshot[domain.TodoList](activity.After)
+		if err != nil {
+			return nil, err
+		}
+		if before == nil || after == nil || before.Title == after.Title {
+			return nil, nil
+		}
+
+		event.Type, event.List = pb.ListEvent_LIST_RENAMED, s.Mapper.FromDomainLists([]*domain.TodoList{after})[0]
+	default:
+		return nil, nil
+	}
+
+	return event, nil
+}
+
+func snapshot[T any](raw json.RawMessage) (*T, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var target T
+	if err := json.Unmarshal(raw, &target); err != nil {
+		return nil, err
+	}
+
+	return &target, nil
+}
+
+func (s *Server) CreateInvitation(ctx context.Context, in *pb.CreateInvitationRequest) (*pb.CreateInvitationResponse, error) {
+	email, ok := normalizeEmail(in.Email)
+	if !ok {
+		return &pb.CreateInvitationResponse{
+			Status: http.StatusBadRequest,
+			Error:  errInvalidEmail,
+		}, nil
+	}
+
+	if !domain.Role(in.Role).Valid() {
+		return &pb.CreateInvitationResponse{
+			Status: http.StatusBadRequest,
+			Error:  errInvalidRole,
+		}, nil
+	}
+
+	if err := s.ListRepo.CheckUserAccessToList(in.UserId, in.ListId, domain.RoleOwner); err != nil {
+		return &pb.CreateInvitationResponse{
+			Status: http.StatusForbidden,
+			Error:  accessError(err),
+		}, nil
+	}
+
+	invitation := &domain.Invitation{
+		ListId:    in.ListId,
+		Email:     email,
+		InviterId: in.UserId,
+		Role:      domain.Role(in.Role),
+		ExpiresAt: time.Now().Add(invitationTTL),
+	}
+
+	if in.InviteeId != 0 {
+		if s.ListRepo.CheckUserAccessToList(in.InviteeId, in.ListId, domain.RoleViewer) == nil {
+			return &pb.CreateInvitationResponse{
+				Status: http.StatusConflict,
+				Error:  errMemberExists,
+			}, nil
+		}
+		invitation.InviteeId = &in.InviteeId
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return &pb.CreateInvitationResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+	invitation.Token = token
+
+	if err := s.InvitationRepo.Create(invitation, activity(in.UserId, domain.ActionCreated)); err != nil {
+		return &pb.CreateInvitationResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.CreateInvitationResponse{
+		Invitation: s.Mapper.FromDomainInvitation(invitation),
+		Status:     http.StatusCreated,
+	}, nil
+}
+
+func (s *Server) GetInvitations(ctx context.Context, in *pb.GetInvitationsRequest) (*pb.GetInvitationsResponse, error) {
+	invitations, err := s.InvitationRepo.GetByInvitee(in.UserId)
+	if err != nil {
+		return &pb.GetInvitationsResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.GetInvitationsResponse{
+		Invitations: s.Mapper.FromDomainInvitations(invitations),
+		Status:      http.StatusOK,
+	}, nil
+}
+
+func (s *Server) AcceptInvitation(ctx context.Context, in *pb.AcceptInvitationRequest) (*pb.AcceptInvitationResponse, error) {
+	invitation, status, msg := s.findInvitation(in.UserId, in.Token)
+	if status != http.StatusOK {
+		return &pb.AcceptInvitationResponse{
+			Success: false,
+			Status:  status,
+			Error:   msg,
+		}, nil
+	}
+
+	if err := s.InvitationRepo.Accept(invitation, activity(in.UserId, domain.ActionAccepted)); err != nil {
+		return &pb.AcceptInvitationResponse{
+			Success: false,
+			Status:  http.StatusInternalServerError,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &pb.AcceptInvitationResponse{
+		Success: true,
+		Status:  http.StatusOK,
+	}, nil
+}
+
+func (s *Server) DeclineInvitation(ctx context.Context, in *pb.DeclineInvitationRequest) (*pb.DeclineInvitationResponse, error) {
+	invitation, status, msg := s.findInvitation(in.UserId, in.Token)
+	if status != http.StatusOK && status != http.StatusGone {
+		return &pb.DeclineInvitationResponse{
+			Success: false,
+			Status:  status,
+			Error:   msg,
+		}, nil
+	}
+
+	if err := s.InvitationRepo.Delete(invitation.Id, activity(in.UserId, domain.ActionDeclined)); err != nil {
+		return &pb.DeclineInvitationResponse{
+			Success: false,
+			Status:  http.StatusInternalServerError,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &pb.DeclineInvitationResponse{
+		Success: true,
+		Status:  http.StatusOK,
+	}, nil
+}
+
+func (s *Server) ActivateInvitations(ctx context.Context, in *pb.ActivateInvitationsRequest) (*pb.ActivateInvitationsResponse, error) {
+	email, ok := normalizeEmail(in.Email)
+	if !ok {
+		return &pb.ActivateInvitationsResponse{
+			Success: false,
+			Status:  http.StatusBadRequest,
+			Error:   errInvalidEmail,
+		}, nil
+	}
+
+	if err := s.InvitationRepo.Activate(email, in.UserId); err != nil {
+		return &pb.ActivateInvitationsResponse{
+			Success: false,
+			Status:  http.StatusInternalServerError,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &pb.ActivateInvitationsResponse{
+		Success: true,
+		Status:  http.StatusOK,
+	}, nil
+}
+
+// findInvitation looks up the invitation with token sent to userId. Expired
+// invitations are returned along with http.StatusGone.
+func (s *Server) findInvitation(userId int64, token string) (*domain.Invitation, int64, string) {
+	invitation, err := s.InvitationRepo.GetByToken(token)
+	if err != nil || invitation.InviteeId == nil || *invitation.InviteeId != userId {
+		return nil, http.StatusNotFound, errInvitationFound
+	}
+
+	if !invitation.ExpiresAt.After(time.Now()) {
+		return invitation, http.StatusGone, errInvitationGone
+	}
+
+	return invitation, http.StatusOK, ""
+}
+
+func normalizeEmail(email string) (string, bool) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return "", false
+	}
+	return email, true
+}
+
+// newToken returns a random secret for links that work without logging in,
+// such as invitations and calendar feeds.
+func newToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// activity starts an entry of the audit trail for a change the user makes,
+// the repository fills in the target along with the change.
+func activity(userId int64, action domain.Action) *domain.Activity {
+	return &domain.Activity{ActorId: userId, Action: action}
+}
+
+// idempotencyKey is the metadata key clients send idempotency keys in.
+const idempotencyKey = "idempotency-key"
+
+// idempotent handles in unless the user sent an idempotency key with it that
+// they already used, in which case the response to the first request with
+// the key is replayed. Keys used for other requests are turned down, as are
+// retries while the first request is being handled. Failed requests release
+// the key so that they can be retried.
+func idempotent[Req, Res proto.Message](s *Server, ctx context.Context, userId int64, in Req, handle func(context.Context, Req) (Res, error)) (Res, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	keys := md.Get(idempotencyKey)
+	if len(keys) == 0 || keys[0] == "" {
+		return handle(ctx, in)
+	}
+
+	var empty Res
+	res := empty.ProtoReflect().New().Interface().(Res)
+	if len(keys[0]) > 255 {
+		setStatus(res, http.StatusBadRequest, errIdempotencyKey)
+		return res, nil
+	}
+
+	hash, err := requestHash(in)
+	if err != nil {
+		setStatus(res, http.StatusInternalServerError, err.Error())
+		return res, nil
+	}
+
+	key := &domain.IdempotencyKey{
+		UserId:      userId,
+		Key:         keys[0],
+		RequestHash: hash,
+	}
+	now := time.Now()
+	stored, err := s.IdempotencyRepo.Claim(key, now.Add(-s.IdempotencyTTL), now.Add(-s.PendingKeyTTL))
+	switch {
+	case errors.Is(err, repository.ErrIdempotencyKeyUsed) && stored.RequestHash != hash:
+		setStatus(res, http.StatusUnprocessableEntity, errKeyReused)
+		return res, nil
+	case errors.Is(err, repository.ErrIdempotencyKeyUsed) && len(stored.Response) == 0:
+		setStatus(res, http.StatusConflict, errKeyPending)
+		return res, nil
+	case errors.Is(err, repository.ErrIdempotencyKeyUsed):
+		if err := proto.Unmarshal(stored.Response, res); err != nil {
+			setStatus(res, http.StatusInternalServerError, err.Error())
+		}
+		return res, nil
+	case err != nil:
+		setStatus(res, http.StatusInternalServerError, err.Error())
+		return res, nil
+	}
+
+	res, err = handle(ctx, in)
+	if err != nil || res.ProtoReflect().Get(statusField(res)).Int() >= http.StatusInternalServerError {
+		if err := s.IdempotencyRepo.Release(key); err != nil {
+			log.Println("failed at releasing idempotency key : ", err)
+		}
+		return res, err
+	}
+
+	key.Response, err = proto.Marshal(res)
+	if err == nil {
+		err = s.IdempotencyRepo.Complete(key)
+	}
+	if err != nil {
+		log.Println("failed at storing idempotent response : ", err)
+		// Retries would otherwise be told the request is still handled.
+		if err := s.IdempotencyRepo.Release(key); err != nil {
+			log.Println("failed at releasing idempotency key : ", err)
+		}
+	}
+
+	return res, nil
+}
+
+// requestHash tells requests apart by their method and contents.
+func requestHash(in proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(in)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(in.ProtoReflect().Descriptor().FullName()))
+	hash.Write(data)
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// setStatus sets the status and error of res, which all responses have.
+func setStatus(res proto.Message, status int64, msg string) {
+	m := res.ProtoReflect()
+	m.Set(statusField(res), protoreflect.ValueOfInt64(status))
+	m.Set(m.Descriptor().Fields().ByName("error"), protoreflect.ValueOfString(msg))
+}
+
+func statusField(res proto.Message) protoreflect.FieldDescriptor {
+	return res.ProtoReflect().Descriptor().Fields().ByName("status")
+}
+
+// listColumns and itemColumns map the fields update masks of lists and items
+// can name to the columns they are saved in.
+var (
+	listColumns = map[string]string{
+		"title": "title",
+	}
+	itemColumns = map[string]string{
+		"title":       "title",
+		"description": "description",
+		"completed":   "done",
+		"start_at":    "start_at",
+		"due_at":      "due_at",
+		"time_zone":   "time_zone",
+		"priority":    "priority",
+	}
+)
+
+// maskedColumns returns the columns named by the paths of mask, nil for an
+// empty mask that changes all of them. It is not ok when a path names no
+// column.
+func maskedColumns(mask *fieldmaskpb.FieldMask, columns map[string]string) ([]string, bool) {
+	var masked []string
+	for _, path := range mask.GetPaths() {
+		column, ok := columns[path]
+		if !ok {
+			return nil, false
+		}
+		if !slices.Contains(masked, column) {
+			masked = append(masked, column)
+		}
+	}
+
+	return masked, true
+}
+
+// versionError returns the response status and error message for changes
+// turned down because the target changed meanwhile. Only changes to a version
+// the client asked for fail its precondition, others just lost a race.
+func versionError(version int64) (int64, string) {
+	if version != 0 {
+		return http.StatusPreconditionFailed, errStaleVersion
+	}
+	return http.StatusConflict, errVersionConflict
+}
+
+// accessError tells members whose role is too low apart from users who are
+// not members of the list at all.
+func accessError(err error) string {
+	if errors.Is(err, repository.ErrInsufficientRole) {
+		return errRole
+	}
+	return errForbidden
+}
+
+func memberErrorStatus(err error) int64 {
+	switch {
+	case errors.Is(err, repository.ErrUsersListNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, repository.ErrMemberExists), errors.Is(err, repository.ErrLastOwner):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func memberError(err error) string {
+	switch {
+	case errors.Is(err, repository.ErrUsersListNotFound):
+		return errMemberNotFound
+	case errors.Is(err, repository.ErrMemberExists):
+		return errMemberExists
+	case errors.Is(err, repository.ErrLastOwner):
+		return errLastOwner
+	default:
+		return err.Error()
+	}
+}
+
+func (s *Server) CreateTodoItem(ctx context.Context, in *pb.CreateTodoItemRequest) (*pb.CreateTodoItemResponse, error) {
+	return idempotent(s, ctx, in.UserId, in, s.createTodoItem)
+}
+
+func (s *Server) createTodoItem(ctx context.Context, in *pb.CreateTodoItemRequest) (*pb.CreateTodoItemResponse, error) {
+	if !validTitle(in.Title) {
+		return &pb.CreateTodoItemResponse{
+			Status: http.StatusBadRequest,
+			Error:  errInvalidTitle,
+		}, nil
+	}
+
+	if status, msg := s.checkEditable(in.UserId, in.ListId); msg != "" {
+		return &pb.CreateTodoItemResponse{
+			Item:   nil,
+			Status: status,
+			Error:  msg,
+		}, nil
+	}
+
+	item := &domain.TodoItem{
+		Title:       in.Title,
+		Description: in.Description,
+		Done:        false,
+		StartAt:     utils.FromTimestamp(in.StartAt),
+		DueAt:       utils.FromTimestamp(in.DueAt),
+		TimeZone:    in.TimeZone,
+		Priority:    domain.Priority(in.Priority),
+		ListId:      in.ListId,
+	}
+
+	if in.Recurrence != "" || in.RecurrenceCount != 0 || in.RecurrenceUntil != nil {
+		rule, err := recurrence.Normalize(in.Recurrence, in.RecurrenceCount, utils.FromTimestamp(in.RecurrenceUntil))
+		if err != nil {
+			return &pb.CreateTodoItemResponse{
+				Item:   nil,
+				Status: http.StatusBadRequest,
+				Error:  recurrenceError(err),
+			}, nil
+		}
+		item.Recurrence = rule
+		item.RecurrenceStart = item.DueAt
+	}
+
+	if msg := validateItem(item); msg != "" {
+		return &pb.CreateTodoItemResponse{
+			Item:   nil,
+			Status: http.StatusBadRequest,
+			Error:  msg,
+		}, nil
+	}
+
+	if in.ParentId != 0 {
+		if status, msg := s.checkParent(in.UserId, in.ParentId, in.ListId); status != http.StatusOK {
+			return &pb.CreateTodoItemResponse{
+				Item:   nil,
+				Status: status,
+				Error:  msg,
+			}, nil
+		}
+		item.ParentId = &in.ParentId
+	}
+
+	if err := s.ItemRepo.Create(item, activity(in.UserId, domain.ActionCreated)); err != nil {
+		return &pb.CreateTodoItemResponse{
+			Item:   nil,
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.CreateTodoItemResponse{
+		Item:   s.Mapper.FromDomainItem(item),
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (s *Server) GetTodoItemById(ctx context.Context, in *pb.GetTodoItemRequest) (*pb.GetTodoItemResponse, error) {
+	item, _, err := s.ItemRepo.GetById(in.UserId, in.Id)
+	if err != nil {
+		return &pb.GetTodoItemResponse{
+			Item:   nil,
+			Status: http.StatusNotFound,
+			Error:  errItemNotFound,
+		}, nil
+	}
+
+	if err := s.ListRepo.CheckUserAccessToList(in.UserId, item.ListId, domain.RoleViewer); err != nil {
+		return &pb.GetTodoItemResponse{
+			Item:   nil,
+			Status: http.StatusForbidden,
+			Error:  accessError(err),
+		}, nil
+	}
+
+	descendants, err := s.ItemRepo.GetDescendants(in.UserId, item.Id)
+	if err != nil {
+		return &pb.GetTodoItemResponse{
+			Item:   nil,
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+	buildSubtree(item, descendants)
+
+	return &pb.GetTodoItemResponse{
+		Item:   s.Mapper.FromDomainItem(item),
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (s *Server) GetTodoItems(ctx context.Context, in *pb.GetTodoItemsRequest) (*pb.GetTodoItemsResponse, error) {
+	if err := s.ListRepo.CheckUserAccessToList(in.UserId, in.ListId, domain.RoleViewer); err != nil {
+		return &pb.GetTodoItemsResponse{
+			Status: http.StatusForbidden,
+			Error:  accessError(err),
+		}, nil
+	}
+
+	if _, ok := pb.ItemSort_name[int32(in.Sort)]; !ok {
+		return &pb.GetTodoItemsResponse{
+			Status: http.StatusBadRequest,
+			Error:  errInvalidSort,
+		}, nil
+	}
+
+	if _, ok := pb.CompletionFilter_name[int32(in.Completion)]; !ok {
+		return &pb.GetTodoItemsResponse{
+			Status: http.StatusBadRequest,
+			Error:  errCompletion,
+		}, nil
+	}
+
+	size, ok := pageSize(in.PageSize)
+	if !ok {
+		return &pb.GetTodoItemsResponse{
+			Status: http.StatusBadRequest,
+			Error:  errPageSize,
+		}, nil
+	}
+
+	sort := domain.ItemSort{
+		Field:      domain.ItemSortField(in.Sort),
+		Descending: in.Descending,
+	}
+
+	var after *domain.ItemCursor
+	if in.PageToken != "" {
+		after = &domain.ItemCursor{}
+		if err := pagination.Decode(in.PageToken, after); err != nil || after.Sort != sort {
+			return &pb.GetTodoItemsResponse{
+				Status: http.StatusBadRequest,
+				Error:  errPageToken,
+			}, nil
+		}
+	}
+
+	filter := domain.ItemFilter{
+		TagIds: in.TagIds,
+		Title:  strings.TrimSpace(in.Title),
+	}
+	if in.Completion != pb.CompletionFilter_COMPLETION_FILTER_ALL {
+		done := in.Completion == pb.CompletionFilter_COMPLETION_FILTER_DONE
+		filter.Done = &done
+	}
+
+	listItems, total, err := s.ItemRepo.GetAll(in.UserId, in.ListId, filter, sort, size+1, after)
+	if err != nil {
+		return &pb.GetTodoItemsResponse{
+			Status: http.StatusInternalServerError,
+			Error:  errItemNotFound,
+		}, nil
+	}
+
+	var nextPageToken string
+	if len(listItems) > size {
+		listItems = listItems[:size]
+		nextPageToken, err = pagination.Encode(itemCursor(listItems[size-1], sort))
+		if err != nil {
+			return &pb.GetTodoItemsResponse{
+				Status: http.StatusInternalServerError,
+				Error:  err.Error(),
+			}, nil
+		}
+	}
+
+	items := s.Mapper.FromDomainItems(listItems)
+
+	return &pb.GetTodoItemsResponse{
+		Items:         items,
+		Status:        http.StatusOK,
+		NextPageToken: nextPageToken,
+		TotalCount:    total,
+	}, nil
+}
+
+// listCursor returns the cursor pointing at list in the given sort order.
+// Only manual order needs more than the id.
+func listCursor(list *domain.TodoList, sort domain.ListSort) domain.ListCursor {
+	cursor := domain.ListCursor{
+		Sort: sort,
+		Id:   list.Id,
+	}
+	if sort == domain.ListSortManual {
+		cursor.Position = list.Position
+	}
+
+	return cursor
+}
+
+// itemCursor returns the cursor pointing at item in the given sort order.
+func itemCursor(item *domain.TodoItem, sort domain.ItemSort) domain.ItemCursor {
+	cursor := domain.ItemCursor{
+		Sort: sort,
+		Id:   item.Id,
+	}
+
+	switch sort.Field {
+	case domain.ItemSortPriority:
+		cursor.Priority = item.Priority
+	case domain.ItemSortCreated:
+		cursor.CreatedAt = item.CreatedAt
+	case domain.ItemSortDue:
+		cursor.DueAt = item.DueAt
+	case domain.ItemSortTitle:
+		cursor.Title = item.Title
+	default:
+		cursor.Position = item.Position
+	}
+
+	return cursor
+}
+
+// pageSize applies the default to an unset page size and caps it at
+// maxPageSize.
+func pageSize(size int32) (int, bool) {
+	switch {
+	case size < 0:
+		return 0, false
+	case size == 0:
+		return defaultPageSize, true
+	case size > maxPageSize:
+		return maxPageSize, true
+	}
+	return int(size), true
+}
+
+func (s *Server) UpdateTodoItem(ctx context.Context, in *pb.UpdateTodoItemRequest) (*pb.UpdateTodoItemResponse, error) {
+	item, listId, err := s.ItemRepo.GetById(in.UserId, in.Id)
+	if err != nil {
+		return &pb.UpdateTodoItemResponse{
+			Status: http.StatusNotFound,
+			Error:  errItemNotFound,
+		}, nil
+	}
+
+	if status, msg := s.checkEditable(in.UserId, listId); msg != "" {
+		return &pb.UpdateTodoItemResponse{
+			Status: status,
+			Error:  msg,
+		}, nil
+	}
+
+	if in.Version != 0 && in.Version != item.Version {
+		status, msg := versionError(in.Version)
+		return &pb.UpdateTodoItemResponse{
+			Status: status,
+			Error:  msg,
+		}, nil
+	}
+
+	columns, ok := maskedColumns(in.UpdateMask, itemColumns)
+	if !ok {
+		return &pb.UpdateTodoItemResponse{
+			Status: http.StatusBadRequest,
+			Error:  errUpdateMask,
+		}, nil
+	}
+	masked := func(column string) bool {
+		return columns == nil || slices.Contains(columns, column)
+	}
+
+	wasDone := item.Done
+	item.Id = in.Id
+	if masked("title") {
+		item.Title = in.Title
+	}
+	if masked("description") {
+		item.Description = in.Description
+	}
+	if masked("done") {
+		item.Done = in.Completed
+	}
+	if masked("start_at") {
+		item.StartAt = utils.FromTimestamp(in.StartAt)
+	}
+	if masked("due_at") {
+		item.DueAt = utils.FromTimestamp(in.DueAt)
+	}
+	if masked("time_zone") {
+		item.TimeZone = in.TimeZone
+	}
+	if masked("priority") {
+		item.Priority = domain.Priority(in.Priority)
+	}
+
+	action := domain.ActionUpdated
+	if item.Done && !wasDone {
+		action = domain.ActionCompleted
+	}
+
+	if msg := validateItem(item); msg != "" {
+		return &pb.UpdateTodoItemResponse{
+			Status: http.StatusBadRequest,
+			Error:  msg,
+		}, nil
+	}
+
+	if item.Done && !wasDone && item.Recurrence != "" {
+		next, err := nextOccurrence(item)
+		if err != nil {
+			return &pb.UpdateTodoItemResponse{
+				Status: http.StatusInternalServerError,
+				Error:  err.Error(),
+			}, nil
+		}
+
+		if next != nil {
+			err := s.ItemRepo.CompleteRecurring(item, next, in.CompleteDescendants, columns, activity(in.UserId, action))
+			if errors.Is(err, repository.ErrVersionConflict) {
+				status, msg := versionError(in.Version)
+				return &pb.UpdateTodoItemResponse{
+					Status: status,
+					Error:  msg,
+				}, nil
+			}
+			if err != nil {
+				return &pb.UpdateTodoItemResponse{
+					Status: http.StatusInternalServerError,
+					Error:  err.Error(),
+				}, nil
+			}
+
+			return &pb.UpdateTodoItemResponse{
+				Item:           s.Mapper.FromDomainItem(item),
+				NextOccurrence: s.Mapper.FromDomainItem(next),
+				Status:         http.StatusOK,
+			}, nil
+		}
+	}
+
+	update := s.ItemRepo.Update
+	if item.Done && in.CompleteDescendants {
+		update = s.ItemRepo.CompleteSubtree
+	}
+
+	err = update(item, columns, activity(in.UserId, action))
+	if errors.Is(err, repository.ErrVersionConflict) {
+		status, msg := versionError(in.Version)
+		return &pb.UpdateTodoItemResponse{
+			Status: status,
+			Error:  msg,
+		}, nil
+	}
+	if err != nil {
+		return &pb.UpdateTodoItemResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.UpdateTodoItemResponse{
+		Item:   s.Mapper.FromDomainItem(item),
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (s *Server) DeleteTodoItem(ctx context.Context, in *pb.DeleteTodoItemRequest) (*pb.DeleteTodoItemResponse, error) {
+	item, listId, err := s.ItemRepo.GetById(in.UserId, in.Id)
+	if err != nil {
+		return &pb.DeleteTodoItemResponse{
+			Success: false,
+			Status:  http.StatusNotFound,
+			Error:   errItemNotFound,
+		}, nil
+	}
+
+	if status, msg := s.checkEditable(in.UserId, listId); msg != "" {
+		return &pb.DeleteTodoItemResponse{
+			Success: false,
+			Status:  status,
+			Error:   msg,
+		}, nil
+	}
+
+	err = s.ItemRepo.Delete(item.Id, in.ReparentChildren, in.Version, activity(in.UserId, domain.ActionDeleted))
+	if errors.Is(err, repository.ErrVersionConflict) {
+		status, msg := versionError(in.Version)
+		return &pb.DeleteTodoItemResponse{
+			Success: false,
+			Status:  status,
+			Error:   msg,
+		}, nil
+	}
+	if err != nil {
+		return &pb.DeleteTodoItemResponse{
+			Success: false,
+			Status:  http.StatusInternalServerError,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &pb.DeleteTodoItemResponse{
+		Success: true,
+		Status:  http.StatusOK,
+	}, nil
+}
+
+func (s *Server) MoveTodoItem(ctx context.Context, in *pb.MoveTodoItemRequest) (*pb.MoveTodoItemResponse, error) {
+	if in.AfterId == in.Id || in.BeforeId == in.Id {
+		return &pb.MoveTodoItemResponse{
+			Status: http.StatusBadRequest,
+			Error:  errMoveSelf,
+		}, nil
+	}
+
+	item, listId, err := s.ItemRepo.GetById(in.UserId, in.Id)
+	if err != nil {
+		return &pb.MoveTodoItemResponse{
+			Status: http.StatusNotFound,
+			Error:  errItemNotFound,
+		}, nil
+	}
+
+	if status, msg := s.checkEditable(in.UserId, listId); msg != "" {
+		return &pb.MoveTodoItemResponse{
+			Status: status,
+			Error:  msg,
+		}, nil
+	}
+
+	if err := s.ItemRepo.Move(item, in.AfterId, in.BeforeId, activity(in.UserId, domain.ActionMoved)); err != nil {
+		status, msg := moveErrorStatus(err)
+		return &pb.MoveTodoItemResponse{
+			Status: status,
+			Error:  msg,
+		}, nil
+	}
+
+	return &pb.MoveTodoItemResponse{
+		Item:   s.Mapper.FromDomainItem(item),
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (s *Server) MoveTodoItems(ctx context.Context, in *pb.MoveTodoItemsRequest) (*pb.MoveTodoItemsResponse, error) {
+	if status, msg := s.checkTransfer(in.UserId, in.Ids, in.ListId, true); msg != "" {
+		return &pb.MoveTodoItemsResponse{
+			Status: status,
+			Error:  msg,
+		}, nil
+	}
+
+	items, err := s.ItemRepo.MoveToList(in.UserId, in.Ids, in.ListId, activity(in.UserId, domain.ActionMoved))
+	if err != nil {
+		return &pb.MoveTodoItemsResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.MoveTodoItemsResponse{
+		Items:  s.Mapper.FromDomainItems(items),
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (s *Server) CopyTodoItems(ctx context.Context, in *pb.CopyTodoItemsRequest) (*pb.CopyTodoItemsResponse, error) {
+	if status, msg := s.checkTransfer(in.UserId, in.Ids, in.ListId, false); msg != "" {
+		return &pb.CopyTodoItemsResponse{
+			Status: status,
+			Error:  msg,
+		}, nil
+	}
+
+	items, err := s.ItemRepo.CopyToList(in.UserId, in.Ids, in.ListId, activity(in.UserId, domain.ActionCopied))
+	if err != nil {
+		return &pb.CopyTodoItemsResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.CopyTodoItemsResponse{
+		Items:  s.Mapper.FromDomainItems(items),
+		Status: http.StatusCreated,
+	}, nil
+}
+
+// checkTransfer makes sure the user can edit the list the items go to and the
+// lists they come from. Copying leaves the items in archived lists untouched,
+// so only moving needs their lists to be unarchived. It returns the response
+// status and error message.
+func (s *Server) checkTransfer(userId int64, itemIds []int64, listId int64, moving bool) (int64, string) {
+	switch {
+	case len(itemIds) == 0:
+		return http.StatusBadRequest, errNoItems
+	case len(itemIds) > maxTransferItems:
+		return http.StatusBadRequest, errTooManyItems
+	}
+
+	if status, msg := s.checkEditable(userId, listId); msg != "" {
+		return status, msg
+	}
+
+	checked := map[int64]bool{listId: true}
+	for _, itemId := range itemIds {
+		_, sourceId, err := s.ItemRepo.GetById(userId, itemId)
+		if err != nil {
+			return http.StatusNotFound, errItemNotFound
+		}
+		if checked[sourceId] {
+			continue
+		}
+		checked[sourceId] = true
+
+		if moving {
+			if status, msg := s.checkEditable(userId, sourceId); msg != "" {
+				return status, msg
+			}
+		} else if err := s.ListRepo.CheckUserAccessToList(userId, sourceId, domain.RoleEditor); err != nil {
+			return http.StatusForbidden, accessError(err)
+		}
+	}
+
+	return http.StatusOK, ""
+}
+
+// moveErrorStatus maps errors of moving an item or list to a response status
+// and error message.
+func moveErrorStatus(err error) (int64, string) {
+	switch {
+	case errors.Is(err, repository.ErrNeighbourNotFound):
+		return http.StatusNotFound, errNeighbour
+	case errors.Is(err, repository.ErrNeighbourOrder):
+		return http.StatusBadRequest, errNeighbourOrder
+	case errors.Is(err, repository.ErrUsersListNotFound):
+		return http.StatusForbidden, errForbidden
+	default:
+		return http.StatusInternalServerError, err.Error()
+	}
+}
+
+func (s *Server) BatchUpdateTodoItems(ctx context.Context, in *pb.BatchUpdateTodoItemsRequest) (*pb.BatchUpdateTodoItemsResponse, error) {
+	return idempotent(s, ctx, in.UserId, in, s.batchUpdateTodoItems)
+}
+
+func (s *Server) batchUpdateTodoItems(ctx context.Context, in *pb.BatchUpdateTodoItemsRequest) (*pb.BatchUpdateTodoItemsResponse, error) {
+	if msg := validateBatch(in); msg != "" {
+		return &pb.BatchUpdateTodoItemsResponse{
+			Status: http.StatusBadRequest,
+			Error:  msg,
+		}, nil
+	}
+
+	ids := make([]int64, 0, len(in.Ids))
+	for _, id := range in.Ids {
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+
+	items, err := s.ItemRepo.GetByIds(in.UserId, ids)
+	if err != nil {
+		return &pb.BatchUpdateTodoItemsResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	byId := make(map[int64]*domain.TodoItem, len(items))
+	for _, item := range items {
+		byId[item.Id] = item
+	}
+
+	type access struct {
+		status int64
+		msg    string
+	}
+	lists := make(map[int64]access)
+
+	batch := &domain.ItemBatch{Next: make(map[int64]*domain.TodoItem)}
+	results := make([]*pb.BatchItemResult, len(ids))
+	var failure *pb.BatchItemResult
+	for i, id := range ids {
+		result := &pb.BatchItemResult{Id: id, Status: http.StatusOK}
+		results[i] = result
+
+		item, ok := byId[id]
+		if !ok {
+			result.Status, result.Error = http.StatusNotFound, errItemNotFound
+		} else {
+			checked, ok := lists[item.ListId]
+			if !ok {
+				checked.status, checked.msg = s.checkEditable(in.UserId, item.ListId)
+				lists[item.ListId] = checked
+			}
+			if checked.msg != "" {
+				result.Status, result.Error = checked.status, checked.msg
+			} else {
+				result.Status, result.Error = applyBatchOperation(item, in, batch)
+			}
+		}
+
+		if result.Error != "" && failure == nil {
+			failure = result
+		}
+	}
+
+	if failure != nil {
+		for _, result := range results {
+			if result.Error == "" {
+				result.Status, result.Error = http.StatusFailedDependency, errBatchAborted
+			}
+		}
+
+		return &pb.BatchUpdateTodoItemsResponse{
+			Results: results,
+			Status:  failure.Status,
+			Error:   errBatchFailed,
+		}, nil
+	}
+
+	if err := s.ItemRepo.ApplyBatch(batch, activity(in.UserId, batchActions[in.Operation])); err != nil {
+		return &pb.BatchUpdateTodoItemsResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	for _, result := range results {
+		result.Item = s.Mapper.FromDomainItem(byId[result.Id])
+		if next, ok := batch.Next[result.Id]; ok {
+			result.NextOccurrence = s.Mapper.FromDomainItem(next)
+		}
+	}
+
+	return &pb.BatchUpdateTodoItemsResponse{
+		Results: results,
+		Status:  http.StatusOK,
+	}, nil
+}
+
+// batchActions is how the operations of a batch are recorded.
+var batchActions = map[pb.BatchOperation]domain.Action{
+	pb.BatchOperation_BATCH_OPERATION_COMPLETE:   domain.ActionCompleted,
+	pb.BatchOperation_BATCH_OPERATION_UNCOMPLETE: domain.ActionUpdated,
+	pb.BatchOperation_BATCH_OPERATION_DELETE:     domain.ActionDeleted,
+	pb.BatchOperation_BATCH_OPERATION_SET_FIELDS: domain.ActionUpdated,
+}
+
+func validateBatch(in *pb.BatchUpdateTodoItemsRequest) string {
+	switch {
+	case len(in.Ids) == 0:
+		return errNoItems
+	case len(in.Ids) > maxBatchItems:
+		return errTooManyBatch
+	case in.Operation <= pb.BatchOperation_BATCH_OPERATION_UNSPECIFIED ||
+		in.Operation > pb.BatchOperation_BATCH_OPERATION_SET_FIELDS:
+		return errBatchOperation
+	case in.Operation == pb.BatchOperation_BATCH_OPERATION_SET_FIELDS && in.Fields == nil:
+		return errBatchFields
+	default:
+		return ""
+	}
+}
+
+// applyBatchOperation applies the operation of the request to item and adds
+// the change to batch. Completing a recurring item also schedules its next
+// occurrence. It returns the status and error message of the item.
+func applyBatchOperation(item *domain.TodoItem, in *pb.BatchUpdateTodoItemsRequest, batch *domain.ItemBatch) (int64, string) {
+	switch in.Operation {
+	case pb.BatchOperation_BATCH_OPERATION_COMPLETE:
+		if !item.Done && item.Recurrence != "" {
+			next, err := nextOccurrence(item)
+			if err != nil {
+				return http.StatusInternalServerError, err.Error()
+			}
+			if next != nil {
+				batch.Next[item.Id] = next
+			}
+		}
+		item.Done = true
+	case pb.BatchOperation_BATCH_OPERATION_UNCOMPLETE:
+		item.Done = false
+	case pb.BatchOperation_BATCH_OPERATION_DELETE:
+		batch.Delete = append(batch.Delete, item.Id)
+		return http.StatusOK, ""
+	case pb.BatchOperation_BATCH_OPERATION_SET_FIELDS:
+		if in.Fields.StartAt != nil {
+			item.StartAt = utils.FromTimestamp(in.Fields.StartAt)
+		}
+		if in.Fields.DueAt != nil {
+			item.DueAt = utils.FromTimestamp(in.Fields.DueAt)
+		}
+		if in.Fields.TimeZone != nil {
+			item.TimeZone = *in.Fields.TimeZone
+		}
+		if in.Fields.Priority != nil {
+			item.Priority = domain.Priority(*in.Fields.Priority)
+		}
+		if msg := validateItem(item); msg != "" {
+			return http.StatusBadRequest, msg
+		}
+	}
+
+	batch.Save = append(batch.Save, item)
+	return http.StatusOK, ""
+}
+
+func (s *Server) GetDueItems(ctx context.Context, in *pb.GetDueItemsRequest) (*pb.GetDueItemsResponse, error) {
+	after := utils.FromTimestamp(in.DueAfter)
+	before := utils.FromTimestamp(in.DueBefore)
+	if after != nil && before != nil && !after.Before(*before) {
+		return &pb.GetDueItemsResponse{
+			Status: http.StatusBadRequest,
+			Error:  errInvalidRange,
+		}, nil
+	}
+
+	dueItems, err := s.ItemRepo.GetDue(in.UserId, after, before, in.IncludeCompleted)
+	if err != nil {
+		return &pb.GetDueItemsResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.GetDueItemsResponse{
+		Items:  s.Mapper.FromDomainItems(dueItems),
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (s *Server) GetOverdueItems(ctx context.Context, in *pb.GetOverdueItemsRequest) (*pb.GetOverdueItemsResponse, error) {
+	now := time.Now()
+
+	overdueItems, err := s.ItemRepo.GetDue(in.UserId, nil, &now, false)
+	if err != nil {
+		return &pb.GetOverdueItemsResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.GetOverdueItemsResponse{
+		Items:  s.Mapper.FromDomainItems(overdueItems),
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (s *Server) SearchItems(ctx context.Context, in *pb.SearchItemsRequest) (*pb.SearchItemsResponse, error) {
+	query := strings.TrimSpace(in.Query)
+	if query == "" {
+		return &pb.SearchItemsResponse{
+			Status: http.StatusBadRequest,
+			Error:  errEmptyQuery,
+		}, nil
+	}
+
+	limit := int(in.Limit)
+	if limit <= 0 {
+		limit = defaultResults
+	}
+	if limit > maxResults {
+		limit = maxResults
+	}
+
+	results, err := s.ItemRepo.Search(in.UserId, query, limit)
+	if err != nil {
+		return &pb.SearchItemsResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.SearchItemsResponse{
+		Results: s.Mapper.FromDomainSearchResults(results),
+		Status:  http.StatusOK,
+	}, nil
+}
+
+func (s *Server) GetUpcomingOccurrences(ctx context.Context, in *pb.GetUpcomingOccurrencesRequest) (*pb.GetUpcomingOccurrencesResponse, error) {
+	var (
+		rule     string
+		dtstart  time.Time
+		from     time.Time
+		timeZone string
+	)
+
+	if in.ItemId != 0 {
+		item, listId, err := s.ItemRepo.GetById(in.UserId, in.ItemId)
+		if err != nil {
+			return &pb.GetUpcomingOccurrencesResponse{
+				Status: http.StatusNotFound,
+				Error:  errItemNotFound,
+			}, nil
+		}
+
+		if err := s.ListRepo.CheckUserAccessToList(in.UserId, listId, domain.RoleViewer); err != nil {
+			return &pb.GetUpcomingOccurrencesResponse{
+				Status: http.StatusForbidden,
+				Error:  accessError(err),
+			}, nil
+		}
+
+		if item.Recurrence == "" || item.DueAt == nil {
+			return &pb.GetUpcomingOccurrencesResponse{
+				Status: http.StatusBadRequest,
+				Error:  errNotRecurring,
+			}, nil
+		}
+
+		rule, dtstart, from, timeZone = item.Recurrence, seriesStart(item), *item.DueAt, item.TimeZone
+	} else {
+		var err error
+		rule, err = recurrence.Normalize(in.Recurrence, in.RecurrenceCount, utils.FromTimestamp(in.RecurrenceUntil))
+		if err != nil {
+			return &pb.GetUpcomingOccurrencesResponse{
+				Status: http.StatusBadRequest,
+				Error:  recurrenceError(err),
+			}, nil
+		}
+
+		if in.DueAt == nil {
+			return &pb.GetUpcomingOccurrencesResponse{
+				Status: http.StatusBadRequest,
+				Error:  errRecurrenceDue,
+			}, nil
+		}
+
+		dtstart = in.DueAt.AsTime()
+		from, timeZone = dtstart, in.TimeZone
+	}
+
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return &pb.GetUpcomingOccurrencesResponse{
+			Status: http.StatusBadRequest,
+			Error:  errInvalidTimeZone,
+		}, nil
+	}
+
+	limit := int(in.Limit)
+	if limit <= 0 {
+		limit = defaultOccurrences
+	}
+	if limit > maxOccurrences {
+		limit = maxOccurrences
+	}
+
+	occurrences, err := recurrence.Occurrences(rule, dtstart, loc, from, limit)
+	if err != nil {
+		return &pb.GetUpcomingOccurrencesResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	timestamps := make([]*timestamppb.Timestamp, 0, len(occurrences))
+	for _, occurrence := range occurrences {
+		timestamps = append(timestamps, timestamppb.New(occurrence))
+	}
+
+	return &pb.GetUpcomingOccurrencesResponse{
+		Occurrences: timestamps,
+		Status:      http.StatusOK,
+	}, nil
+}
+
+// checkParent makes sure a new subtask can be added under parentId in the
+// list listId. It returns the response status and error message.
+func (s *Server) checkParent(userId, parentId, listId int64) (int64, string) {
+	_, parentListId, err := s.ItemRepo.GetById(userId, parentId)
+	if err != nil {
+		return http.StatusNotFound, errParentNotFound
+	}
+
+	if parentListId != listId {
+		return http.StatusBadRequest, errParentList
+	}
+
+	depth, err := s.ItemRepo.GetDepth(parentId)
+	if err != nil {
+		return http.StatusInternalServerError, err.Error()
+	}
+
+	if depth >= domain.MaxItemDepth {
+		return http.StatusBadRequest, errTooDeep
+	}
+
+	return http.StatusOK, ""
+}
+
+// buildSubtree links descendants into the Children of root and its subtasks.
+func buildSubtree(root *domain.TodoItem, descendants []*domain.TodoItem) {
+	byId := map[int64]*domain.TodoItem{root.Id: root}
+	for _, item := range descendants {
+		byId[item.Id] = item
+	}
+
+	for _, item := range descendants {
+		if item.ParentId == nil {
+			continue
+		}
+		if parent, ok := byId[*item.ParentId]; ok {
+			parent.Children = append(parent.Children, item)
+		}
+	}
+}
+
+// validateItem checks the item's priority, dates and time zone, returning an
+// error message suitable for the response or an empty string if they are valid.
+func validateItem(item *domain.TodoItem) string {
+	if !item.Priority.Valid() {
+		return errInvalidPriority
+	}
+
+	if item.StartAt != nil && item.DueAt != nil && item.StartAt.After(*item.DueAt) {
+		return errInvalidSchedule
+	}
+
+	if item.TimeZone != "" {
+		if _, err := time.LoadLocation(item.TimeZone); err != nil {
+			return errInvalidTimeZone
+		}
+	}
+
+	if item.Recurrence != "" && item.DueAt == nil {
+		return errRecurrenceDue
+	}
+
+	return ""
+}
+
+// nextOccurrence builds the item that follows the completed recurring item,
+// or returns nil once its series has ended.
+func nextOccurrence(item *domain.TodoItem) (*domain.TodoItem, error) {
+	loc, err := time.LoadLocation(item.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	dueAt, err := recurrence.Next(item.Recurrence, seriesStart(item), loc, *item.DueAt)
+	if err != nil || dueAt == nil {
+		return nil, err
+	}
+
+	next := &domain.TodoItem{
+		Title:           item.Title,
+		Description:     item.Description,
+		DueAt:           dueAt,
+		TimeZone:        item.TimeZone,
+		Priority:        item.Priority,
+		Recurrence:      item.Recurrence,
+		RecurrenceStart: item.RecurrenceStart,
+		ListId:          item.ListId,
+		ParentId:        item.ParentId,
+		Tags:            item.Tags,
+		Extensions:      item.Extensions,
+	}
+	if item.StartAt != nil {
+		startAt := dueAt.Add(item.StartAt.Sub(*item.DueAt))
+		next.StartAt = &startAt
+	}
+
+	return next, nil
+}
+
+func seriesStart(item *domain.TodoItem) time.Time {
+	if item.RecurrenceStart != nil {
+		return *item.RecurrenceStart
+	}
+	return *item.DueAt
+}
+
+func recurrenceError(err error) string {
+	switch {
+	case errors.Is(err, recurrence.ErrUnsupportedFrequency):
+		return errRuleFrequency
+	case errors.Is(err, recurrence.ErrCountAndUntil):
+		return errRuleEnd
+	default:
+		return errInvalidRule
+	}
+}
+
+func (s *Server) CreateTag(ctx context.Context, in *pb.CreateTagRequest) (*pb.CreateTagResponse, error) {
+	tag := &domain.Tag{
+		UserId: in.UserId,
+		Name:   strings.TrimSpace(in.Name),
+	}
+
+	if !validTagName(tag.Name) {
+		return &pb.CreateTagResponse{
+			Status: http.StatusBadRequest,
+			Error:  errInvalidTagName,
+		}, nil
+	}
+
+	if err := s.TagRepo.Create(tag); err != nil {
+		return &pb.CreateTagResponse{
+			Status: tagErrorStatus(err),
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.CreateTagResponse{
+		Tag: &pb.Tag{
+			Id:   tag.Id,
+			Name: tag.Name,
+		},
+		Status: http.StatusCreated,
+	}, nil
+}
+
+func (s *Server) GetTags(ctx context.Context, in *pb.GetTagsRequest) (*pb.GetTagsResponse, error) {
+	tags, err := s.TagRepo.GetAll(in.UserId)
+	if err != nil {
+		return &pb.GetTagsResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.GetTagsResponse{
+		Tags:   s.Mapper.FromDomainTags(tags),
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (s *Server) UpdateTag(ctx context.Context, in *pb.UpdateTagRequest) (*pb.UpdateTagResponse, error) {
+	name := strings.TrimSpace(in.Name)
+	if !validTagName(name) {
+		return &pb.UpdateTagResponse{
+			Status: http.StatusBadRequest,
+			Error:  errInvalidTagName,
+		}, nil
+	}
+
+	tag, err := s.TagRepo.GetById(in.Id)
+	if err != nil || tag.UserId != in.UserId {
+		return &pb.UpdateTagResponse{
+			Status: http.StatusNotFound,
+			Error:  errTagNotFound,
+		}, nil
+	}
+
+	tag.Name = name
+	if err := s.TagRepo.Update(tag); err != nil {
+		return &pb.UpdateTagResponse{
+			Status: tagErrorStatus(err),
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.UpdateTagResponse{
+		Tag: &pb.Tag{
+			Id:   tag.Id,
+			Name: tag.Name,
+		},
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (s *Server) DeleteTag(ctx context.Context, in *pb.DeleteTagRequest) (*pb.DeleteTagResponse, error) {
+	tag, err := s.TagRepo.GetById(in.Id)
+	if err != nil || tag.UserId != in.UserId {
+		return &pb.DeleteTagResponse{
+			Status: http.StatusNotFound,
+			Error:  errTagNotFound,
+		}, nil
+	}
+
+	if err := s.TagRepo.Delete(tag.Id); err != nil {
+		return &pb.DeleteTagResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.DeleteTagResponse{
+		Success: true,
+		Status:  http.StatusOK,
+	}, nil
+}
+
+func (s *Server) AttachTag(ctx context.Context, in *pb.AttachTagRequest) (*pb.AttachTagResponse, error) {
+	status, msg := s.checkTagging(in.UserId, in.ItemId, in.TagId)
+	if status != http.StatusOK {
+		return &pb.AttachTagResponse{
+			Status: status,
+			Error:  msg,
+		}, nil
+	}
+
+	if err := s.TagRepo.Attach(in.ItemId, in.TagId, activity(in.UserId, domain.ActionAdded)); err != nil {
+		return &pb.AttachTagResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.AttachTagResponse{
+		Success: true,
+		Status:  http.StatusOK,
+	}, nil
+}
+
+func (s *Server) DetachTag(ctx context.Context, in *pb.DetachTagRequest) (*pb.DetachTagResponse, error) {
+	status, msg := s.checkTagging(in.UserId, in.ItemId, in.TagId)
+	if status != http.StatusOK {
+		return &pb.DetachTagResponse{
+			Status: status,
+			Error:  msg,
+		}, nil
+	}
+
+	if err := s.TagRepo.Detach(in.ItemId, in.TagId, activity(in.UserId, domain.ActionRemoved)); err != nil {
+		return &pb.DetachTagResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.DetachTagResponse{
+		Success: true,
+		Status:  http.StatusOK,
+	}, nil
+}
+
+// checkTagging makes sure the tag belongs to the user and the user can access
+// the item's list. It returns the response status and error message.
+func (s *Server) checkTagging(userId, itemId, tagId int64) (int64, string) {
+	tag, err := s.TagRepo.GetById(tagId)
+	if err != nil || tag.UserId != userId {
+		return http.StatusNotFound, errTagNotFound
+	}
+
+	_, listId, err := s.ItemRepo.GetById(userId, itemId)
+	if err != nil {
+		return http.StatusNotFound, errItemNotFound
+	}
+
+	return s.checkEditable(userId, listId)
+}
+
+// checkEditable makes sure the user can change the items of the list and the
+// list is not archived. It returns the response status and error message.
+func (s *Server) checkEditable(userId, listId int64) (int64, string) {
+	if err := s.ListRepo.CheckUserAccessToList(userId, listId, domain.RoleEditor); err != nil {
+		return http.StatusForbidden, accessError(err)
+	}
+
+	return s.checkNotArchived(listId)
+}
+
+// checkNotArchived returns the response status and error message for changes
+// to an archived list.
+func (s *Server) checkNotArchived(listId int64) (int64, string) {
+	if err := s.ListRepo.CheckNotArchived(listId); err != nil {
+		if errors.Is(err, repository.ErrListArchived) {
+			return http.StatusConflict, errListArchived
+		}
+		return http.StatusNotFound, errListNotFound
+	}
+
+	return http.StatusOK, ""
+}
+
+func validTitle(title string) bool {
+	return strings.TrimSpace(title) != "" && len([]rune(title)) <= maxTitleLength
+}
+
+func validTagName(name string) bool {
+	return name != "" && len([]rune(name)) <= maxTagNameLength
+}
+
+func tagErrorStatus(err error) int64 {
+	if errors.Is(err, repository.ErrTagExists) {
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
+
+func (s *Server) AddComment(ctx context.Context, in *pb.AddCommentRequest) (*pb.AddCommentResponse, error) {
+	body := strings.TrimSpace(in.Body)
+	if !validCommentBody(body) {
+		return &pb.AddCommentResponse{
+			Status: http.StatusBadRequest,
+			Error:  errCommentBody,
+		}, nil
+	}
+
+	if _, status, msg := s.checkCommenting(in.UserId, in.ItemId, true); msg != "" {
+		return &pb.AddCommentResponse{
+			Status: status,
+			Error:  msg,
+		}, nil
+	}
+
+	comment := &domain.Comment{
+		ItemId:   in.ItemId,
+		AuthorId: in.UserId,
+		Body:     body,
+	}
+	if err := s.CommentRepo.Create(comment, activity(in.UserId, domain.ActionCreated)); err != nil {
+		return &pb.AddCommentResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.AddCommentResponse{
+		Comment: s.Mapper.FromDomainComment(comment),
+		Status:  http.StatusCreated,
+	}, nil
+}
+
+func (s *Server) ListComments(ctx context.Context, in *pb.ListCommentsRequest) (*pb.ListCommentsResponse, error) {
+	size, ok := pageSize(in.PageSize)
+	if !ok {
+		return &pb.ListCommentsResponse{
+			Status: http.StatusBadRequest,
+			Error:  errPageSize,
+		}, nil
+	}
+
+	var after *domain.CommentCursor
+	if in.PageToken != "" {
+		after = &domain.CommentCursor{}
+		if err := pagination.Decode(in.PageToken, after); err != nil {
+			return &pb.ListCommentsResponse{
+				Status: http.StatusBadRequest,
+				Error:  errPageToken,
+			}, nil
+		}
+	}
+
+	if _, status, msg := s.checkCommenting(in.UserId, in.ItemId, false); msg != "" {
+		return &pb.ListCommentsResponse{
+			Status: status,
+			Error:  msg,
+		}, nil
+	}
+
+	comments, total, err := s.CommentRepo.GetAll(in.ItemId, size+1, after)
+	if err != nil {
+		return &pb.ListCommentsResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	var nextPageToken string
+	if len(comments) > size {
+		comments = comments[:size]
+		nextPageToken, err = pagination.Encode(domain.CommentCursor{Id: comments[size-1].Id})
+		if err != nil {
+			return &pb.ListCommentsResponse{
+				Status: http.StatusInternalServerError,
+				Error:  err.Error(),
+			}, nil
+		}
+	}
+
+	return &pb.ListCommentsResponse{
+		Comments:      s.Mapper.FromDomainComments(comments),
+		Status:        http.StatusOK,
+		NextPageToken: nextPageToken,
+		TotalCount:    total,
+	}, nil
+}
+
+func (s *Server) EditComment(ctx context.Context, in *pb.EditCommentRequest) (*pb.EditCommentResponse, error) {
+	body := strings.TrimSpace(in.Body)
+	if !validCommentBody(body) {
+		return &pb.EditCommentResponse{
+			Status: http.StatusBadRequest,
+			Error:  errCommentBody,
+		}, nil
+	}
+
+	comment, err := s.CommentRepo.GetById(in.Id)
+	if err != nil || comment.ItemId != in.ItemId {
+		return &pb.EditCommentResponse{
+			Status: http.StatusNotFound,
+			Error:  errCommentNotFound,
+		}, nil
+	}
+
+	if _, status, msg := s.checkCommenting(in.UserId, comment.ItemId, true); msg != "" {
+		return &pb.EditCommentResponse{
+			Status: status,
+			Error:  msg,
+		}, nil
+	}
+
+	if comment.AuthorId != in.UserId {
+		return &pb.EditCommentResponse{
+			Status: http.StatusForbidden,
+			Error:  errCommentEdit,
+		}, nil
+	}
+
+	if comment.Body != body {
+		comment.Body = body
+		comment.Edited = true
+		if err := s.CommentRepo.Update(comment, activity(in.UserId, domain.ActionUpdated)); err != nil {
+			return &pb.EditCommentResponse{
+				Status: http.StatusInternalServerError,
+				Error:  err.Error(),
+			}, nil
+		}
+	}
+
+	return &pb.EditCommentResponse{
+		Comment: s.Mapper.FromDomainComment(comment),
+		Status:  http.StatusOK,
+	}, nil
+}
+
+func (s *Server) DeleteComment(ctx context.Context, in *pb.DeleteCommentRequest) (*pb.DeleteCommentResponse, error) {
+	comment, err := s.CommentRepo.GetById(in.Id)
+	if err != nil || comment.ItemId != in.ItemId {
+		return &pb.DeleteCommentResponse{
+			Success: false,
+			Status:  http.StatusNotFound,
+			Error:   errCommentNotFound,
+		}, nil
+	}
+
+	listId, status, msg := s.checkCommenting(in.UserId, comment.ItemId, true)
+	if msg != "" {
+		return &pb.DeleteCommentResponse{
+			Success: false,
+			Status:  status,
+			Error:   msg,
+		}, nil
+	}
+
+	if comment.AuthorId != in.UserId {
+		if err := s.ListRepo.CheckUserAccessToList(in.UserId, listId, domain.RoleOwner); err != nil {
+			return &pb.DeleteCommentResponse{
+				Success: false,
+				Status:  http.StatusForbidden,
+				Error:   errCommentDelete,
+			}, nil
+		}
+	}
+
+	if err := s.CommentRepo.Delete(comment.Id, activity(in.UserId, domain.ActionDeleted)); err != nil {
+		return &pb.DeleteCommentResponse{
+			Success: false,
+			Status:  http.StatusInternalServerError,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &pb.DeleteCommentResponse{
+		Success: true,
+		Status:  http.StatusOK,
+	}, nil
+}
+
+// checkCommenting makes sure the item exists and the user is a member of its
+// list. Any member can comment, but comments of archived lists can only be
+// read. It returns the list of the item, the response status and error
+// message.
+func (s *Server) checkCommenting(userId, itemId int64, changing bool) (int64, int64, string) {
+	_, listId, err := s.ItemRepo.GetById(userId, itemId)
+	if err != nil {
+		return 0, http.StatusNotFound, errItemNotFound
+	}
+
+	if err := s.ListRepo.CheckUserAccessToList(userId, listId, domain.RoleViewer); err != nil {
+		return 0, http.StatusForbidden, accessError(err)
+	}
+
+	if changing {
+		if status, msg := s.checkNotArchived(listId); msg != "" {
+			return 0, status, msg
+		}
+	}
+
+	return listId, http.StatusOK, ""
+}
+
+func validCommentBody(body string) bool {
+	return body != "" && len([]rune(body)) <= maxCommentLength
+}
+
+func (s *Server) UploadAttachment(stream pb.TodoService_UploadAttachmentServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	info := first.GetInfo()
+	if info == nil {
+		return stream.SendAndClose(&pb.UploadAttachmentResponse{
+			Status: http.StatusBadRequest,
+			Error:  errAttachmentInfo,
+		})
+	}
+
+	fileName := cleanFileName(info.FileName)
+	if !validFileName(fileName) {
+		return stream.SendAndClose(&pb.UploadAttachmentResponse{
+			Status: http.StatusBadRequest,
+			Error:  errFileName,
+		})
+	}
+
+	if status, msg := s.checkItemAccess(info.UserId, info.ItemId, true); msg != "" {
+		return stream.SendAndClose(&pb.UploadAttachmentResponse{
+			Status: status,
+			Error:  msg,
+		})
+	}
+
+	// The contents are spooled to a temporary file, so that the size is
+	// known and checked before anything reaches the storage.
+	file, err := os.CreateTemp("", "attachment-*")
+	if err != nil {
+		return stream.SendAndClose(&pb.UploadAttachmentResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		})
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	size, err := io.Copy(file, io.LimitReader(&chunkReader[*pb.UploadAttachmentRequest]{stream: stream}, s.Attachments.MaxSize+1))
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case size == 0:
+		return stream.SendAndClose(&pb.UploadAttachmentResponse{
+			Status: http.StatusBadRequest,
+			Error:  errAttachmentEmpty,
+		})
+	case size > s.Attachments.MaxSize:
+		return stream.SendAndClose(&pb.UploadAttachmentResponse{
+			Status: http.StatusRequestEntityTooLarge,
+			Error:  fmt.Sprintf("Attachments can be at most %d bytes", s.Attachments.MaxSize),
+		})
+	}
+
+	head := make([]byte, sniffLength)
+	n, err := file.ReadAt(head, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return stream.SendAndClose(&pb.UploadAttachmentResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		})
+	}
+
+	contentType := http.DetectContentType(head[:n])
+	if mediaType, _, _ := mime.ParseMediaType(contentType); !slices.Contains(s.Attachments.AllowedTypes, mediaType) {
+		return stream.SendAndClose(&pb.UploadAttachmentResponse{
+			Status: http.StatusUnsupportedMediaType,
+			Error:  fmt.Sprintf("Files of type %s cannot be attached", mediaType),
+		})
+	}
+
+	key, err := newStorageKey(info.ItemId)
+	if err == nil {
+		_, err = file.Seek(0, io.SeekStart)
+	}
+	if err == nil {
+		err = s.Storage.Put(stream.Context(), key, file, size, contentType)
+	}
+	if err != nil {
+		return stream.SendAndClose(&pb.UploadAttachmentResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		})
+	}
+
+	attachment := &domain.Attachment{
+		ItemId:      info.ItemId,
+		UploaderId:  info.UserId,
+		FileName:    fileName,
+		ContentType: contentType,
+		Size:        size,
+		StorageKey:  key,
+	}
+	if err := s.AttachmentRepo.Create(attachment, activity(info.UserId, domain.ActionCreated)); err != nil {
+		if err := s.Storage.Delete(stream.Context(), key); err != nil {
+			log.Println("failed at deleting unsaved attachment : ", err)
+		}
+		return stream.SendAndClose(&pb.UploadAttachmentResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		})
+	}
+
+	return stream.SendAndClose(&pb.UploadAttachmentResponse{
+		Attachment: s.Mapper.FromDomainAttachment(attachment),
+		Status:     http.StatusCreated,
+	})
+}
+
+func (s *Server) DownloadAttachment(in *pb.DownloadAttachmentRequest, stream pb.TodoService_DownloadAttachmentServer) error {
+	attachment, status, msg := s.findAttachment(in.UserId, in.ItemId, in.Id, false)
+	if msg != "" {
+		return stream.Send(&pb.DownloadAttachmentResponse{
+			Status: status,
+			Error:  msg,
+		})
+	}
+
+	blob, err := s.Storage.Get(stream.Context(), attachment.StorageKey)
+	if err != nil {
+		return stream.Send(&pb.DownloadAttachmentResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		})
+	}
+	defer blob.Close()
+
+	if err := stream.Send(&pb.DownloadAttachmentResponse{
+		Attachment: s.Mapper.FromDomainAttachment(attachment),
+		Status:     http.StatusOK,
+	}); err != nil {
+		return err
+	}
+
+	for {
+		// Sent messages may still be in use after Send returns, so every
+		// chunk gets its own buffer.
+		chunk := make([]byte, attachmentChunk)
+		n, err := io.ReadFull(blob, chunk)
+		if n > 0 {
+			if err := stream.Send(&pb.DownloadAttachmentResponse{Chunk: chunk[:n]}); err != nil {
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) ListAttachments(ctx context.Context, in *pb.ListAttachmentsRequest) (*pb.ListAttachmentsResponse, error) {
+	if status, msg := s.checkItemAccess(in.UserId, in.ItemId, false); msg != "" {
+		return &pb.ListAttachmentsResponse{
+			Status: status,
+			Error:  msg,
+		}, nil
+	}
+
+	attachments, err := s.AttachmentRepo.GetAll(in.ItemId)
+	if err != nil {
+		return &pb.ListAttachmentsResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.ListAttachmentsResponse{
+		Attachments: s.Mapper.FromDomainAttachments(attachments),
+		Status:      http.StatusOK,
+	}, nil
+}
+
+func (s *Server) DeleteAttachment(ctx context.Context, in *pb.DeleteAttachmentRequest) (*pb.DeleteAttachmentResponse, error) {
+	attachment, status, msg := s.findAttachment(in.UserId, in.ItemId, in.Id, true)
+	if msg != "" {
+		return &pb.DeleteAttachmentResponse{
+			Success: false,
+			Status:  status,
+			Error:   msg,
+		}, nil
+	}
+
+	if err := s.Storage.Delete(ctx, attachment.StorageKey); err != nil {
+		return &pb.DeleteAttachmentResponse{
+			Success: false,
+			Status:  http.StatusInternalServerError,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	if err := s.AttachmentRepo.Delete(attachment.Id, activity(in.UserId, domain.ActionDeleted)); err != nil {
+		return &pb.DeleteAttachmentResponse{
+			Success: false,
+			Status:  http.StatusInternalServerError,
+			Error:   err.Error(),
+		}, nil
+	}
+
+	return &pb.DeleteAttachmentResponse{
+		Success: true,
+		Status:  http.StatusOK,
+	}, nil
+}
+
+// findAttachment looks up an attachment of the item after checking the user
+// can read the item or, when changing, edit it. It returns the response
+// status and error message.
+func (s *Server) findAttachment(userId, itemId, attachmentId int64, changing bool) (*domain.Attachment, int64, string) {
+	if status, msg := s.checkItemAccess(userId, itemId, changing); msg != "" {
+		return nil, status, msg
+	}
+
+	attachment, err := s.AttachmentRepo.GetById(attachmentId)
+	if err != nil || attachment.ItemId != itemId {
+		return nil, http.StatusNotFound, errAttachmentFound
+	}
+
+	return attachment, http.StatusOK, ""
+}
+
+// checkItemAccess makes sure the item exists and the user can read it or,
+// when changing, edit it. It returns the response status and error message.
+func (s *Server) checkItemAccess(userId, itemId int64, changing bool) (int64, string) {
+	_, listId, err := s.ItemRepo.GetById(userId, itemId)
+	if err != nil {
+		return http.StatusNotFound, errItemNotFound
+	}
+
+	if changing {
+		return s.checkEditable(userId, listId)
+	}
+
+	if err := s.ListRepo.CheckUserAccessToList(userId, listId, domain.RoleViewer); err != nil {
+		return http.StatusForbidden, accessError(err)
+	}
+
+	return http.StatusOK, ""
+}
+
+// chunkReader reads the chunks of an upload as one stream of bytes.
+type chunkReader[Req interface{ GetChunk() []byte }] struct {
+	stream interface{ Recv() (Req, error) }
+	chunk  []byte
+}
+
+func (r *chunkReader[Req]) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.chunk = req.GetChunk()
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
+
+// chunkWriter sends what is written to it as chunks of an export.
+type chunkWriter struct {
+	stream pb.TodoService_ExportListsServer
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	// Sent messages may still be in use after Send returns, so every chunk
+	// gets its own buffer.
+	if err := w.stream.Send(&pb.ExportListsResponse{Chunk: bytes.Clone(p)}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// cleanFileName drops directories and control characters from a file name
+// sent by a client.
+func cleanFileName(name string) string {
+	if i := strings.LastIndexAny(name, `/\`); i >= 0 {
+		name = name[i+1:]
+	}
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, name)
+
+	return strings.TrimSpace(name)
+}
+
+func validFileName(name string) bool {
+	return name != "" && name != "." && name != ".." && len([]rune(name)) <= maxFileNameLength
+}
+
+// newStorageKey returns a fresh key for a file attached to the item.
+func newStorageKey(itemId int64) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("items/%d/%s", itemId, hex.EncodeToString(b)), nil
+}
+
+func (s *Server) ExportLists(in *pb.ExportListsRequest, stream pb.TodoService_ExportListsServer) error {
+	codec, err := transfer.Lookup(in.Format)
+	if err != nil {
+		return stream.Send(&pb.ExportListsResponse{
+			Status: http.StatusBadRequest,
+			Error:  errUnknownFormat,
+		})
+	}
+
+	for _, listId := range in.ListIds {
+		if err := s.ListRepo.CheckUserAccessToList(in.UserId, listId, domain.RoleViewer); err != nil {
+			return stream.Send(&pb.ExportListsResponse{
+				Status: http.StatusForbidden,
+				Error:  accessError(err),
+			})
+		}
+	}
+
+	lists, err := s.TransferRepo.GetLists(in.UserId, in.ListIds)
+	if err != nil {
+		return stream.Send(&pb.ExportListsResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		})
+	}
+
+	if err := stream.Send(&pb.ExportListsResponse{
+		ContentType: codec.ContentType(),
+		FileName:    "lists." + codec.Extension(),
+		Status:      http.StatusOK,
+	}); err != nil {
+		return err
+	}
+
+	out := bufio.NewWriterSize(&chunkWriter{stream: stream}, attachmentChunk)
+	if err := codec.Encode(out, lists); err != nil {
+		return err
+	}
+	return out.Flush()
+}
+
+func (s *Server) ImportLists(stream pb.TodoService_ImportListsServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	info := first.GetInfo()
+	if info == nil {
+		return stream.SendAndClose(&pb.ImportListsResponse{
+			Status: http.StatusBadRequest,
+			Error:  errImportInfo,
+		})
+	}
+
+	// Items imported into a list are checked like items created in it.
+	var list *domain.TodoList
+	if info.ListId != 0 {
+		if status, msg := s.checkEditable(info.UserId, info.ListId); msg != "" {
+			return stream.SendAndClose(&pb.ImportListsResponse{
+				Status: status,
+				Error:  msg,
+			})
+		}
+
+		if list, err = s.ListRepo.GetById(info.ListId); err != nil {
+			return stream.SendAndClose(&pb.ImportListsResponse{
+				Status: http.StatusNotFound,
+				Error:  errListNotFound,
+			})
+		}
+	}
+
+	var codec transfer.Codec
+	if info.Format != "" {
+		codec, err = transfer.Lookup(info.Format)
+	} else {
+		codec, err = transfer.ForFileName(info.FileName)
+	}
+	if err != nil {
+		return stream.SendAndClose(&pb.ImportListsResponse{
+			Status: http.StatusBadRequest,
+			Error:  errUnknownFormat,
+		})
+	}
+
+	data, err := io.ReadAll(io.LimitReader(&chunkReader[*pb.ImportListsRequest]{stream: stream}, maxImportSize+1))
+	if err != nil {
+		return err
+	}
+	if len(data) > maxImportSize {
+		return stream.SendAndClose(&pb.ImportListsResponse{
+			Status: http.StatusRequestEntityTooLarge,
+			Error:  errImportSize,
+		})
+	}
+
+	lists, err := codec.Decode(bytes.NewReader(data))
+	if err != nil {
+		return stream.SendAndClose(&pb.ImportListsResponse{
+			Status: http.StatusBadRequest,
+			Error:  "Invalid import: " + err.Error(),
+		})
+	}
+
+	imported, msg := prepareImport(lists)
+	if msg != "" {
+		return stream.SendAndClose(&pb.ImportListsResponse{
+			Status: http.StatusBadRequest,
+			Error:  msg,
+		})
+	}
+
+	if list != nil {
+		return s.importItems(stream, info, list, lists)
+	}
+
+	for _, list := range lists {
+		if !validTitle(list.Title) {
+			return stream.SendAndClose(&pb.ImportListsResponse{
+				Status: http.StatusBadRequest,
+				Error:  fmt.Sprintf("List %q: %s", list.Title, errInvalidTitle),
+			})
+		}
+	}
+
+	if info.DryRun {
+		return stream.SendAndClose(&pb.ImportListsResponse{
+			Lists:  imported,
+			DryRun: true,
+			Status: http.StatusOK,
+		})
+	}
+
+	if err := s.TransferRepo.Import(info.UserId, lists, activity(info.UserId, domain.ActionCreated)); err != nil {
+		return stream.SendAndClose(&pb.ImportListsResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		})
+	}
+
+	for i, list := range lists {
+		imported[i].Id = list.Id
+	}
+
+	return stream.SendAndClose(&pb.ImportListsResponse{
+		Lists:  imported,
+		Status: http.StatusCreated,
+	})
+}
+
+// importItems adds the items of all decoded lists to the end of list.
+func (s *Server) importItems(stream pb.TodoService_ImportListsServer, info *pb.ImportInfo, list *domain.TodoList, lists []*domain.TodoList) error {
+	var items []*domain.TodoItem
+	var count int32
+	for _, decoded := range lists {
+		for i := range decoded.Items {
+			items = append(items, &decoded.Items[i])
+		}
+		transfer.Walk(decoded, func(*domain.TodoItem, int) error {
+			count++
+			return nil
+		})
+	}
+	if len(items) == 0 {
+		return stream.SendAndClose(&pb.ImportListsResponse{
+			Status: http.StatusBadRequest,
+			Error:  errImportEmpty,
+		})
+	}
+
+	imported := []*pb.ImportedList{{
+		Id:        list.Id,
+		Title:     list.Title,
+		ItemCount: count,
+	}}
+
+	if info.DryRun {
+		return stream.SendAndClose(&pb.ImportListsResponse{
+			Lists:  imported,
+			DryRun: true,
+			Status: http.StatusOK,
+		})
+	}
+
+	if err := s.TransferRepo.ImportItems(info.UserId, list.Id, items, activity(info.UserId, domain.ActionCreated)); err != nil {
+		return stream.SendAndClose(&pb.ImportListsResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		})
+	}
+
+	return stream.SendAndClose(&pb.ImportListsResponse{
+		Lists:  imported,
+		Status: http.StatusCreated,
+	})
+}
+
+// prepareImport checks the decoded lists the way their items are checked
+// when created one by one, normalizing recurrence rules on the way. It
+// returns what would be created, or why nothing can be.
+func prepareImport(lists []*domain.TodoList) ([]*pb.ImportedList, string) {
+	if len(lists) == 0 {
+		return nil, errImportEmpty
+	}
+
+	imported := make([]*pb.ImportedList, 0, len(lists))
+	total := 0
+	for _, list := range lists {
+		var count int32
+		err := transfer.Walk(list, func(item *domain.TodoItem, depth int) error {
+			count++
+			if depth > domain.MaxItemDepth {
+				return errors.New(errTooDeep)
+			}
+			if !validTitle(item.Title) {
+				return fmt.Errorf("Item %q: %s", item.Title, errInvalidTitle)
+			}
+
+			if item.Recurrence != "" {
+				rule, err := recurrence.Normalize(item.Recurrence, 0, nil)
+				if err != nil {
+					return fmt.Errorf("Item %q: %s", item.Title, recurrenceError(err))
+				}
+				item.Recurrence = rule
+				if item.RecurrenceStart == nil {
+					item.RecurrenceStart = item.DueAt
+				}
+			}
+
+			if msg := validateItem(item); msg != "" {
+				return fmt.Errorf("Item %q: %s", item.Title, msg)
+			}
+
+			for i := range item.Tags {
+				item.Tags[i].Name = strings.TrimSpace(item.Tags[i].Name)
+				if !validTagName(item.Tags[i].Name) {
+					return errors.New(errInvalidTagName)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err.Error()
+		}
+
+		total += int(count)
+		imported = append(imported, &pb.ImportedList{
+			Title:     list.Title,
+			ItemCount: count,
+		})
+	}
+
+	if total > maxImportItems {
+		return nil, errImportItems
+	}
+
+	return imported, ""
+}
+
+func (s *Server) GetCalendarFeed(ctx context.Context, in *pb.GetCalendarFeedRequest) (*pb.GetCalendarFeedResponse, error) {
+	token, err := newToken()
+	if err != nil {
+		return &pb.GetCalendarFeedResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	feed, err := s.CalendarRepo.GetOrCreate(&domain.CalendarFeed{UserId: in.UserId, Token: token})
+	if err != nil {
+		return &pb.GetCalendarFeedResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.GetCalendarFeedResponse{
+		Token:  feed.Token,
+		Status: http.StatusOK,
+	}, nil
+}
+
+func (s *Server) ResetCalendarFeed(ctx context.Context, in *pb.ResetCalendarFeedRequest) (*pb.ResetCalendarFeedResponse, error) {
+	token, err := newToken()
+	if err != nil {
+		return &pb.ResetCalendarFeedResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	feed := &domain.CalendarFeed{UserId: in.UserId, Token: token}
+	if err := s.CalendarRepo.Reset(feed); err != nil {
+		return &pb.ResetCalendarFeedResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.ResetCalendarFeedResponse{
+		Token:  feed.Token,
+		Status: http.StatusOK,
+	}, nil
+}
+
+// GetCalendar renders the items with a due date from all lists of the owner
+// of the feed, completed ones included so that calendar apps can show them
+// as done. Anyone with the token can read the feed, so items only come with
+// the tags of the owner, never those of other members of shared lists.
+func (s *Server) GetCalendar(ctx context.Context, in *pb.GetCalendarRequest) (*pb.GetCalendarResponse, error) {
+	feed, err := s.CalendarRepo.GetByToken(in.Token)
+	if err != nil {
+		if errors.Is(err, repository.ErrCalendarFeedNotFound) {
+			return &pb.GetCalendarResponse{
+				Status: http.StatusNotFound,
+				Error:  errFeedNotFound,
+			}, nil
+		}
+		return &pb.GetCalendarResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	items, err := s.ItemRepo.GetDue(feed.UserId, nil, nil, true)
+	if err != nil {
+		return &pb.GetCalendarResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	list := &domain.TodoList{Title: calendarName, Items: make([]domain.TodoItem, len(items))}
+	for i, item := range items {
+		list.Items[i] = *item
+	}
+
+	var content bytes.Buffer
+	codec := transfer.ICal{}
+	if err := codec.Encode(&content, []*domain.TodoList{list}); err != nil {
+		return &pb.GetCalendarResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.GetCalendarResponse{
+		ContentType: codec.ContentType(),
+		Content:     content.Bytes(),
+		Status:      http.StatusOK,
+	}, nil
+}
+
+func (s *Server) SyncTodoTxt(ctx context.Context, in *pb.SyncTodoTxtRequest) (*pb.SyncTodoTxtResponse, error) {
+	if status, msg := s.checkEditable(in.UserId, in.ListId); msg != "" {
+		return &pb.SyncTodoTxtResponse{
+			Status: status,
+			Error:  msg,
+		}, nil
+	}
+
+	list, err := s.ListRepo.GetById(in.ListId)
+	if err != nil {
+		return &pb.SyncTodoTxtResponse{
+			Status: http.StatusNotFound,
+			Error:  errListNotFound,
+		}, nil
+	}
+
+	if len(in.Content) > maxImportSize {
+		return &pb.SyncTodoTxtResponse{
+			Status: http.StatusRequestEntityTooLarge,
+			Error:  errImportSize,
+		}, nil
+	}
+
+	tasks, err := transfer.ParseTodoTxt(strings.NewReader(in.Content))
+	if err != nil {
+		return &pb.SyncTodoTxtResponse{
+			Status: http.StatusBadRequest,
+			Error:  "Invalid import: " + err.Error(),
+		}, nil
+	}
+	if len(tasks) == 0 {
+		return &pb.SyncTodoTxtResponse{
+			Status: http.StatusBadRequest,
+			Error:  errImportEmpty,
+		}, nil
+	}
+
+	// Lines are checked like imported items, on a list of what the items
+	// become. Only the first line with the id of an item of the list updates
+	// it, other lines add items. Lines of other projects are synced into the
+	// list too, the response names those projects.
+	synced := &domain.TodoList{Items: make([]domain.TodoItem, len(tasks))}
+	wasDone := make(map[int64]bool)
+	lookedUp := make(map[int64]bool)
+	var otherProjects []string
+	for i, task := range tasks {
+		if project := task.Project; project != "" && project != transfer.TodoTxtName(list.Title) && !slices.Contains(otherProjects, project) {
+			otherProjects = append(otherProjects, project)
+		}
+
+		item := task.Item
+		var existing *domain.TodoItem
+		if item.Id != 0 && !lookedUp[item.Id] {
+			lookedUp[item.Id] = true
+			if found, listId, err := s.ItemRepo.GetById(in.UserId, item.Id); err == nil && listId == in.ListId {
+				existing = found
+			}
+		}
+
+		if existing != nil {
+			wasDone[existing.Id] = existing.Done
+			item = mergeTodoTxt(existing, item)
+		} else {
+			item.Id = 0
+		}
+		synced.Items[i] = *item
+	}
+
+	if _, msg := prepareImport([]*domain.TodoList{synced}); msg != "" {
+		return &pb.SyncTodoTxtResponse{
+			Status: http.StatusBadRequest,
+			Error:  msg,
+		}, nil
+	}
+
+	sync := &domain.ItemSync{Next: make(map[int64]*domain.TodoItem)}
+	for i := range synced.Items {
+		item := &synced.Items[i]
+		if item.Id == 0 {
+			sync.Create = append(sync.Create, item)
+			continue
+		}
+
+		sync.Update = append(sync.Update, item)
+		if item.Done && !wasDone[item.Id] && item.Recurrence != "" {
+			next, err := nextOccurrence(item)
+			if err != nil {
+				return &pb.SyncTodoTxtResponse{
+					Status: http.StatusInternalServerError,
+					Error:  err.Error(),
+				}, nil
+			}
+			if next != nil {
+				sync.Next[item.Id] = next
+			}
+		}
+	}
+
+	if in.DryRun {
+		return &pb.SyncTodoTxtResponse{
+			Created:       int32(len(sync.Create)),
+			Updated:       int32(len(sync.Update)),
+			DryRun:        true,
+			Status:        http.StatusOK,
+			OtherProjects: otherProjects,
+		}, nil
+	}
+
+	err = s.TransferRepo.SyncItems(in.UserId, in.ListId, sync, activity(in.UserId, domain.ActionUpdated))
+	if errors.Is(err, repository.ErrVersionConflict) {
+		return &pb.SyncTodoTxtResponse{
+			Status: http.StatusConflict,
+			Error:  errVersionConflict,
+		}, nil
+	}
+	if err != nil {
+		return &pb.SyncTodoTxtResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	lists, err := s.TransferRepo.GetLists(in.UserId, []int64{in.ListId})
+	if err != nil {
+		return &pb.SyncTodoTxtResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	var content strings.Builder
+	if err := (transfer.TodoTxt{}).Encode(&content, lists); err != nil {
+		return &pb.SyncTodoTxtResponse{
+			Status: http.StatusInternalServerError,
+			Error:  err.Error(),
+		}, nil
+	}
+
+	return &pb.SyncTodoTxtResponse{
+		Created:       int32(len(sync.Create)),
+		Updated:       int32(len(sync.Update)),
+		Content:       content.String(),
+		Status:        http.StatusOK,
+		OtherProjects: otherProjects,
+	}, nil
+}
+
+// mergeTodoTxt returns existing changed to what its todo.txt line says.
+// Descriptions, time zones and subtasks are not in lines and are kept, as
+// are times that fall on the dates of the line. Series whose rule changes
+// start over.
+func mergeTodoTxt(existing, line *domain.TodoItem) *domain.TodoItem {
+	if rule, err := recurrence.Normalize(line.Recurrence, 0, nil); line.Recurrence == "" || err != nil || rule != existing.Recurrence {
+		existing.RecurrenceStart = nil
+	}
+
+	existing.Title = line.Title
+	existing.Done = line.Done
+	existing.Priority = line.Priority
+	existing.DueAt = transfer.MergeTodoTxtDate(existing, existing.DueAt, line.DueAt)
+	existing.StartAt = transfer.MergeTodoTxtDate(existing, existing.StartAt, line.StartAt)
+	existing.Recurrence = line.Recurrence
+	existing.Tags = line.Tags
+	existing.Extensions = line.Extensions
+	return existing
+}
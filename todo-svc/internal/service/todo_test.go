@@ -2,15 +2,29 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/broker"
 	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/domain"
 	pb "github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/pb"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/repository"
 	mock_repository "github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/repository/mocks"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/storage"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/pkg/pagination"
 	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/pkg/utils"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestServer_CreateTodoList(t *testing.T) {
@@ -37,7 +51,7 @@ func TestServer_CreateTodoList(t *testing.T) {
 				Title:  "My Todo List",
 			},
 			mockRepoSetup: func() {
-				listRepo.EXPECT().Create(int64(1), gomock.Any()).Return(nil)
+				listRepo.EXPECT().Create(int64(1), gomock.Any(), gomock.Any()).Return(nil)
 			},
 			expectedStatus: http.StatusCreated,
 			expectedError:  "",
@@ -49,11 +63,21 @@ func TestServer_CreateTodoList(t *testing.T) {
 				Title:  "My Todo List",
 			},
 			mockRepoSetup: func() {
-				listRepo.EXPECT().Create(int64(1), gomock.Any()).Return(errors.New("error creating list"))
+				listRepo.EXPECT().Create(int64(1), gomock.Any(), gomock.Any()).Return(errors.New("error creating list"))
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedError:  "error creating list",
 		},
+		{
+			name: "Empty title",
+			in: &pb.CreateTodoListRequest{
+				UserId: 1,
+				Title:  "  ",
+			},
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  errInvalidTitle,
+		},
 	}
 
 	for _, tt := range tests {
@@ -77,6 +101,137 @@ func TestServer_CreateTodoList(t *testing.T) {
 	}
 }
 
+func TestServer_idempotent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	keyRepo := mock_repository.NewMockIdempotency(ctrl)
+
+	serv := &Server{
+		ListRepo:        listRepo,
+		IdempotencyRepo: keyRepo,
+		IdempotencyTTL:  time.Hour,
+	}
+
+	in := &pb.CreateTodoListRequest{UserId: 1, Title: "My Todo List"}
+	hash, err := requestHash(in)
+	assert.NoError(t, err)
+	first, err := proto.Marshal(&pb.CreateTodoListResponse{List: &pb.TodoList{Id: 7, Title: "My Todo List"}, Status: http.StatusCreated})
+	assert.NoError(t, err)
+
+	withKey := metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotencyKey, "retry-me"))
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		mockRepoSetup  func()
+		expectedStatus int64
+		expectedError  string
+		expectedListId int64
+	}{
+		{
+			name: "Without key",
+			ctx:  context.Background(),
+			mockRepoSetup: func() {
+				listRepo.EXPECT().Create(int64(1), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name: "First request",
+			ctx:  withKey,
+			mockRepoSetup: func() {
+				keyRepo.EXPECT().Claim(&domain.IdempotencyKey{UserId: 1, Key: "retry-me", RequestHash: hash}, gomock.Any(), gomock.Any()).Return(nil, nil)
+				listRepo.EXPECT().Create(int64(1), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ int64, list *domain.TodoList, _ *domain.Activity) error {
+						list.Id = 7
+						return nil
+					})
+				keyRepo.EXPECT().Complete(&domain.IdempotencyKey{UserId: 1, Key: "retry-me", RequestHash: hash, Response: first}).Return(nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedListId: 7,
+		},
+		{
+			name: "Retry",
+			ctx:  withKey,
+			mockRepoSetup: func() {
+				keyRepo.EXPECT().Claim(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&domain.IdempotencyKey{UserId: 1, Key: "retry-me", RequestHash: hash, Response: first}, repository.ErrIdempotencyKeyUsed)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedListId: 7,
+		},
+		{
+			name: "Key used for another request",
+			ctx:  withKey,
+			mockRepoSetup: func() {
+				keyRepo.EXPECT().Claim(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&domain.IdempotencyKey{UserId: 1, Key: "retry-me", RequestHash: "other", Response: first}, repository.ErrIdempotencyKeyUsed)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedError:  errKeyReused,
+		},
+		{
+			name: "First request still handled",
+			ctx:  withKey,
+			mockRepoSetup: func() {
+				keyRepo.EXPECT().Claim(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&domain.IdempotencyKey{UserId: 1, Key: "retry-me", RequestHash: hash}, repository.ErrIdempotencyKeyUsed)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedError:  errKeyPending,
+		},
+		{
+			name: "Failed request releases key",
+			ctx:  withKey,
+			mockRepoSetup: func() {
+				keyRepo.EXPECT().Claim(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				listRepo.EXPECT().Create(int64(1), gomock.Any(), gomock.Any()).Return(errors.New("error creating list"))
+				keyRepo.EXPECT().Release(&domain.IdempotencyKey{UserId: 1, Key: "retry-me", RequestHash: hash}).Return(nil)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "error creating list",
+		},
+		{
+			name: "Failed completion releases key",
+			ctx:  withKey,
+			mockRepoSetup: func() {
+				keyRepo.EXPECT().Claim(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				listRepo.EXPECT().Create(int64(1), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ int64, list *domain.TodoList, _ *domain.Activity) error {
+						list.Id = 7
+						return nil
+					})
+				keyRepo.EXPECT().Complete(gomock.Any()).Return(errors.New("error storing response"))
+				keyRepo.EXPECT().Release(&domain.IdempotencyKey{UserId: 1, Key: "retry-me", RequestHash: hash, Response: first}).Return(nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedListId: 7,
+		},
+		{
+			name:           "Key too long",
+			ctx:            metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotencyKey, strings.Repeat("k", 256))),
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  errIdempotencyKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.CreateTodoList(tt.ctx, in)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, resp.Status)
+			assert.Equal(t, tt.expectedError, resp.Error)
+			if tt.expectedListId != 0 {
+				assert.Equal(t, tt.expectedListId, resp.List.Id)
+			}
+		})
+	}
+}
 func TestServer_GetTodoListById(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -101,7 +256,7 @@ func TestServer_GetTodoListById(t *testing.T) {
 				UserId: 1,
 			},
 			mockRepoSetup: func() {
-				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1)).Return(nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleViewer).Return(nil)
 				listRepo.EXPECT().GetById(int64(1)).Return(&domain.TodoList{Id: 1, Title: "My Todo List"}, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -147,6 +302,8 @@ func TestServer_GetTodoLists(t *testing.T) {
 		Mapper:   utils.NewMapper(),
 	}
 
+	archivedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
 	tests := []struct {
 		name           string
 		in             *pb.GetTodoListsRequest
@@ -154,6 +311,8 @@ func TestServer_GetTodoLists(t *testing.T) {
 		expectedStatus int
 		expectedError  string
 		expectedLists  []*pb.TodoList
+		expectedToken  string
+		expectedTotal  int64
 	}{
 		{
 			name: "Success",
@@ -161,10 +320,10 @@ func TestServer_GetTodoLists(t *testing.T) {
 				UserId: 1,
 			},
 			mockRepoSetup: func() {
-				listRepo.EXPECT().GetAll(int64(1)).Return([]*domain.TodoList{
+				listRepo.EXPECT().GetAll(int64(1), domain.ListFilter{}, domain.ListSortCreated, defaultPageSize+1, nil).Return([]*domain.TodoList{
 					{Id: 1, Title: "My First Todo"},
 					{Id: 2, Title: "My Second Todo"},
-				}, nil)
+				}, int64(2), nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedError:  "",
@@ -172,6 +331,7 @@ func TestServer_GetTodoLists(t *testing.T) {
 				{Id: 1, Title: "My First Todo"},
 				{Id: 2, Title: "My Second Todo"},
 			},
+			expectedTotal: 2,
 		},
 		{
 			name: "Not found",
@@ -179,11 +339,127 @@ func TestServer_GetTodoLists(t *testing.T) {
 				UserId: 1,
 			},
 			mockRepoSetup: func() {
-				listRepo.EXPECT().GetAll(int64(1)).Return(nil, errors.New("List not found"))
+				listRepo.EXPECT().GetAll(int64(1), domain.ListFilter{}, domain.ListSortCreated, defaultPageSize+1, nil).Return(nil, int64(0), errors.New("List not found"))
 			},
 			expectedStatus: http.StatusNotFound,
 			expectedError:  "List not found",
 		},
+		{
+			name: "First page",
+			in: &pb.GetTodoListsRequest{
+				UserId:   1,
+				PageSize: 1,
+				Title:    " todo ",
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().GetAll(int64(1), domain.ListFilter{Title: "todo"}, domain.ListSortCreated, 2, nil).Return([]*domain.TodoList{
+					{Id: 1, Title: "My First Todo"},
+					{Id: 2, Title: "My Second Todo"},
+				}, int64(3), nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedLists: []*pb.TodoList{
+				{Id: 1, Title: "My First Todo"},
+			},
+			expectedToken: pageToken(domain.ListCursor{Id: 1}),
+			expectedTotal: 3,
+		},
+		{
+			name: "Next page",
+			in: &pb.GetTodoListsRequest{
+				UserId:    1,
+				PageSize:  1,
+				PageToken: pageToken(domain.ListCursor{Id: 1}),
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().GetAll(int64(1), domain.ListFilter{}, domain.ListSortCreated, 2, &domain.ListCursor{Id: 1}).Return([]*domain.TodoList{
+					{Id: 2, Title: "My Second Todo"},
+				}, int64(2), nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedLists: []*pb.TodoList{
+				{Id: 2, Title: "My Second Todo"},
+			},
+			expectedTotal: 2,
+		},
+		{
+			name: "Including archived lists",
+			in: &pb.GetTodoListsRequest{
+				UserId:          1,
+				IncludeArchived: true,
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().GetAll(int64(1), domain.ListFilter{IncludeArchived: true}, domain.ListSortCreated, defaultPageSize+1, nil).Return([]*domain.TodoList{
+					{Id: 1, Title: "My First Todo", ArchivedAt: &archivedAt},
+				}, int64(1), nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedLists: []*pb.TodoList{
+				{Id: 1, Title: "My First Todo", ArchivedAt: timestamppb.New(archivedAt)},
+			},
+			expectedTotal: 1,
+		},
+		{
+			name: "Sorted manually",
+			in: &pb.GetTodoListsRequest{
+				UserId:   1,
+				PageSize: 1,
+				Sort:     pb.ListSort_LIST_SORT_MANUAL,
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().GetAll(int64(1), domain.ListFilter{}, domain.ListSortManual, 2, nil).Return([]*domain.TodoList{
+					{Id: 2, Title: "My Second Todo", Position: "a0"},
+					{Id: 1, Title: "My First Todo", Position: "a1"},
+				}, int64(2), nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedLists: []*pb.TodoList{
+				{Id: 2, Title: "My Second Todo", Position: "a0"},
+			},
+			expectedToken: pageToken(domain.ListCursor{Sort: domain.ListSortManual, Id: 2, Position: "a0"}),
+			expectedTotal: 2,
+		},
+		{
+			name: "Page token of another sort",
+			in: &pb.GetTodoListsRequest{
+				UserId:    1,
+				PageToken: pageToken(domain.ListCursor{Id: 1}),
+				Sort:      pb.ListSort_LIST_SORT_MANUAL,
+			},
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Invalid page token",
+		},
+		{
+			name: "Unknown sort",
+			in: &pb.GetTodoListsRequest{
+				UserId: 1,
+				Sort:   pb.ListSort(7),
+			},
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  errInvalidSort,
+		},
+		{
+			name: "Invalid page token",
+			in: &pb.GetTodoListsRequest{
+				UserId:    1,
+				PageToken: "not a token",
+			},
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Invalid page token",
+		},
+		{
+			name: "Negative page size",
+			in: &pb.GetTodoListsRequest{
+				UserId:   1,
+				PageSize: -1,
+			},
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Page size must not be negative",
+		},
 	}
 
 	for _, tt := range tests {
@@ -197,11 +473,18 @@ func TestServer_GetTodoLists(t *testing.T) {
 				assert.Equal(t, tt.expectedError, resp.Error)
 			} else {
 				assert.ElementsMatch(t, tt.expectedLists, resp.Lists)
+				assert.Equal(t, tt.expectedToken, resp.NextPageToken)
+				assert.Equal(t, tt.expectedTotal, resp.TotalCount)
 			}
 		})
 	}
 }
 
+func pageToken(cursor any) string {
+	token, _ := pagination.Encode(cursor)
+	return token
+}
+
 func TestServer_UpdateTodoList(t *testing.T) {
 
 	ctrl := gomock.NewController(t)
@@ -228,8 +511,8 @@ func TestServer_UpdateTodoList(t *testing.T) {
 				Title:  "My Updated Todo",
 			},
 			mockRepoSetup: func() {
-				listRepo.EXPECT().Update(int64(1), &domain.TodoList{Title: "My Updated Todo"}).Return(&domain.TodoList{Id: 1, Title: "My Updated Todo"}, nil)
-				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1)).Return(nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleOwner).Return(nil)
+				listRepo.EXPECT().Update(int64(1), &domain.TodoList{Title: "My Updated Todo"}, gomock.Any()).Return(&domain.TodoList{Id: 1, Title: "My Updated Todo"}, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedError:  "",
@@ -242,11 +525,51 @@ func TestServer_UpdateTodoList(t *testing.T) {
 				Title:  "My Updated Todo",
 			},
 			mockRepoSetup: func() {
-				listRepo.EXPECT().Update(int64(1), &domain.TodoList{Title: "My Updated Todo"}).Return(nil, errors.New("List not found"))
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleOwner).Return(nil)
+				listRepo.EXPECT().Update(int64(1), &domain.TodoList{Title: "My Updated Todo"}, gomock.Any()).Return(nil, errors.New("List not found"))
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedError:  "List not found",
 		},
+		{
+			name: "Editor cannot rename",
+			in: &pb.UpdateTodoListRequest{
+				Id:     1,
+				UserId: 2,
+				Title:  "My Updated Todo",
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(2), int64(1), domain.RoleOwner).Return(repository.ErrInsufficientRole)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  "User role does not allow this action",
+		},
+		{
+			name: "Stale version",
+			in: &pb.UpdateTodoListRequest{
+				Id:      1,
+				UserId:  1,
+				Title:   "My Updated Todo",
+				Version: 3,
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleOwner).Return(nil)
+				listRepo.EXPECT().Update(int64(1), &domain.TodoList{Title: "My Updated Todo", Version: 3}, gomock.Any()).Return(nil, repository.ErrVersionConflict)
+			},
+			expectedStatus: http.StatusPreconditionFailed,
+			expectedError:  errStaleVersion,
+		},
+		{
+			name: "Unknown mask path",
+			in: &pb.UpdateTodoListRequest{
+				Id:         1,
+				UserId:     1,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"archived_at"}},
+			},
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  errUpdateMask,
+		},
 	}
 
 	for _, tt := range tests {
@@ -287,7 +610,8 @@ func TestServer_DeleteTodoList(t *testing.T) {
 				UserId: 1,
 			},
 			mockRepoSetup: func() {
-				listRepo.EXPECT().Delete(int64(1)).Return(nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleOwner).Return(nil)
+				listRepo.EXPECT().Delete(int64(1), int64(0), gomock.Any()).Return(nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedError:  "",
@@ -299,11 +623,38 @@ func TestServer_DeleteTodoList(t *testing.T) {
 				UserId: 1,
 			},
 			mockRepoSetup: func() {
-				listRepo.EXPECT().Delete(int64(1)).Return(errors.New("List not found"))
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleOwner).Return(nil)
+				listRepo.EXPECT().Delete(int64(1), int64(0), gomock.Any()).Return(errors.New("List not found"))
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedError:  "List not found",
 		},
+		{
+			name: "Stale version",
+			in: &pb.DeleteTodoListRequest{
+				Id:      1,
+				UserId:  1,
+				Version: 3,
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleOwner).Return(nil)
+				listRepo.EXPECT().Delete(int64(1), int64(3), gomock.Any()).Return(repository.ErrVersionConflict)
+			},
+			expectedStatus: http.StatusPreconditionFailed,
+			expectedError:  errStaleVersion,
+		},
+		{
+			name: "Not a member",
+			in: &pb.DeleteTodoListRequest{
+				Id:     1,
+				UserId: 2,
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(2), int64(1), domain.RoleOwner).Return(repository.ErrUsersListNotFound)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  "User does not have access to this list",
+		},
 	}
 
 	for _, tt := range tests {
@@ -320,409 +671,498 @@ func TestServer_DeleteTodoList(t *testing.T) {
 	}
 }
 
-func TestServer_CreateTodoItem(t *testing.T) {
+func TestServer_ArchiveTodoList(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	itemRepo := mock_repository.NewMockTodoItem(ctrl)
 	listRepo := mock_repository.NewMockTodoList(ctrl)
 	serv := &Server{
-		ItemRepo: itemRepo,
 		ListRepo: listRepo,
 	}
 
 	tests := []struct {
 		name           string
-		in             *pb.CreateTodoItemRequest
+		in             *pb.ArchiveTodoListRequest
 		mockRepoSetup  func()
 		expectedStatus int
 		expectedError  string
 	}{
 		{
 			name: "Success",
-			in: &pb.CreateTodoItemRequest{
-				UserId:      1,
-				ListId:      1,
-				Title:       "My Todo Item",
-				Description: "My Todo Item",
-			},
+			in:   &pb.ArchiveTodoListRequest{UserId: 1, Id: 1},
 			mockRepoSetup: func() {
-				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1)).Return(nil)
-				itemRepo.EXPECT().Create(&domain.TodoItem{Title: "My Todo Item", Description: "My Todo Item", ListId: 1}).
-					Return(nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleOwner).Return(nil)
+				listRepo.EXPECT().Archive(int64(1), &domain.Activity{ActorId: 1, Action: domain.ActionArchived}).Return(nil)
 			},
 			expectedStatus: http.StatusOK,
-			expectedError:  "",
 		},
 		{
-			name: "Not created",
-			in: &pb.CreateTodoItemRequest{
-				UserId:      1,
-				ListId:      1,
-				Title:       "My Todo Item",
-				Description: "My Todo Item",
+			name: "Editor",
+			in:   &pb.ArchiveTodoListRequest{UserId: 2, Id: 1},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(2), int64(1), domain.RoleOwner).Return(repository.ErrInsufficientRole)
 			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  errRole,
+		},
+		{
+			name: "Database error",
+			in:   &pb.ArchiveTodoListRequest{UserId: 1, Id: 1},
 			mockRepoSetup: func() {
-				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1)).Return(nil)
-				itemRepo.EXPECT().Create(&domain.TodoItem{Title: "My Todo Item", Description: "My Todo Item", ListId: 1}).
-					Return(errors.New("Item not created"))
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleOwner).Return(nil)
+				listRepo.EXPECT().Archive(int64(1), gomock.Any()).Return(errors.New("db error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
-			expectedError:  "Item not created",
+			expectedError:  "db error",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockRepoSetup()
-			resp, err := serv.CreateTodoItem(context.Background(), tt.in)
+			resp, err := serv.ArchiveTodoList(context.Background(), tt.in)
 			assert.NoError(t, err)
-			assert.NotNil(t, resp)
 			assert.Equal(t, tt.expectedStatus, int(resp.Status))
-			if tt.expectedError != "" {
-				assert.Equal(t, tt.expectedError, resp.Error)
-			}
+			assert.Equal(t, tt.expectedError, resp.Error)
+			assert.Equal(t, tt.expectedError == "", resp.Success)
 		})
 	}
 }
 
-func TestServer_GetTodoItemById(t *testing.T) {
+func TestServer_UnarchiveTodoList(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	itemRepo := mock_repository.NewMockTodoItem(ctrl)
 	listRepo := mock_repository.NewMockTodoList(ctrl)
 	serv := &Server{
-		ItemRepo: itemRepo,
 		ListRepo: listRepo,
 	}
 
 	tests := []struct {
 		name           string
-		in             *pb.GetTodoItemRequest
+		in             *pb.UnarchiveTodoListRequest
 		mockRepoSetup  func()
 		expectedStatus int
 		expectedError  string
 	}{
 		{
 			name: "Success",
-			in: &pb.GetTodoItemRequest{
-				UserId: 1,
-				Id:     1,
-			},
+			in:   &pb.UnarchiveTodoListRequest{UserId: 1, Id: 1},
 			mockRepoSetup: func() {
-				itemRepo.EXPECT().GetById(int64(1)).
-					Return(
-						&domain.TodoItem{Id: 1, Title: "My Todo Item", Description: "My Todo Item", ListId: 1},
-						int64(1),
-						nil,
-					)
-				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1)).Return(nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleOwner).Return(nil)
+				listRepo.EXPECT().Unarchive(int64(1), &domain.Activity{ActorId: 1, Action: domain.ActionUnarchived}).Return(nil)
 			},
 			expectedStatus: http.StatusOK,
-			expectedError:  "",
 		},
 		{
-			name: "Not found",
-			in: &pb.GetTodoItemRequest{
-				UserId: 1,
-				Id:     1,
-			},
+			name: "Not a member",
+			in:   &pb.UnarchiveTodoListRequest{UserId: 2, Id: 1},
 			mockRepoSetup: func() {
-				itemRepo.EXPECT().GetById(int64(1)).
-					Return(
-						nil,
-						int64(0),
-						errors.New("Item not found"),
-					)
+				listRepo.EXPECT().CheckUserAccessToList(int64(2), int64(1), domain.RoleOwner).Return(repository.ErrUsersListNotFound)
 			},
-			expectedStatus: http.StatusNotFound,
-			expectedError:  "Item not found",
+			expectedStatus: http.StatusForbidden,
+			expectedError:  errForbidden,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockRepoSetup()
-			resp, err := serv.GetTodoItemById(context.Background(), tt.in)
+			resp, err := serv.UnarchiveTodoList(context.Background(), tt.in)
 			assert.NoError(t, err)
-			assert.NotNil(t, resp)
 			assert.Equal(t, tt.expectedStatus, int(resp.Status))
-			if tt.expectedError != "" {
-				assert.Equal(t, tt.expectedError, resp.Error)
-			}
+			assert.Equal(t, tt.expectedError, resp.Error)
+			assert.Equal(t, tt.expectedError == "", resp.Success)
 		})
 	}
 }
 
-func TestServer_GetTodoItems(t *testing.T) {
+func TestServer_MoveTodoList(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	itemRepo := mock_repository.NewMockTodoItem(ctrl)
 	listRepo := mock_repository.NewMockTodoList(ctrl)
 	serv := &Server{
-		ItemRepo: itemRepo,
 		ListRepo: listRepo,
-		Mapper:   utils.NewMapper(),
 	}
 
 	tests := []struct {
 		name           string
-		in             *pb.GetTodoItemsRequest
+		in             *pb.MoveTodoListRequest
 		mockRepoSetup  func()
 		expectedStatus int
 		expectedError  string
 	}{
 		{
 			name: "Success",
-			in: &pb.GetTodoItemsRequest{
-				UserId: 1,
-				ListId: 1,
-			},
+			in:   &pb.MoveTodoListRequest{UserId: 1, Id: 1, BeforeId: 2},
 			mockRepoSetup: func() {
-				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1)).Return(nil)
-				itemRepo.EXPECT().GetAll(int64(1)).
-					Return(
-						[]*domain.TodoItem{{Id: 1, Title: "My Todo Item", Description: "My Todo Item", ListId: 1},
-							{Id: 2, Title: "My Todo Item 2", Description: "My Todo Item 2", ListId: 1}},
-						nil,
-					)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleViewer).Return(nil)
+				listRepo.EXPECT().Move(int64(1), int64(1), int64(0), int64(2)).Return(nil)
 			},
 			expectedStatus: http.StatusOK,
-			expectedError:  "",
 		},
 		{
-			name: "Not found",
-			in: &pb.GetTodoItemsRequest{
-				UserId: 1,
-				ListId: 1,
+			name: "Not a member",
+			in:   &pb.MoveTodoListRequest{UserId: 2, Id: 1},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(2), int64(1), domain.RoleViewer).Return(repository.ErrUsersListNotFound)
 			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  errForbidden,
+		},
+		{
+			name: "Neighbour of another user",
+			in:   &pb.MoveTodoListRequest{UserId: 1, Id: 1, AfterId: 5},
 			mockRepoSetup: func() {
-				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1)).Return(nil)
-				itemRepo.EXPECT().GetAll(int64(1)).
-					Return(
-						[]*domain.TodoItem{},
-						errors.New("Item not found"),
-					)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleViewer).Return(nil)
+				listRepo.EXPECT().Move(int64(1), int64(1), int64(5), int64(0)).Return(repository.ErrNeighbourNotFound)
 			},
-			expectedStatus: http.StatusInternalServerError,
-			expectedError:  "Item not found",
+			expectedStatus: http.StatusNotFound,
+			expectedError:  errNeighbour,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockRepoSetup()
-			resp, err := serv.GetTodoItems(context.Background(), tt.in)
+			resp, err := serv.MoveTodoList(context.Background(), tt.in)
 			assert.NoError(t, err)
-			assert.NotNil(t, resp)
 			assert.Equal(t, tt.expectedStatus, int(resp.Status))
-			if tt.expectedError != "" {
-				assert.Equal(t, tt.expectedError, resp.Error)
-			}
+			assert.Equal(t, tt.expectedError, resp.Error)
+			assert.Equal(t, tt.expectedError == "", resp.Success)
 		})
 	}
 }
 
-func TestServer_UpdateTodoItem(t *testing.T) {
+func TestServer_ListTrash(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	itemRepo := mock_repository.NewMockTodoItem(ctrl)
-	listRepo := mock_repository.NewMockTodoList(ctrl)
+	trashRepo := mock_repository.NewMockTrash(ctrl)
 	serv := &Server{
-		ItemRepo: itemRepo,
-		ListRepo: listRepo,
+		TrashRepo: trashRepo,
+		Mapper:    utils.NewMapper(),
 	}
 
+	deletedAt := gorm.DeletedAt{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true}
+
 	tests := []struct {
 		name           string
-		in             *pb.UpdateTodoItemRequest
+		in             *pb.ListTrashRequest
 		mockRepoSetup  func()
 		expectedStatus int
 		expectedError  string
+		expectedLists  int
+		expectedItems  int
 	}{
 		{
 			name: "Success",
-			in: &pb.UpdateTodoItemRequest{
-				UserId:      1,
-				Id:          1,
-				Title:       "My Todo Item",
-				Completed:   true,
-				Description: "My Todo Item",
+			in:   &pb.ListTrashRequest{UserId: 1},
+			mockRepoSetup: func() {
+				trashRepo.EXPECT().GetLists(int64(1)).Return([]*domain.TodoList{{Id: 1, Title: "List", DeletedAt: deletedAt}}, nil)
+				trashRepo.EXPECT().GetItems(int64(1)).Return([]*domain.TodoItem{
+					{Id: 1, Title: "Item 1", ListId: 2, DeletedAt: deletedAt},
+					{Id: 2, Title: "Item 2", ListId: 2, DeletedAt: deletedAt},
+				}, nil)
 			},
+			expectedStatus: http.StatusOK,
+			expectedLists:  1,
+			expectedItems:  2,
+		},
+		{
+			name: "Database error",
+			in:   &pb.ListTrashRequest{UserId: 1},
 			mockRepoSetup: func() {
-				itemRepo.EXPECT().GetById(int64(1)).
-					Return(
-						&domain.TodoItem{Id: 1, Title: "My Todo Item", Description: "My Todo Item", ListId: 1},
-						int64(1),
-						nil,
-					)
-				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1)).Return(nil)
-				itemRepo.EXPECT().Update(&domain.TodoItem{Id: 1, Title: "My Todo Item", Description: "My Todo Item", ListId: 1, Done: true}).
-					Return(nil)
+				trashRepo.EXPECT().GetLists(int64(1)).Return(nil, errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "db error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.ListTrash(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			assert.Equal(t, tt.expectedError, resp.Error)
+			assert.Len(t, resp.Lists, tt.expectedLists)
+			assert.Len(t, resp.Items, tt.expectedItems)
+			for _, list := range resp.Lists {
+				assert.Equal(t, deletedAt.Time, list.DeletedAt.AsTime())
+			}
+		})
+	}
+}
+
+func TestServer_RestoreTodoList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	trashRepo := mock_repository.NewMockTrash(ctrl)
+	serv := &Server{
+		TrashRepo: trashRepo,
+	}
+
+	tests := []struct {
+		name           string
+		in             *pb.RestoreTodoListRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name: "Success",
+			in:   &pb.RestoreTodoListRequest{UserId: 1, Id: 1},
+			mockRepoSetup: func() {
+				trashRepo.EXPECT().RestoreList(int64(1), int64(1), gomock.Any()).Return(nil)
 			},
 			expectedStatus: http.StatusOK,
-			expectedError:  "",
 		},
 		{
-			name: "Not found",
-			in: &pb.UpdateTodoItemRequest{
-				UserId:      1,
-				Id:          1,
-				Title:       "My Todo Item",
-				Completed:   true,
-				Description: "My Todo Item",
+			name: "Not in the trash",
+			in:   &pb.RestoreTodoListRequest{UserId: 1, Id: 1},
+			mockRepoSetup: func() {
+				trashRepo.EXPECT().RestoreList(int64(1), int64(1), gomock.Any()).Return(repository.ErrTodoListNotFound)
 			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  errListNotFound,
+		},
+		{
+			name: "Not the owner",
+			in:   &pb.RestoreTodoListRequest{UserId: 2, Id: 1},
 			mockRepoSetup: func() {
-				itemRepo.EXPECT().GetById(int64(1)).
-					Return(
-						nil,
-						int64(0),
-						errors.New("Item not found"),
-					)
+				trashRepo.EXPECT().RestoreList(int64(2), int64(1), gomock.Any()).Return(repository.ErrTodoListNotFound)
 			},
 			expectedStatus: http.StatusNotFound,
-			expectedError:  "Item not found",
+			expectedError:  errListNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.RestoreTodoList(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			assert.Equal(t, tt.expectedError, resp.Error)
+			assert.Equal(t, tt.expectedError == "", resp.Success)
+		})
+	}
+}
+
+func TestServer_RestoreTodoItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	trashRepo := mock_repository.NewMockTrash(ctrl)
+	serv := &Server{
+		ListRepo:  listRepo,
+		TrashRepo: trashRepo,
+	}
+
+	item := &domain.TodoItem{Id: 1, ListId: 2}
+
+	tests := []struct {
+		name           string
+		in             *pb.RestoreTodoItemRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name: "Success",
+			in:   &pb.RestoreTodoItemRequest{UserId: 1, Id: 1},
+			mockRepoSetup: func() {
+				trashRepo.EXPECT().GetItem(int64(1)).Return(item, nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(2)).Return(nil)
+				trashRepo.EXPECT().RestoreItem(item, gomock.Any()).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
 		},
 		{
-			name: "error updating item",
-			in: &pb.UpdateTodoItemRequest{
-				UserId:      1,
-				Id:          1,
-				Title:       "My Todo Item",
-				Completed:   true,
-				Description: "My Todo Item",
+			name: "Not in the trash",
+			in:   &pb.RestoreTodoItemRequest{UserId: 1, Id: 1},
+			mockRepoSetup: func() {
+				trashRepo.EXPECT().GetItem(int64(1)).Return(nil, repository.ErrTodoItemNotFound)
 			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  errItemNotFound,
+		},
+		{
+			name: "Viewer",
+			in:   &pb.RestoreTodoItemRequest{UserId: 3, Id: 1},
 			mockRepoSetup: func() {
-				itemRepo.EXPECT().GetById(int64(1)).
-					Return(
-						&domain.TodoItem{Id: 1, Title: "My Todo Item", Description: "My Todo Item", ListId: 1},
-						int64(1),
-						nil,
-					)
-				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1)).Return(nil)
-				itemRepo.EXPECT().Update(&domain.TodoItem{Id: 1, Title: "My Todo Item", Description: "My Todo Item", ListId: 1, Done: true}).
-					Return(errors.New("error updating item"))
+				trashRepo.EXPECT().GetItem(int64(1)).Return(item, nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(3), int64(2), domain.RoleEditor).Return(repository.ErrInsufficientRole)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  errRole,
+		},
+		{
+			name: "List in the trash",
+			in:   &pb.RestoreTodoItemRequest{UserId: 1, Id: 1},
+			mockRepoSetup: func() {
+				trashRepo.EXPECT().GetItem(int64(1)).Return(item, nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleEditor).Return(repository.ErrTodoListNotFound)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedError:  errListTrashed,
+		},
+		{
+			name: "List trashed meanwhile",
+			in:   &pb.RestoreTodoItemRequest{UserId: 1, Id: 1},
+			mockRepoSetup: func() {
+				trashRepo.EXPECT().GetItem(int64(1)).Return(item, nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(2)).Return(nil)
+				trashRepo.EXPECT().RestoreItem(item, gomock.Any()).Return(repository.ErrListTrashed)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedError:  errListTrashed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.RestoreTodoItem(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			assert.Equal(t, tt.expectedError, resp.Error)
+			assert.Equal(t, tt.expectedError == "", resp.Success)
+		})
+	}
+}
+
+func TestServer_EmptyTrash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	trashRepo := mock_repository.NewMockTrash(ctrl)
+	serv := &Server{
+		TrashRepo: trashRepo,
+	}
+
+	tests := []struct {
+		name           string
+		in             *pb.EmptyTrashRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name: "Success",
+			in:   &pb.EmptyTrashRequest{UserId: 1},
+			mockRepoSetup: func() {
+				trashRepo.EXPECT().Empty(int64(1), gomock.Any()).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Database error",
+			in:   &pb.EmptyTrashRequest{UserId: 1},
+			mockRepoSetup: func() {
+				trashRepo.EXPECT().Empty(int64(1), gomock.Any()).Return(errors.New("db error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
-			expectedError:  "error updating item",
+			expectedError:  "db error",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockRepoSetup()
-			resp, err := serv.UpdateTodoItem(context.Background(), tt.in)
+			resp, err := serv.EmptyTrash(context.Background(), tt.in)
 			assert.NoError(t, err)
-			assert.NotNil(t, resp)
 			assert.Equal(t, tt.expectedStatus, int(resp.Status))
-			if tt.expectedError != "" {
-				assert.Equal(t, tt.expectedError, resp.Error)
-			}
+			assert.Equal(t, tt.expectedError, resp.Error)
+			assert.Equal(t, tt.expectedError == "", resp.Success)
 		})
 	}
 }
 
-func TestServer_DeleteTodoItem(t *testing.T) {
+func TestServer_ShareList(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	itemRepo := mock_repository.NewMockTodoItem(ctrl)
 	listRepo := mock_repository.NewMockTodoList(ctrl)
 	serv := &Server{
-		ItemRepo: itemRepo,
 		ListRepo: listRepo,
 		Mapper:   utils.NewMapper(),
 	}
 
 	tests := []struct {
 		name           string
-		in             *pb.DeleteTodoItemRequest
+		in             *pb.ShareListRequest
 		mockRepoSetup  func()
 		expectedStatus int
 		expectedError  string
 	}{
 		{
 			name: "Success",
-			in: &pb.DeleteTodoItemRequest{
-				UserId: 1,
-				Id:     1,
+			in: &pb.ShareListRequest{
+				UserId:   1,
+				ListId:   1,
+				MemberId: 2,
+				Role:     pb.ListRole_LIST_ROLE_EDITOR,
 			},
 			mockRepoSetup: func() {
-				itemRepo.EXPECT().GetById(int64(1)).
-					Return(
-						&domain.TodoItem{Id: 1, Title: "My Todo Item", Description: "My Todo Item", ListId: 1},
-						int64(1),
-						nil,
-					)
-				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1)).Return(nil)
-				itemRepo.EXPECT().Delete(int64(1)).Return(nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleOwner).Return(nil)
+				listRepo.EXPECT().AddMember(&domain.UsersList{UserId: 2, ListId: 1, Role: domain.RoleEditor}, gomock.Any()).Return(nil)
 			},
-			expectedStatus: http.StatusOK,
-			expectedError:  "",
+			expectedStatus: http.StatusCreated,
 		},
 		{
-			name: "Not found",
-			in: &pb.DeleteTodoItemRequest{
-				UserId: 1,
-				Id:     1,
+			name: "Editor cannot share",
+			in: &pb.ShareListRequest{
+				UserId:   2,
+				ListId:   1,
+				MemberId: 3,
+				Role:     pb.ListRole_LIST_ROLE_VIEWER,
 			},
 			mockRepoSetup: func() {
-				itemRepo.EXPECT().GetById(int64(1)).
-					Return(
-						nil,
-						int64(0),
-						errors.New("Item not found"),
-					)
+				listRepo.EXPECT().CheckUserAccessToList(int64(2), int64(1), domain.RoleOwner).Return(repository.ErrInsufficientRole)
 			},
-			expectedStatus: http.StatusNotFound,
-			expectedError:  "Item not found",
+			expectedStatus: http.StatusForbidden,
+			expectedError:  "User role does not allow this action",
 		},
 		{
-			name: "error deleting item",
-			in: &pb.DeleteTodoItemRequest{
-				UserId: 1,
-				Id:     1,
+			name: "Already a member",
+			in: &pb.ShareListRequest{
+				UserId:   1,
+				ListId:   1,
+				MemberId: 2,
+				Role:     pb.ListRole_LIST_ROLE_VIEWER,
 			},
 			mockRepoSetup: func() {
-				itemRepo.EXPECT().GetById(int64(1)).
-					Return(
-						&domain.TodoItem{Id: 1, Title: "My Todo Item", Description: "My Todo Item", ListId: 1},
-						int64(1),
-						nil,
-					)
-				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1)).Return(nil)
-				itemRepo.EXPECT().Delete(int64(1)).Return(errors.New("error deleting item"))
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleOwner).Return(nil)
+				listRepo.EXPECT().AddMember(&domain.UsersList{UserId: 2, ListId: 1, Role: domain.RoleViewer}, gomock.Any()).Return(repository.ErrMemberExists)
 			},
-			expectedStatus: http.StatusInternalServerError,
-			expectedError:  "error deleting item",
+			expectedStatus: http.StatusConflict,
+			expectedError:  "User is already a member of this list",
 		},
 		{
-			name: "error accessing list",
-			in: &pb.DeleteTodoItemRequest{
-				UserId: 1,
-				Id:     1,
-			},
-			mockRepoSetup: func() {
-				itemRepo.EXPECT().GetById(int64(1)).
-					Return(
-						&domain.TodoItem{Id: 1, Title: "My Todo Item", Description: "My Todo Item", ListId: 1},
-						int64(1),
-						nil,
-					)
-				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1)).Return(errors.New("User does not have access to this list"))
+			name: "Unknown role",
+			in: &pb.ShareListRequest{
+				UserId:   1,
+				ListId:   1,
+				MemberId: 2,
 			},
-			expectedStatus: http.StatusForbidden,
-			expectedError:  "User does not have access to this list",
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Unknown role",
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockRepoSetup()
-			resp, err := serv.DeleteTodoItem(context.Background(), tt.in)
+			resp, err := serv.ShareList(context.Background(), tt.in)
 			assert.NoError(t, err)
 			assert.NotNil(t, resp)
 			assert.Equal(t, tt.expectedStatus, int(resp.Status))
@@ -732,3 +1172,4261 @@ func TestServer_DeleteTodoItem(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_UpdateMemberRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	serv := &Server{
+		ListRepo: listRepo,
+		Mapper:   utils.NewMapper(),
+	}
+
+	tests := []struct {
+		name           string
+		in             *pb.UpdateMemberRoleRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name: "Success",
+			in: &pb.UpdateMemberRoleRequest{
+				UserId:   1,
+				ListId:   1,
+				MemberId: 2,
+				Role:     pb.ListRole_LIST_ROLE_OWNER,
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleOwner).Return(nil)
+				listRepo.EXPECT().UpdateMemberRole(int64(1), int64(2), domain.RoleOwner, gomock.Any()).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Last owner",
+			in: &pb.UpdateMemberRoleRequest{
+				UserId:   1,
+				ListId:   1,
+				MemberId: 1,
+				Role:     pb.ListRole_LIST_ROLE_VIEWER,
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleOwner).Return(nil)
+				listRepo.EXPECT().UpdateMemberRole(int64(1), int64(1), domain.RoleViewer, gomock.Any()).Return(repository.ErrLastOwner)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedError:  "A list must keep at least one owner",
+		},
+		{
+			name: "Member not found",
+			in: &pb.UpdateMemberRoleRequest{
+				UserId:   1,
+				ListId:   1,
+				MemberId: 5,
+				Role:     pb.ListRole_LIST_ROLE_EDITOR,
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleOwner).Return(nil)
+				listRepo.EXPECT().UpdateMemberRole(int64(1), int64(5), domain.RoleEditor, gomock.Any()).Return(repository.ErrUsersListNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  "Member not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.UpdateMemberRole(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, resp.Error)
+			}
+		})
+	}
+}
+
+func TestServer_RemoveMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	serv := &Server{
+		ListRepo: listRepo,
+		Mapper:   utils.NewMapper(),
+	}
+
+	tests := []struct {
+		name           string
+		in             *pb.RemoveMemberRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name: "Owner removes member",
+			in: &pb.RemoveMemberRequest{
+				UserId:   1,
+				ListId:   1,
+				MemberId: 2,
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleOwner).Return(nil)
+				listRepo.EXPECT().RemoveMember(int64(1), int64(2), gomock.Any()).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Viewer leaves list",
+			in: &pb.RemoveMemberRequest{
+				UserId:   3,
+				ListId:   1,
+				MemberId: 3,
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(3), int64(1), domain.RoleViewer).Return(nil)
+				listRepo.EXPECT().RemoveMember(int64(1), int64(3), gomock.Any()).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Viewer cannot remove others",
+			in: &pb.RemoveMemberRequest{
+				UserId:   3,
+				ListId:   1,
+				MemberId: 2,
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(3), int64(1), domain.RoleOwner).Return(repository.ErrInsufficientRole)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  "User role does not allow this action",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.RemoveMember(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, resp.Error)
+			}
+		})
+	}
+}
+
+func TestServer_ListMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	serv := &Server{
+		ListRepo: listRepo,
+		Mapper:   utils.NewMapper(),
+	}
+
+	tests := []struct {
+		name           string
+		in             *pb.ListMembersRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name: "Success",
+			in: &pb.ListMembersRequest{
+				UserId: 3,
+				ListId: 1,
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(3), int64(1), domain.RoleViewer).Return(nil)
+				listRepo.EXPECT().GetMembers(int64(1)).Return([]*domain.UsersList{
+					{UserId: 1, ListId: 1, Role: domain.RoleOwner},
+					{UserId: 3, ListId: 1, Role: domain.RoleViewer},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Not a member",
+			in: &pb.ListMembersRequest{
+				UserId: 4,
+				ListId: 1,
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(4), int64(1), domain.RoleViewer).Return(repository.ErrUsersListNotFound)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  "User does not have access to this list",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.ListMembers(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, resp.Error)
+			}
+		})
+	}
+}
+
+func TestServer_GetListActivity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	activityRepo := mock_repository.NewMockActivity(ctrl)
+	serv := &Server{
+		ListRepo:     listRepo,
+		ActivityRepo: activityRepo,
+		Mapper:       utils.NewMapper(),
+	}
+
+	tests := []struct {
+		name               string
+		in                 *pb.GetListActivityRequest
+		mockRepoSetup      func()
+		expectedStatus     int
+		expectedError      string
+		expectedActivities int
+		expectedPageToken  string
+	}{
+		{
+			name: "First page",
+			in:   &pb.GetListActivityRequest{UserId: 1, ListId: 2, PageSize: 2},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleViewer).Return(nil)
+				activityRepo.EXPECT().GetAll(int64(2), 3, nil).Return([]*domain.Activity{
+					{Id: 9, ListId: 2, ActorId: 1, Action: domain.ActionCompleted, TargetType: domain.TargetItem, TargetId: 5},
+					{Id: 8, ListId: 2, ActorId: 3, Action: domain.ActionCreated, TargetType: domain.TargetComment, TargetId: 4},
+					{Id: 7, ListId: 2, ActorId: 1, Action: domain.ActionCreated, TargetType: domain.TargetItem, TargetId: 5},
+				}, int64(3), nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedActivities: 2,
+			expectedPageToken:  pageToken(domain.ActivityCursor{Id: 8}),
+		},
+		{
+			name: "Next page",
+			in:   &pb.GetListActivityRequest{UserId: 1, ListId: 2, PageSize: 2, PageToken: pageToken(domain.ActivityCursor{Id: 8})},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleViewer).Return(nil)
+				activityRepo.EXPECT().GetAll(int64(2), 3, &domain.ActivityCursor{Id: 8}).Return([]*domain.Activity{
+					{Id: 7, ListId: 2, ActorId: 1, Action: domain.ActionCreated, TargetType: domain.TargetItem, TargetId: 5},
+				}, int64(3), nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedActivities: 1,
+		},
+		{
+			name:           "Invalid page token",
+			in:             &pb.GetListActivityRequest{UserId: 1, ListId: 2, PageToken: "not a token"},
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  errPageToken,
+		},
+		{
+			name: "Not a member",
+			in:   &pb.GetListActivityRequest{UserId: 1, ListId: 2},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleViewer).Return(repository.ErrUsersListNotFound)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  errForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.GetListActivity(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			assert.Equal(t, tt.expectedError, resp.Error)
+			assert.Len(t, resp.Activities, tt.expectedActivities)
+			assert.Equal(t, tt.expectedPageToken, resp.NextPageToken)
+		})
+	}
+}
+
+type watchStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses chan *pb.WatchListResponse
+}
+
+func (s *watchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchStream) Send(resp *pb.WatchListResponse) error {
+	s.responses <- resp
+	return nil
+}
+
+func snapshotOf(t *testing.T, v any) json.RawMessage {
+	raw, err := json.Marshal(v)
+	assert.NoError(t, err)
+	return raw
+}
+
+func TestServer_WatchList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	activityRepo := mock_repository.NewMockActivity(ctrl)
+	events := broker.New()
+	serv := &Server{
+		ListRepo:     listRepo,
+		ActivityRepo: activityRepo,
+		Events:       events,
+		Mapper:       utils.NewMapper(),
+	}
+
+	watch := func(in *pb.WatchListRequest) (*watchStream, chan error) {
+		stream := &watchStream{ctx: context.Background(), responses: make(chan *pb.WatchListResponse, 10)}
+		done := make(chan error, 1)
+		go func() {
+			done <- serv.WatchList(in, stream)
+		}()
+		return stream, done
+	}
+
+	t.Run("Not a member", func(t *testing.T) {
+		listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleViewer).Return(repository.ErrUsersListNotFound)
+
+		stream, done := watch(&pb.WatchListRequest{UserId: 1, ListId: 2})
+		assert.NoError(t, <-done)
+		resp := <-stream.responses
+		assert.Equal(t, int64(http.StatusForbidden), resp.Status)
+		assert.Equal(t, errForbidden, resp.Error)
+	})
+
+	t.Run("Catch up and follow until removed", func(t *testing.T) {
+		item := domain.TodoItem{Id: 4, Title: "Buy milk", ListId: 2}
+		done := item
+		done.Done = true
+
+		listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleViewer).Return(nil)
+		activityRepo.EXPECT().ResumeAfter(int64(2), int64(5), watchOverlap).Return(int64(3), nil)
+		activityRepo.EXPECT().GetAfter(int64(2), int64(3), watchBatch).Return([]*domain.Activity{
+			{Id: 6, ListId: 2, ActorId: 3, Action: domain.ActionCreated, TargetType: domain.TargetItem, TargetId: 4, After: snapshotOf(t, item)},
+			{Id: 7, ListId: 2, ActorId: 3, Action: domain.ActionCreated, TargetType: domain.TargetComment, TargetId: 9, After: snapshotOf(t, domain.Comment{Id: 9})},
+		}, nil)
+		activityRepo.EXPECT().GetById(int64(8)).Return(&domain.Activity{
+			Id: 8, ListId: 2, ActorId: 3, Action: domain.ActionCompleted, TargetType: domain.TargetItem, TargetId: 4,
+			Before: snapshotOf(t, item), After: snapshotOf(t, done),
+		}, nil)
+		activityRepo.EXPECT().GetById(int64(10)).Return(&domain.Activity{
+			Id: 10, ListId: 2, ActorId: 3, Action: domain.ActionRemoved, TargetType: domain.TargetMember, TargetId: 1,
+		}, nil)
+
+		stream, finished := watch(&pb.WatchListRequest{UserId: 1, ListId: 2, AfterSequence: 5})
+		assert.Equal(t, int64(http.StatusOK), (<-stream.responses).Status)
+
+		// Entry 7 was caught up with already.
+		for _, sequence := range []int64{7, 8, 10} {
+			events.Publish(broker.Event{ListId: 2, Sequence: sequence})
+		}
+		events.Publish(broker.Event{ListId: 3, Sequence: 11})
+		assert.NoError(t, <-finished)
+		close(stream.responses)
+
+		var responses []*pb.WatchListResponse
+		for resp := range stream.responses {
+			responses = append(responses, resp)
+		}
+		if assert.Len(t, responses, 3) {
+			assert.Equal(t, pb.ListEvent_ITEM_CREATED, responses[0].Event.Type)
+			assert.Equal(t, int64(6), responses[0].Event.Sequence)
+			assert.Equal(t, "Buy milk", responses[0].Event.Item.Title)
+			assert.Equal(t, pb.ListEvent_ITEM_UPDATED, responses[1].Event.Type)
+			assert.Equal(t, int64(8), responses[1].Event.Sequence)
+			assert.True(t, responses[1].Event.Item.Completed)
+			assert.Equal(t, int64(http.StatusForbidden), responses[2].Status)
+			assert.Nil(t, responses[2].Event)
+		}
+	})
+}
+
+func TestServer_listEvent(t *testing.T) {
+	serv := &Server{Mapper: utils.NewMapper()}
+
+	item := domain.TodoItem{Id: 4, Title: "Buy milk", ListId: 2}
+	moved := item
+	moved.ListId = 3
+	trashed := item
+	trashed.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+
+	tests := []struct {
+		name         string
+		activity     *domain.Activity
+		expectedType pb.ListEvent_Type
+		expectedNil  bool
+	}{
+		{
+			name:         "Item moved away",
+			activity:     &domain.Activity{ListId: 2, Action: domain.ActionMoved, TargetType: domain.TargetItem, Before: snapshotOf(t, item), After: snapshotOf(t, moved)},
+			expectedType: pb.ListEvent_ITEM_DELETED,
+		},
+		{
+			name:         "Item moved here",
+			activity:     &domain.Activity{ListId: 3, Action: domain.ActionMoved, TargetType: domain.TargetItem, Before: snapshotOf(t, item), After: snapshotOf(t, moved)},
+			expectedType: pb.ListEvent_ITEM_CREATED,
+		},
+		{
+			name:         "Item restored",
+			activity:     &domain.Activity{ListId: 2, Action: domain.ActionRestored, TargetType: domain.TargetItem, Before: snapshotOf(t, trashed), After: snapshotOf(t, item)},
+			expectedType: pb.ListEvent_ITEM_CREATED,
+		},
+		{
+			name:         "Item deleted",
+			activity:     &domain.Activity{ListId: 2, Action: domain.ActionDeleted, TargetType: domain.TargetItem, Before: snapshotOf(t, item)},
+			expectedType: pb.ListEvent_ITEM_DELETED,
+		},
+		{
+			name:        "Item purged",
+			activity:    &domain.Activity{ListId: 2, Action: domain.ActionPurged, TargetType: domain.TargetItem, Before: snapshotOf(t, trashed)},
+			expectedNil: true,
+		},
+		{
+			name: "List renamed",
+			activity: &domain.Activity{ListId: 2, Action: domain.ActionUpdated, TargetType: domain.TargetList,
+				Before: snapshotOf(t, domain.TodoList{Id: 2, Title: "Groceries"}), After: snapshotOf(t, domain.TodoList{Id: 2, Title: "Shopping"})},
+			expectedType: pb.ListEvent_LIST_RENAMED,
+		},
+		{
+			name: "List description changed",
+			activity: &domain.Activity{ListId: 2, Action: domain.ActionUpdated, TargetType: domain.TargetList,
+				Before: snapshotOf(t, domain.TodoList{Id: 2, Title: "Groceries"}), After: snapshotOf(t, domain.TodoList{Id: 2, Title: "Groceries", Description: "Weekly"})},
+			expectedNil: true,
+		},
+		{
+			name:        "Tag attached",
+			activity:    &domain.Activity{ListId: 2, Action: domain.ActionAdded, TargetType: domain.TargetTag, After: snapshotOf(t, domain.Tag{Id: 1})},
+			expectedNil: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, err := serv.listEvent(tt.activity)
+			assert.NoError(t, err)
+			if tt.expectedNil {
+				assert.Nil(t, event)
+				return
+			}
+			if assert.NotNil(t, event) {
+				assert.Equal(t, tt.expectedType, event.Type)
+			}
+		})
+	}
+}
+
+func TestServer_CreateInvitation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	invitationRepo := mock_repository.NewMockInvitation(ctrl)
+	serv := &Server{
+		ListRepo:       listRepo,
+		InvitationRepo: invitationRepo,
+		Mapper:         utils.NewMapper(),
+	}
+
+	tests := []struct {
+		name           string
+		in             *pb.CreateInvitationRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name: "Registered user",
+			in: &pb.CreateInvitationRequest{
+				UserId:    1,
+				ListId:    1,
+				Email:     " Colleague@Example.com ",
+				Role:      pb.ListRole_LIST_ROLE_EDITOR,
+				InviteeId: 2,
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleOwner).Return(nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(2), int64(1), domain.RoleViewer).Return(repository.ErrUsersListNotFound)
+				invitationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(invitation *domain.Invitation, _ *domain.Activity) error {
+					assert.Equal(t, "colleague@example.com", invitation.Email)
+					assert.Equal(t, int64(2), *invitation.InviteeId)
+					assert.Equal(t, domain.RoleEditor, invitation.Role)
+					assert.Len(t, invitation.Token, 64)
+					assert.True(t, invitation.ExpiresAt.After(time.Now()))
+					return nil
+				})
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name: "E-mail without account",
+			in: &pb.CreateInvitationRequest{
+				UserId: 1,
+				ListId: 1,
+				Email:  "new@example.com",
+				Role:   pb.ListRole_LIST_ROLE_VIEWER,
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleOwner).Return(nil)
+				invitationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(invitation *domain.Invitation, _ *domain.Activity) error {
+					assert.Nil(t, invitation.InviteeId)
+					return nil
+				})
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name: "Already a member",
+			in: &pb.CreateInvitationRequest{
+				UserId:    1,
+				ListId:    1,
+				Email:     "colleague@example.com",
+				Role:      pb.ListRole_LIST_ROLE_VIEWER,
+				InviteeId: 2,
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleOwner).Return(nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(2), int64(1), domain.RoleViewer).Return(nil)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedError:  "User is already a member of this list",
+		},
+		{
+			name: "Invalid e-mail",
+			in: &pb.CreateInvitationRequest{
+				UserId: 1,
+				ListId: 1,
+				Email:  "Colleague <colleague@example.com>",
+				Role:   pb.ListRole_LIST_ROLE_VIEWER,
+			},
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Invalid e-mail address",
+		},
+		{
+			name: "Editor cannot invite",
+			in: &pb.CreateInvitationRequest{
+				UserId: 2,
+				ListId: 1,
+				Email:  "colleague@example.com",
+				Role:   pb.ListRole_LIST_ROLE_VIEWER,
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(2), int64(1), domain.RoleOwner).Return(repository.ErrInsufficientRole)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  "User role does not allow this action",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.CreateInvitation(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, resp.Error)
+			}
+		})
+	}
+}
+
+func TestServer_AcceptInvitation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	invitationRepo := mock_repository.NewMockInvitation(ctrl)
+	serv := &Server{
+		InvitationRepo: invitationRepo,
+	}
+
+	invitee := int64(2)
+	pending := &domain.Invitation{Id: 1, ListId: 1, InviteeId: &invitee, Role: domain.RoleEditor, Token: "token", ExpiresAt: time.Now().Add(time.Hour)}
+	expired := &domain.Invitation{Id: 2, ListId: 1, InviteeId: &invitee, Role: domain.RoleEditor, Token: "old", ExpiresAt: time.Now().Add(-time.Hour)}
+	unclaimed := &domain.Invitation{Id: 3, ListId: 1, Role: domain.RoleEditor, Token: "unclaimed", ExpiresAt: time.Now().Add(time.Hour)}
+
+	tests := []struct {
+		name           string
+		in             *pb.AcceptInvitationRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name: "Success",
+			in:   &pb.AcceptInvitationRequest{UserId: 2, Token: "token"},
+			mockRepoSetup: func() {
+				invitationRepo.EXPECT().GetByToken("token").Return(pending, nil)
+				invitationRepo.EXPECT().Accept(pending, gomock.Any()).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Someone else's invitation",
+			in:   &pb.AcceptInvitationRequest{UserId: 3, Token: "token"},
+			mockRepoSetup: func() {
+				invitationRepo.EXPECT().GetByToken("token").Return(pending, nil)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  "Invitation not found",
+		},
+		{
+			name: "Invitee has not signed up",
+			in:   &pb.AcceptInvitationRequest{UserId: 2, Token: "unclaimed"},
+			mockRepoSetup: func() {
+				invitationRepo.EXPECT().GetByToken("unclaimed").Return(unclaimed, nil)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  "Invitation not found",
+		},
+		{
+			name: "Expired",
+			in:   &pb.AcceptInvitationRequest{UserId: 2, Token: "old"},
+			mockRepoSetup: func() {
+				invitationRepo.EXPECT().GetByToken("old").Return(expired, nil)
+			},
+			expectedStatus: http.StatusGone,
+			expectedError:  "Invitation has expired",
+		},
+		{
+			name: "Unknown token",
+			in:   &pb.AcceptInvitationRequest{UserId: 2, Token: "nope"},
+			mockRepoSetup: func() {
+				invitationRepo.EXPECT().GetByToken("nope").Return(nil, repository.ErrInvitationNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  "Invitation not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.AcceptInvitation(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, resp.Error)
+			}
+		})
+	}
+}
+
+func TestServer_DeclineInvitation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	invitationRepo := mock_repository.NewMockInvitation(ctrl)
+	serv := &Server{
+		InvitationRepo: invitationRepo,
+	}
+
+	invitee := int64(2)
+	expired := &domain.Invitation{Id: 2, ListId: 1, InviteeId: &invitee, Token: "old", ExpiresAt: time.Now().Add(-time.Hour)}
+
+	tests := []struct {
+		name           string
+		in             *pb.DeclineInvitationRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name: "Expired invitations can be declined",
+			in:   &pb.DeclineInvitationRequest{UserId: 2, Token: "old"},
+			mockRepoSetup: func() {
+				invitationRepo.EXPECT().GetByToken("old").Return(expired, nil)
+				invitationRepo.EXPECT().Delete(int64(2), &domain.Activity{ActorId: 2, Action: domain.ActionDeclined}).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Someone else's invitation",
+			in:   &pb.DeclineInvitationRequest{UserId: 3, Token: "old"},
+			mockRepoSetup: func() {
+				invitationRepo.EXPECT().GetByToken("old").Return(expired, nil)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  "Invitation not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.DeclineInvitation(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, resp.Error)
+			}
+		})
+	}
+}
+
+func TestServer_ActivateInvitations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	invitationRepo := mock_repository.NewMockInvitation(ctrl)
+	serv := &Server{
+		InvitationRepo: invitationRepo,
+	}
+
+	tests := []struct {
+		name           string
+		in             *pb.ActivateInvitationsRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name: "Success",
+			in:   &pb.ActivateInvitationsRequest{UserId: 5, Email: "New@Example.com"},
+			mockRepoSetup: func() {
+				invitationRepo.EXPECT().Activate("new@example.com", int64(5)).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid e-mail",
+			in:             &pb.ActivateInvitationsRequest{UserId: 5, Email: "new"},
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Invalid e-mail address",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.ActivateInvitations(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, resp.Error)
+			}
+		})
+	}
+}
+
+func TestServer_CreateTodoItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepo := mock_repository.NewMockTodoItem(ctrl)
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	serv := &Server{
+		ItemRepo: itemRepo,
+		ListRepo: listRepo,
+		Mapper:   utils.NewMapper(),
+	}
+
+	tests := []struct {
+		name           string
+		in             *pb.CreateTodoItemRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name: "Success",
+			in: &pb.CreateTodoItemRequest{
+				UserId:      1,
+				ListId:      1,
+				Title:       "My Todo Item",
+				Description: "My Todo Item",
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+				itemRepo.EXPECT().Create(&domain.TodoItem{Title: "My Todo Item", Description: "My Todo Item", ListId: 1}, gomock.Any()).
+					Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedError:  "",
+		},
+		{
+			name: "Archived list",
+			in: &pb.CreateTodoItemRequest{
+				UserId: 1,
+				ListId: 1,
+				Title:  "My Todo Item",
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(repository.ErrListArchived)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedError:  errListArchived,
+		},
+		{
+			name: "Not created",
+			in: &pb.CreateTodoItemRequest{
+				UserId:      1,
+				ListId:      1,
+				Title:       "My Todo Item",
+				Description: "My Todo Item",
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+				itemRepo.EXPECT().Create(&domain.TodoItem{Title: "My Todo Item", Description: "My Todo Item", ListId: 1}, gomock.Any()).
+					Return(errors.New("Item not created"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "Item not created",
+		},
+		{
+			name: "Start after due",
+			in: &pb.CreateTodoItemRequest{
+				UserId:  1,
+				ListId:  1,
+				Title:   "My Todo Item",
+				StartAt: timestamppb.New(time.Date(2024, 10, 2, 0, 0, 0, 0, time.UTC)),
+				DueAt:   timestamppb.New(time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Start date must not be after due date",
+		},
+		{
+			name: "Unknown time zone",
+			in: &pb.CreateTodoItemRequest{
+				UserId:   1,
+				ListId:   1,
+				Title:    "My Todo Item",
+				TimeZone: "Mars/Olympus",
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Unknown time zone",
+		},
+		{
+			name: "Unknown priority",
+			in: &pb.CreateTodoItemRequest{
+				UserId:   1,
+				ListId:   1,
+				Title:    "My Todo Item",
+				Priority: pb.Priority(9),
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Unknown priority",
+		},
+		{
+			name: "Subtask",
+			in: &pb.CreateTodoItemRequest{
+				UserId:   1,
+				ListId:   1,
+				Title:    "Write changelog",
+				ParentId: 7,
+			},
+			mockRepoSetup: func() {
+				parentId := int64(7)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+				itemRepo.EXPECT().GetById(int64(1), int64(7)).Return(&domain.TodoItem{Id: 7, ListId: 1}, int64(1), nil)
+				itemRepo.EXPECT().GetDepth(int64(7)).Return(2, nil)
+				itemRepo.EXPECT().Create(&domain.TodoItem{Title: "Write changelog", ListId: 1, ParentId: &parentId}, gomock.Any()).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedError:  "",
+		},
+		{
+			name: "Subtask of item in another list",
+			in: &pb.CreateTodoItemRequest{
+				UserId:   1,
+				ListId:   1,
+				Title:    "Write changelog",
+				ParentId: 7,
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+				itemRepo.EXPECT().GetById(int64(1), int64(7)).Return(&domain.TodoItem{Id: 7, ListId: 2}, int64(2), nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Parent item must be in the same list",
+		},
+		{
+			name: "Subtask nested too deep",
+			in: &pb.CreateTodoItemRequest{
+				UserId:   1,
+				ListId:   1,
+				Title:    "Write changelog",
+				ParentId: 7,
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+				itemRepo.EXPECT().GetById(int64(1), int64(7)).Return(&domain.TodoItem{Id: 7, ListId: 1}, int64(1), nil)
+				itemRepo.EXPECT().GetDepth(int64(7)).Return(domain.MaxItemDepth, nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Subtasks can be nested at most 5 levels deep",
+		},
+		{
+			name: "Recurring item",
+			in: &pb.CreateTodoItemRequest{
+				UserId:          1,
+				ListId:          1,
+				Title:           "Take out trash",
+				DueAt:           timestamppb.New(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)),
+				Recurrence:      "RRULE:FREQ=WEEKLY;BYDAY=MO",
+				RecurrenceCount: 4,
+			},
+			mockRepoSetup: func() {
+				due := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+				itemRepo.EXPECT().Create(&domain.TodoItem{
+					Title:           "Take out trash",
+					DueAt:           &due,
+					Recurrence:      "FREQ=WEEKLY;COUNT=4;BYDAY=MO",
+					RecurrenceStart: &due,
+					ListId:          1,
+				}, gomock.Any()).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Invalid recurrence rule",
+			in: &pb.CreateTodoItemRequest{
+				UserId:     1,
+				ListId:     1,
+				Title:      "Take out trash",
+				DueAt:      timestamppb.New(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)),
+				Recurrence: "FREQ=SOMETIMES",
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Invalid recurrence rule",
+		},
+		{
+			name: "Recurrence too frequent",
+			in: &pb.CreateTodoItemRequest{
+				UserId:     1,
+				ListId:     1,
+				Title:      "Take out trash",
+				DueAt:      timestamppb.New(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)),
+				Recurrence: "FREQ=MINUTELY",
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Items can repeat at most hourly",
+		},
+		{
+			name: "Recurrence with count and until",
+			in: &pb.CreateTodoItemRequest{
+				UserId:          1,
+				ListId:          1,
+				Title:           "Take out trash",
+				DueAt:           timestamppb.New(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)),
+				Recurrence:      "FREQ=WEEKLY",
+				RecurrenceCount: 4,
+				RecurrenceUntil: timestamppb.New(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Recurrence can end after a number of occurrences or at a date, not both",
+		},
+		{
+			name: "Recurring item without due date",
+			in: &pb.CreateTodoItemRequest{
+				UserId:     1,
+				ListId:     1,
+				Title:      "Take out trash",
+				Recurrence: "FREQ=WEEKLY",
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Recurring items need a due date",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.CreateTodoItem(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, resp.Error)
+			}
+		})
+	}
+}
+
+func TestServer_GetTodoItemById(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepo := mock_repository.NewMockTodoItem(ctrl)
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	serv := &Server{
+		ItemRepo: itemRepo,
+		ListRepo: listRepo,
+		Mapper:   utils.NewMapper(),
+	}
+
+	tests := []struct {
+		name           string
+		in             *pb.GetTodoItemRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+		expectedTree   bool
+	}{
+		{
+			name: "Success",
+			in: &pb.GetTodoItemRequest{
+				UserId: 1,
+				Id:     1,
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).
+					Return(
+						&domain.TodoItem{Id: 1, Title: "My Todo Item", Description: "My Todo Item", ListId: 1},
+						int64(1),
+						nil,
+					)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleViewer).Return(nil)
+				itemRepo.EXPECT().GetDescendants(int64(1), int64(1)).Return(nil, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedError:  "",
+		},
+		{
+			name: "With subtasks",
+			in: &pb.GetTodoItemRequest{
+				UserId: 1,
+				Id:     1,
+			},
+			mockRepoSetup: func() {
+				parentId, childId := int64(1), int64(2)
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).
+					Return(
+						&domain.TodoItem{Id: 1, Title: "Release", ListId: 1},
+						int64(1),
+						nil,
+					)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleViewer).Return(nil)
+				itemRepo.EXPECT().GetDescendants(int64(1), int64(1)).Return([]*domain.TodoItem{
+					{Id: 2, Title: "Write changelog", ListId: 1, ParentId: &parentId},
+					{Id: 3, Title: "Proofread changelog", ListId: 1, ParentId: &childId},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedError:  "",
+			expectedTree:   true,
+		},
+		{
+			name: "Not found",
+			in: &pb.GetTodoItemRequest{
+				UserId: 1,
+				Id:     1,
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).
+					Return(
+						nil,
+						int64(0),
+						errors.New("Item not found"),
+					)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  "Item not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.GetTodoItemById(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, resp.Error)
+			}
+			if tt.expectedTree {
+				assert.Len(t, resp.Item.Children, 1)
+				assert.Equal(t, int64(1), resp.Item.Children[0].ParentId)
+				assert.Len(t, resp.Item.Children[0].Children, 1)
+				assert.Equal(t, int64(3), resp.Item.Children[0].Children[0].Id)
+			}
+		})
+	}
+}
+
+func TestServer_GetTodoItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepo := mock_repository.NewMockTodoItem(ctrl)
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	serv := &Server{
+		ItemRepo: itemRepo,
+		ListRepo: listRepo,
+		Mapper:   utils.NewMapper(),
+	}
+
+	due := time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		in             *pb.GetTodoItemsRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+		expectedToken  string
+		expectedTotal  int64
+	}{
+		{
+			name: "Success",
+			in: &pb.GetTodoItemsRequest{
+				UserId: 1,
+				ListId: 1,
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleViewer).Return(nil)
+				itemRepo.EXPECT().GetAll(int64(1), int64(1), domain.ItemFilter{}, domain.ItemSort{}, defaultPageSize+1, nil).
+					Return(
+						[]*domain.TodoItem{{Id: 1, Title: "My Todo Item", Description: "My Todo Item", ListId: 1},
+							{Id: 2, Title: "My Todo Item 2", Description: "My Todo Item 2", ListId: 1}},
+						int64(2),
+						nil,
+					)
+			},
+			expectedStatus: http.StatusOK,
+			expectedError:  "",
+			expectedTotal:  2,
+		},
+		{
+			name: "Not found",
+			in: &pb.GetTodoItemsRequest{
+				UserId: 1,
+				ListId: 1,
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleViewer).Return(nil)
+				itemRepo.EXPECT().GetAll(int64(1), int64(1), domain.ItemFilter{}, domain.ItemSort{}, defaultPageSize+1, nil).
+					Return(
+						[]*domain.TodoItem{},
+						int64(0),
+						errors.New("Item not found"),
+					)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "Item not found",
+		},
+		{
+			name: "Sorted by priority",
+			in: &pb.GetTodoItemsRequest{
+				UserId:     1,
+				ListId:     1,
+				Sort:       pb.ItemSort_ITEM_SORT_PRIORITY,
+				Descending: true,
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleViewer).Return(nil)
+				itemRepo.EXPECT().GetAll(int64(1), int64(1), domain.ItemFilter{}, domain.ItemSort{Field: domain.ItemSortPriority, Descending: true}, defaultPageSize+1, nil).
+					Return(
+						[]*domain.TodoItem{{Id: 2, Title: "Urgent", Priority: domain.PriorityUrgent, ListId: 1},
+							{Id: 1, Title: "Low", Priority: domain.PriorityLow, ListId: 1}},
+						int64(2),
+						nil,
+					)
+			},
+			expectedStatus: http.StatusOK,
+			expectedError:  "",
+			expectedTotal:  2,
+		},
+		{
+			name: "Unknown sort",
+			in: &pb.GetTodoItemsRequest{
+				UserId: 1,
+				ListId: 1,
+				Sort:   pb.ItemSort(42),
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleViewer).Return(nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Unknown sort order",
+		},
+		{
+			name: "Undone items page sorted by due date",
+			in: &pb.GetTodoItemsRequest{
+				UserId:     1,
+				ListId:     1,
+				Sort:       pb.ItemSort_ITEM_SORT_DUE,
+				PageSize:   1,
+				Completion: pb.CompletionFilter_COMPLETION_FILTER_UNDONE,
+			},
+			mockRepoSetup: func() {
+				done := false
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleViewer).Return(nil)
+				itemRepo.EXPECT().GetAll(int64(1), int64(1), domain.ItemFilter{Done: &done}, domain.ItemSort{Field: domain.ItemSortDue}, 2, nil).
+					Return(
+						[]*domain.TodoItem{{Id: 2, Title: "Soon", DueAt: &due, ListId: 1},
+							{Id: 1, Title: "Later", ListId: 1}},
+						int64(2),
+						nil,
+					)
+			},
+			expectedStatus: http.StatusOK,
+			expectedToken:  pageToken(domain.ItemCursor{Sort: domain.ItemSort{Field: domain.ItemSortDue}, Id: 2, DueAt: &due}),
+			expectedTotal:  2,
+		},
+		{
+			name: "Next page",
+			in: &pb.GetTodoItemsRequest{
+				UserId:    1,
+				ListId:    1,
+				Sort:      pb.ItemSort_ITEM_SORT_DUE,
+				PageSize:  1,
+				PageToken: pageToken(domain.ItemCursor{Sort: domain.ItemSort{Field: domain.ItemSortDue}, Id: 2, DueAt: &due}),
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleViewer).Return(nil)
+				itemRepo.EXPECT().GetAll(int64(1), int64(1), domain.ItemFilter{}, domain.ItemSort{Field: domain.ItemSortDue}, 2, gomock.Any()).
+					DoAndReturn(func(_, listId int64, filter domain.ItemFilter, sort domain.ItemSort, limit int, after *domain.ItemCursor) ([]*domain.TodoItem, int64, error) {
+						assert.Equal(t, int64(2), after.Id)
+						assert.True(t, due.Equal(*after.DueAt))
+						return []*domain.TodoItem{{Id: 1, Title: "Later", ListId: 1}}, int64(2), nil
+					})
+			},
+			expectedStatus: http.StatusOK,
+			expectedTotal:  2,
+		},
+		{
+			name: "Page token of another sort",
+			in: &pb.GetTodoItemsRequest{
+				UserId:    1,
+				ListId:    1,
+				Sort:      pb.ItemSort_ITEM_SORT_TITLE,
+				PageToken: pageToken(domain.ItemCursor{Sort: domain.ItemSort{Field: domain.ItemSortDue}, Id: 2, DueAt: &due}),
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleViewer).Return(nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Invalid page token",
+		},
+		{
+			name: "Unknown completion filter",
+			in: &pb.GetTodoItemsRequest{
+				UserId:     1,
+				ListId:     1,
+				Completion: pb.CompletionFilter(7),
+			},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleViewer).Return(nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Unknown completion filter",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.GetTodoItems(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, resp.Error)
+			} else {
+				assert.Equal(t, tt.expectedToken, resp.NextPageToken)
+				assert.Equal(t, tt.expectedTotal, resp.TotalCount)
+			}
+		})
+	}
+}
+
+func TestServer_UpdateTodoItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepo := mock_repository.NewMockTodoItem(ctrl)
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	serv := &Server{
+		ItemRepo: itemRepo,
+		ListRepo: listRepo,
+		Mapper:   utils.NewMapper(),
+	}
+
+	first := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	start := time.Date(2024, 1, 8, 8, 0, 0, 0, time.UTC)
+	due := time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC)
+	nextStart := time.Date(2024, 1, 15, 8, 0, 0, 0, time.UTC)
+	nextDue := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		in             *pb.UpdateTodoItemRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+		expectedNext   *time.Time
+	}{
+		{
+			name: "Success",
+			in: &pb.UpdateTodoItemRequest{
+				UserId:      1,
+				Id:          1,
+				Title:       "My Todo Item",
+				Completed:   true,
+				Description: "My Todo Item",
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).
+					Return(
+						&domain.TodoItem{Id: 1, Title: "My Todo Item", Description: "My Todo Item", ListId: 1},
+						int64(1),
+						nil,
+					)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+				itemRepo.EXPECT().Update(&domain.TodoItem{Id: 1, Title: "My Todo Item", Description: "My Todo Item", ListId: 1, Done: true}, nil, &domain.Activity{ActorId: 1, Action: domain.ActionCompleted}).
+					Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedError:  "",
+		},
+		{
+			name: "Not found",
+			in: &pb.UpdateTodoItemRequest{
+				UserId:      1,
+				Id:          1,
+				Title:       "My Todo Item",
+				Completed:   true,
+				Description: "My Todo Item",
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).
+					Return(
+						nil,
+						int64(0),
+						errors.New("Item not found"),
+					)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  "Item not found",
+		},
+		{
+			name: "error updating item",
+			in: &pb.UpdateTodoItemRequest{
+				UserId:      1,
+				Id:          1,
+				Title:       "My Todo Item",
+				Completed:   true,
+				Description: "My Todo Item",
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).
+					Return(
+						&domain.TodoItem{Id: 1, Title: "My Todo Item", Description: "My Todo Item", ListId: 1},
+						int64(1),
+						nil,
+					)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+				itemRepo.EXPECT().Update(&domain.TodoItem{Id: 1, Title: "My Todo Item", Description: "My Todo Item", ListId: 1, Done: true}, nil, gomock.Any()).
+					Return(errors.New("error updating item"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "error updating item",
+		},
+		{
+			name: "Stale version",
+			in: &pb.UpdateTodoItemRequest{
+				UserId:  1,
+				Id:      1,
+				Title:   "My Todo Item",
+				Version: 2,
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).
+					Return(&domain.TodoItem{Id: 1, Title: "Changed", ListId: 1, Version: 3}, int64(1), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+			},
+			expectedStatus: http.StatusPreconditionFailed,
+			expectedError:  errStaleVersion,
+		},
+		{
+			name: "Changed meanwhile",
+			in: &pb.UpdateTodoItemRequest{
+				UserId: 1,
+				Id:     1,
+				Title:  "My Todo Item",
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).
+					Return(&domain.TodoItem{Id: 1, Title: "Changed", ListId: 1, Version: 3}, int64(1), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+				itemRepo.EXPECT().Update(&domain.TodoItem{Id: 1, Title: "My Todo Item", ListId: 1, Version: 3}, nil, gomock.Any()).
+					Return(repository.ErrVersionConflict)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedError:  errVersionConflict,
+		},
+		{
+			name: "Masked fields only",
+			in: &pb.UpdateTodoItemRequest{
+				UserId:     1,
+				Id:         1,
+				Completed:  true,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"completed"}},
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).
+					Return(&domain.TodoItem{Id: 1, Title: "My Todo Item", Description: "Keep me", ListId: 1}, int64(1), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+				itemRepo.EXPECT().Update(&domain.TodoItem{Id: 1, Title: "My Todo Item", Description: "Keep me", ListId: 1, Done: true}, []string{"done"}, &domain.Activity{ActorId: 1, Action: domain.ActionCompleted}).
+					Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Unknown mask path",
+			in: &pb.UpdateTodoItemRequest{
+				UserId:     1,
+				Id:         1,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"list_id"}},
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).
+					Return(&domain.TodoItem{Id: 1, Title: "My Todo Item", ListId: 1}, int64(1), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  errUpdateMask,
+		},
+		{
+			name: "Complete with descendants",
+			in: &pb.UpdateTodoItemRequest{
+				UserId:              1,
+				Id:                  1,
+				Title:               "My Todo Item",
+				Completed:           true,
+				CompleteDescendants: true,
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).
+					Return(
+						&domain.TodoItem{Id: 1, Title: "My Todo Item", ListId: 1},
+						int64(1),
+						nil,
+					)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+				itemRepo.EXPECT().CompleteSubtree(&domain.TodoItem{Id: 1, Title: "My Todo Item", ListId: 1, Done: true}, nil, gomock.Any()).
+					Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedError:  "",
+		},
+		{
+			name: "Viewer cannot edit",
+			in: &pb.UpdateTodoItemRequest{
+				UserId:    3,
+				Id:        1,
+				Title:     "My Todo Item",
+				Completed: true,
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(3), int64(1)).
+					Return(
+						&domain.TodoItem{Id: 1, Title: "My Todo Item", ListId: 1},
+						int64(1),
+						nil,
+					)
+				listRepo.EXPECT().CheckUserAccessToList(int64(3), int64(1), domain.RoleEditor).Return(repository.ErrInsufficientRole)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  "User role does not allow this action",
+		},
+		{
+			name: "Complete recurring item",
+			in: &pb.UpdateTodoItemRequest{
+				UserId:    1,
+				Id:        1,
+				Title:     "Take out trash",
+				Completed: true,
+				StartAt:   timestamppb.New(time.Date(2024, 1, 8, 8, 0, 0, 0, time.UTC)),
+				DueAt:     timestamppb.New(time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC)),
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).
+					Return(
+						&domain.TodoItem{Id: 1, Title: "Take out trash", DueAt: &due, Recurrence: "FREQ=WEEKLY", RecurrenceStart: &first, ListId: 1, Tags: []domain.Tag{{Id: 3, Name: "home"}}},
+						int64(1),
+						nil,
+					)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+				itemRepo.EXPECT().CompleteRecurring(
+					&domain.TodoItem{Id: 1, Title: "Take out trash", Done: true, StartAt: &start, DueAt: &due, Recurrence: "FREQ=WEEKLY", RecurrenceStart: &first, ListId: 1, Tags: []domain.Tag{{Id: 3, Name: "home"}}},
+					&domain.TodoItem{Title: "Take out trash", StartAt: &nextStart, DueAt: &nextDue, Recurrence: "FREQ=WEEKLY", RecurrenceStart: &first, ListId: 1, Tags: []domain.Tag{{Id: 3, Name: "home"}}},
+					false,
+					nil,
+					gomock.Any(),
+				).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedNext:   &nextDue,
+		},
+		{
+			name: "Complete last occurrence",
+			in: &pb.UpdateTodoItemRequest{
+				UserId:    1,
+				Id:        1,
+				Title:     "Take out trash",
+				Completed: true,
+				DueAt:     timestamppb.New(time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC)),
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).
+					Return(
+						&domain.TodoItem{Id: 1, Title: "Take out trash", DueAt: &due, Recurrence: "FREQ=WEEKLY;COUNT=2", RecurrenceStart: &first, ListId: 1},
+						int64(1),
+						nil,
+					)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+				itemRepo.EXPECT().Update(&domain.TodoItem{Id: 1, Title: "Take out trash", Done: true, DueAt: &due, Recurrence: "FREQ=WEEKLY;COUNT=2", RecurrenceStart: &first, ListId: 1}, nil, gomock.Any()).
+					Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.UpdateTodoItem(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, resp.Error)
+			}
+			if tt.expectedNext != nil {
+				assert.Equal(t, tt.expectedNext.Unix(), resp.NextOccurrence.DueAt.Seconds)
+			} else {
+				assert.Nil(t, resp.NextOccurrence)
+			}
+		})
+	}
+}
+
+func TestServer_DeleteTodoItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepo := mock_repository.NewMockTodoItem(ctrl)
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	serv := &Server{
+		ItemRepo: itemRepo,
+		ListRepo: listRepo,
+		Mapper:   utils.NewMapper(),
+	}
+
+	tests := []struct {
+		name           string
+		in             *pb.DeleteTodoItemRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name: "Success",
+			in: &pb.DeleteTodoItemRequest{
+				UserId: 1,
+				Id:     1,
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).
+					Return(
+						&domain.TodoItem{Id: 1, Title: "My Todo Item", Description: "My Todo Item", ListId: 1},
+						int64(1),
+						nil,
+					)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+				itemRepo.EXPECT().Delete(int64(1), false, int64(0), gomock.Any()).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedError:  "",
+		},
+		{
+			name: "Not found",
+			in: &pb.DeleteTodoItemRequest{
+				UserId: 1,
+				Id:     1,
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).
+					Return(
+						nil,
+						int64(0),
+						errors.New("Item not found"),
+					)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  "Item not found",
+		},
+		{
+			name: "error deleting item",
+			in: &pb.DeleteTodoItemRequest{
+				UserId: 1,
+				Id:     1,
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).
+					Return(
+						&domain.TodoItem{Id: 1, Title: "My Todo Item", Description: "My Todo Item", ListId: 1},
+						int64(1),
+						nil,
+					)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+				itemRepo.EXPECT().Delete(int64(1), false, int64(0), gomock.Any()).Return(errors.New("error deleting item"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "error deleting item",
+		},
+		{
+			name: "Stale version",
+			in: &pb.DeleteTodoItemRequest{
+				UserId:  1,
+				Id:      1,
+				Version: 2,
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).
+					Return(&domain.TodoItem{Id: 1, Title: "My Todo Item", ListId: 1, Version: 3}, int64(1), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+				itemRepo.EXPECT().Delete(int64(1), false, int64(2), gomock.Any()).Return(repository.ErrVersionConflict)
+			},
+			expectedStatus: http.StatusPreconditionFailed,
+			expectedError:  errStaleVersion,
+		},
+		{
+			name: "error accessing list",
+			in: &pb.DeleteTodoItemRequest{
+				UserId: 1,
+				Id:     1,
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).
+					Return(
+						&domain.TodoItem{Id: 1, Title: "My Todo Item", Description: "My Todo Item", ListId: 1},
+						int64(1),
+						nil,
+					)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(errors.New("User does not have access to this list"))
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  "User does not have access to this list",
+		},
+		{
+			name: "Reparent children",
+			in: &pb.DeleteTodoItemRequest{
+				UserId:           1,
+				Id:               1,
+				ReparentChildren: true,
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).
+					Return(
+						&domain.TodoItem{Id: 1, Title: "My Todo Item", Description: "My Todo Item", ListId: 1},
+						int64(1),
+						nil,
+					)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+				itemRepo.EXPECT().Delete(int64(1), true, int64(0), gomock.Any()).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedError:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.DeleteTodoItem(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, resp.Error)
+			}
+		})
+	}
+}
+
+func TestServer_MoveTodoItem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepo := mock_repository.NewMockTodoItem(ctrl)
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	serv := &Server{
+		ItemRepo: itemRepo,
+		ListRepo: listRepo,
+		Mapper:   utils.NewMapper(),
+	}
+
+	item := &domain.TodoItem{Id: 1, Title: "My Todo Item", ListId: 1, Position: "a0"}
+
+	tests := []struct {
+		name             string
+		in               *pb.MoveTodoItemRequest
+		mockRepoSetup    func()
+		expectedStatus   int
+		expectedError    string
+		expectedPosition string
+	}{
+		{
+			name: "Success",
+			in:   &pb.MoveTodoItemRequest{UserId: 1, Id: 1, AfterId: 2, BeforeId: 3},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).Return(item, int64(1), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+				itemRepo.EXPECT().Move(item, int64(2), int64(3), gomock.Any()).DoAndReturn(func(item *domain.TodoItem, afterId, beforeId int64, _ *domain.Activity) error {
+					item.Position = "a1V"
+					return nil
+				})
+			},
+			expectedStatus:   http.StatusOK,
+			expectedPosition: "a1V",
+		},
+		{
+			name:           "Next to itself",
+			in:             &pb.MoveTodoItemRequest{UserId: 1, Id: 1, AfterId: 1},
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  errMoveSelf,
+		},
+		{
+			name: "Item not found",
+			in:   &pb.MoveTodoItemRequest{UserId: 1, Id: 1, AfterId: 2},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).Return(nil, int64(0), repository.ErrTodoItemNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  errItemNotFound,
+		},
+		{
+			name: "Viewer",
+			in:   &pb.MoveTodoItemRequest{UserId: 2, Id: 1, AfterId: 2},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(2), int64(1)).Return(item, int64(1), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(2), int64(1), domain.RoleEditor).Return(repository.ErrInsufficientRole)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  errRole,
+		},
+		{
+			name: "Neighbour in another list",
+			in:   &pb.MoveTodoItemRequest{UserId: 1, Id: 1, BeforeId: 9},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).Return(item, int64(1), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+				itemRepo.EXPECT().Move(item, int64(0), int64(9), gomock.Any()).Return(repository.ErrNeighbourNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  errNeighbour,
+		},
+		{
+			name: "Neighbours out of order",
+			in:   &pb.MoveTodoItemRequest{UserId: 1, Id: 1, AfterId: 3, BeforeId: 2},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).Return(item, int64(1), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+				itemRepo.EXPECT().Move(item, int64(3), int64(2), gomock.Any()).Return(repository.ErrNeighbourOrder)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  errNeighbourOrder,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.MoveTodoItem(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			assert.Equal(t, tt.expectedError, resp.Error)
+			if tt.expectedError == "" {
+				assert.Equal(t, tt.expectedPosition, resp.Item.Position)
+			}
+		})
+	}
+}
+
+func TestServer_MoveTodoItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepo := mock_repository.NewMockTodoItem(ctrl)
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	serv := &Server{
+		ItemRepo: itemRepo,
+		ListRepo: listRepo,
+		Mapper:   utils.NewMapper(),
+	}
+
+	tests := []struct {
+		name           string
+		in             *pb.MoveTodoItemsRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+		expectedItems  int
+	}{
+		{
+			name: "Success",
+			in:   &pb.MoveTodoItemsRequest{UserId: 1, Ids: []int64{1, 2}, ListId: 2},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(2)).Return(nil)
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).Return(&domain.TodoItem{Id: 1, ListId: 1}, int64(1), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+				itemRepo.EXPECT().GetById(int64(1), int64(2)).Return(&domain.TodoItem{Id: 2, ListId: 1}, int64(1), nil)
+				itemRepo.EXPECT().MoveToList(int64(1), []int64{1, 2}, int64(2), gomock.Any()).Return([]*domain.TodoItem{
+					{Id: 1, ListId: 2, Position: "a0"},
+					{Id: 2, ListId: 2, Position: "a1"},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedItems:  2,
+		},
+		{
+			name:           "No items",
+			in:             &pb.MoveTodoItemsRequest{UserId: 1, ListId: 2},
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  errNoItems,
+		},
+		{
+			name:           "Too many items",
+			in:             &pb.MoveTodoItemsRequest{UserId: 1, Ids: make([]int64, maxTransferItems+1), ListId: 2},
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  errTooManyItems,
+		},
+		{
+			name: "Viewer of the destination",
+			in:   &pb.MoveTodoItemsRequest{UserId: 1, Ids: []int64{1}, ListId: 2},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleEditor).Return(repository.ErrInsufficientRole)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  errRole,
+		},
+		{
+			name: "Viewer of the source",
+			in:   &pb.MoveTodoItemsRequest{UserId: 1, Ids: []int64{1}, ListId: 2},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(2)).Return(nil)
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).Return(&domain.TodoItem{Id: 1, ListId: 3}, int64(3), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(3), domain.RoleEditor).Return(repository.ErrInsufficientRole)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  errRole,
+		},
+		{
+			name: "Archived source",
+			in:   &pb.MoveTodoItemsRequest{UserId: 1, Ids: []int64{1}, ListId: 2},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(2)).Return(nil)
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).Return(&domain.TodoItem{Id: 1, ListId: 3}, int64(3), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(3), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(3)).Return(repository.ErrListArchived)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedError:  errListArchived,
+		},
+		{
+			name: "Item not found",
+			in:   &pb.MoveTodoItemsRequest{UserId: 1, Ids: []int64{1}, ListId: 2},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(2)).Return(nil)
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).Return(nil, int64(0), repository.ErrTodoItemNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  errItemNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.MoveTodoItems(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			assert.Equal(t, tt.expectedError, resp.Error)
+			assert.Len(t, resp.Items, tt.expectedItems)
+		})
+	}
+}
+
+func TestServer_CopyTodoItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepo := mock_repository.NewMockTodoItem(ctrl)
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	serv := &Server{
+		ItemRepo: itemRepo,
+		ListRepo: listRepo,
+		Mapper:   utils.NewMapper(),
+	}
+
+	tests := []struct {
+		name           string
+		in             *pb.CopyTodoItemsRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+		expectedItems  []*pb.TodoItem
+	}{
+		{
+			name: "Success",
+			in:   &pb.CopyTodoItemsRequest{UserId: 1, Ids: []int64{1}, ListId: 2},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(2)).Return(nil)
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).Return(&domain.TodoItem{Id: 1, ListId: 1}, int64(1), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				itemRepo.EXPECT().CopyToList(int64(1), []int64{1}, int64(2), gomock.Any()).Return([]*domain.TodoItem{
+					{Id: 5, Title: "Copy", ListId: 2, Position: "a0", Tags: []domain.Tag{{Id: 1, Name: "work"}}},
+				}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			expectedItems: []*pb.TodoItem{
+				{Id: 5, Title: "Copy", ListId: 2, Position: "a0", Tags: []*pb.Tag{{Id: 1, Name: "work"}}},
+			},
+		},
+		{
+			name: "Source archived",
+			in:   &pb.CopyTodoItemsRequest{UserId: 1, Ids: []int64{1}, ListId: 1},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(repository.ErrListArchived)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedError:  errListArchived,
+		},
+		{
+			name: "Database error",
+			in:   &pb.CopyTodoItemsRequest{UserId: 1, Ids: []int64{1}, ListId: 1},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).Return(&domain.TodoItem{Id: 1, ListId: 1}, int64(1), nil)
+				itemRepo.EXPECT().CopyToList(int64(1), []int64{1}, int64(1), gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "db error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.CopyTodoItems(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			assert.Equal(t, tt.expectedError, resp.Error)
+			assert.Equal(t, tt.expectedItems, resp.Items)
+		})
+	}
+}
+
+func TestServer_BatchUpdateTodoItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepo := mock_repository.NewMockTodoItem(ctrl)
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	serv := &Server{
+		ItemRepo: itemRepo,
+		ListRepo: listRepo,
+		Mapper:   utils.NewMapper(),
+	}
+
+	dueAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	priority := pb.Priority_PRIORITY_HIGH
+	invalidPriority := pb.Priority(9)
+
+	tests := []struct {
+		name             string
+		in               *pb.BatchUpdateTodoItemsRequest
+		mockRepoSetup    func()
+		expectedStatus   int
+		expectedError    string
+		expectedStatuses []int
+	}{
+		{
+			name: "Complete items of two lists",
+			in: &pb.BatchUpdateTodoItemsRequest{
+				UserId:    1,
+				Ids:       []int64{1, 2, 3, 1},
+				Operation: pb.BatchOperation_BATCH_OPERATION_COMPLETE,
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetByIds(int64(1), []int64{1, 2, 3}).Return([]*domain.TodoItem{
+					{Id: 1, ListId: 1},
+					{Id: 2, ListId: 1},
+					{Id: 3, ListId: 2},
+				}, nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(2)).Return(nil)
+				itemRepo.EXPECT().ApplyBatch(gomock.Any(), gomock.Any()).DoAndReturn(func(batch *domain.ItemBatch, _ *domain.Activity) error {
+					assert.Len(t, batch.Save, 3)
+					for _, item := range batch.Save {
+						assert.True(t, item.Done)
+					}
+					assert.Empty(t, batch.Next)
+					return nil
+				})
+			},
+			expectedStatus:   http.StatusOK,
+			expectedStatuses: []int{http.StatusOK, http.StatusOK, http.StatusOK},
+		},
+		{
+			name: "Complete recurring item",
+			in: &pb.BatchUpdateTodoItemsRequest{
+				UserId:    1,
+				Ids:       []int64{1},
+				Operation: pb.BatchOperation_BATCH_OPERATION_COMPLETE,
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetByIds(int64(1), []int64{1}).Return([]*domain.TodoItem{
+					{Id: 1, ListId: 1, DueAt: &dueAt, Recurrence: "FREQ=DAILY"},
+				}, nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+				itemRepo.EXPECT().ApplyBatch(gomock.Any(), gomock.Any()).DoAndReturn(func(batch *domain.ItemBatch, _ *domain.Activity) error {
+					assert.Equal(t, dueAt.AddDate(0, 0, 1), *batch.Next[1].DueAt)
+					return nil
+				})
+			},
+			expectedStatus:   http.StatusOK,
+			expectedStatuses: []int{http.StatusOK},
+		},
+		{
+			name: "Delete items",
+			in: &pb.BatchUpdateTodoItemsRequest{
+				UserId:    1,
+				Ids:       []int64{1, 2},
+				Operation: pb.BatchOperation_BATCH_OPERATION_DELETE,
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetByIds(int64(1), []int64{1, 2}).Return([]*domain.TodoItem{
+					{Id: 1, ListId: 1, Done: true},
+					{Id: 2, ListId: 1, Done: true},
+				}, nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+				itemRepo.EXPECT().ApplyBatch(gomock.Any(), gomock.Any()).DoAndReturn(func(batch *domain.ItemBatch, _ *domain.Activity) error {
+					assert.Equal(t, []int64{1, 2}, batch.Delete)
+					assert.Empty(t, batch.Save)
+					return nil
+				})
+			},
+			expectedStatus:   http.StatusOK,
+			expectedStatuses: []int{http.StatusOK, http.StatusOK},
+		},
+		{
+			name: "Set fields",
+			in: &pb.BatchUpdateTodoItemsRequest{
+				UserId:    1,
+				Ids:       []int64{1},
+				Operation: pb.BatchOperation_BATCH_OPERATION_SET_FIELDS,
+				Fields:    &pb.BatchItemFields{DueAt: timestamppb.New(dueAt), Priority: &priority},
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetByIds(int64(1), []int64{1}).Return([]*domain.TodoItem{{Id: 1, ListId: 1}}, nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+				itemRepo.EXPECT().ApplyBatch(gomock.Any(), gomock.Any()).DoAndReturn(func(batch *domain.ItemBatch, _ *domain.Activity) error {
+					assert.Equal(t, domain.PriorityHigh, batch.Save[0].Priority)
+					assert.Equal(t, dueAt, *batch.Save[0].DueAt)
+					return nil
+				})
+			},
+			expectedStatus:   http.StatusOK,
+			expectedStatuses: []int{http.StatusOK},
+		},
+		{
+			name: "Invalid field fails the batch",
+			in: &pb.BatchUpdateTodoItemsRequest{
+				UserId:    1,
+				Ids:       []int64{1, 2},
+				Operation: pb.BatchOperation_BATCH_OPERATION_SET_FIELDS,
+				Fields:    &pb.BatchItemFields{Priority: &invalidPriority},
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetByIds(int64(1), []int64{1, 2}).Return([]*domain.TodoItem{
+					{Id: 1, ListId: 1},
+					{Id: 2, ListId: 1},
+				}, nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedError:    errBatchFailed,
+			expectedStatuses: []int{http.StatusBadRequest, http.StatusBadRequest},
+		},
+		{
+			name: "Missing item fails the batch",
+			in: &pb.BatchUpdateTodoItemsRequest{
+				UserId:    1,
+				Ids:       []int64{1, 2},
+				Operation: pb.BatchOperation_BATCH_OPERATION_UNCOMPLETE,
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetByIds(int64(1), []int64{1, 2}).Return([]*domain.TodoItem{{Id: 2, ListId: 1}}, nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+			},
+			expectedStatus:   http.StatusNotFound,
+			expectedError:    errBatchFailed,
+			expectedStatuses: []int{http.StatusNotFound, http.StatusFailedDependency},
+		},
+		{
+			name: "Archived list fails the batch",
+			in: &pb.BatchUpdateTodoItemsRequest{
+				UserId:    1,
+				Ids:       []int64{1, 2},
+				Operation: pb.BatchOperation_BATCH_OPERATION_COMPLETE,
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetByIds(int64(1), []int64{1, 2}).Return([]*domain.TodoItem{
+					{Id: 1, ListId: 1},
+					{Id: 2, ListId: 2},
+				}, nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(2)).Return(repository.ErrListArchived)
+			},
+			expectedStatus:   http.StatusConflict,
+			expectedError:    errBatchFailed,
+			expectedStatuses: []int{http.StatusFailedDependency, http.StatusConflict},
+		},
+		{
+			name: "Repository error",
+			in: &pb.BatchUpdateTodoItemsRequest{
+				UserId:    1,
+				Ids:       []int64{1},
+				Operation: pb.BatchOperation_BATCH_OPERATION_COMPLETE,
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetByIds(int64(1), []int64{1}).Return([]*domain.TodoItem{{Id: 1, ListId: 1}}, nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(1)).Return(nil)
+				itemRepo.EXPECT().ApplyBatch(gomock.Any(), gomock.Any()).Return(errors.New("error applying batch"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "error applying batch",
+		},
+		{
+			name: "No items",
+			in: &pb.BatchUpdateTodoItemsRequest{
+				UserId:    1,
+				Operation: pb.BatchOperation_BATCH_OPERATION_COMPLETE,
+			},
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  errNoItems,
+		},
+		{
+			name: "Too many items",
+			in: &pb.BatchUpdateTodoItemsRequest{
+				UserId:    1,
+				Ids:       make([]int64, maxBatchItems+1),
+				Operation: pb.BatchOperation_BATCH_OPERATION_COMPLETE,
+			},
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  errTooManyBatch,
+		},
+		{
+			name:           "Unknown operation",
+			in:             &pb.BatchUpdateTodoItemsRequest{UserId: 1, Ids: []int64{1}},
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  errBatchOperation,
+		},
+		{
+			name: "Set fields without fields",
+			in: &pb.BatchUpdateTodoItemsRequest{
+				UserId:    1,
+				Ids:       []int64{1},
+				Operation: pb.BatchOperation_BATCH_OPERATION_SET_FIELDS,
+			},
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  errBatchFields,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.BatchUpdateTodoItems(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			assert.Equal(t, tt.expectedError, resp.Error)
+
+			var statuses []int
+			for _, result := range resp.Results {
+				statuses = append(statuses, int(result.Status))
+			}
+			assert.Equal(t, tt.expectedStatuses, statuses)
+		})
+	}
+}
+
+func TestServer_GetDueItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepo := mock_repository.NewMockTodoItem(ctrl)
+	serv := &Server{
+		ItemRepo: itemRepo,
+		Mapper:   utils.NewMapper(),
+	}
+
+	after := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2024, 10, 8, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		in             *pb.GetDueItemsRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+		expectedItems  int
+	}{
+		{
+			name: "Success",
+			in: &pb.GetDueItemsRequest{
+				UserId:    1,
+				DueAfter:  timestamppb.New(after),
+				DueBefore: timestamppb.New(before),
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetDue(int64(1), &after, &before, false).
+					Return([]*domain.TodoItem{{Id: 1, Title: "Pay invoices", DueAt: &after, ListId: 1}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedItems:  1,
+		},
+		{
+			name: "Invalid range",
+			in: &pb.GetDueItemsRequest{
+				UserId:    1,
+				DueAfter:  timestamppb.New(before),
+				DueBefore: timestamppb.New(after),
+			},
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "due_after must be before due_before",
+		},
+		{
+			name: "Repository error",
+			in: &pb.GetDueItemsRequest{
+				UserId:           1,
+				IncludeCompleted: true,
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetDue(int64(1), nil, nil, true).Return(nil, errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "db error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.GetDueItems(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, resp.Error)
+			} else {
+				assert.Len(t, resp.Items, tt.expectedItems)
+			}
+		})
+	}
+}
+
+func TestServer_GetOverdueItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepo := mock_repository.NewMockTodoItem(ctrl)
+	serv := &Server{
+		ItemRepo: itemRepo,
+		Mapper:   utils.NewMapper(),
+	}
+
+	due := time.Date(2024, 10, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		in             *pb.GetOverdueItemsRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name: "Success",
+			in: &pb.GetOverdueItemsRequest{
+				UserId: 1,
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetDue(int64(1), nil, gomock.Not(gomock.Nil()), false).
+					Return([]*domain.TodoItem{{Id: 1, Title: "Pay invoices", DueAt: &due, ListId: 1}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Repository error",
+			in: &pb.GetOverdueItemsRequest{
+				UserId: 1,
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetDue(int64(1), nil, gomock.Any(), false).Return(nil, errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "db error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.GetOverdueItems(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, resp.Error)
+			} else {
+				assert.Equal(t, due.Unix(), resp.Items[0].DueAt.Seconds)
+			}
+		})
+	}
+}
+
+func TestServer_SearchItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepo := mock_repository.NewMockTodoItem(ctrl)
+	serv := &Server{
+		ItemRepo: itemRepo,
+		Mapper:   utils.NewMapper(),
+	}
+
+	tests := []struct {
+		name            string
+		in              *pb.SearchItemsRequest
+		mockRepoSetup   func()
+		expectedStatus  int
+		expectedError   string
+		expectedResults []*pb.SearchResult
+	}{
+		{
+			name: "Success",
+			in:   &pb.SearchItemsRequest{UserId: 1, Query: " invoices "},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().Search(int64(1), "invoices", defaultResults).Return([]*domain.SearchResult{
+					{
+						Item:      domain.TodoItem{Id: 3, Title: "Send invoice", ListId: 2},
+						ListTitle: "Work",
+						Rank:      0.6,
+						Snippet:   "Send <mark>invoice</mark>",
+					},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedResults: []*pb.SearchResult{
+				{
+					Item:    &pb.TodoItem{Id: 3, Title: "Send invoice", ListId: 2},
+					List:    &pb.TodoList{Id: 2, Title: "Work"},
+					Snippet: "Send <mark>invoice</mark>",
+					Rank:    0.6,
+				},
+			},
+		},
+		{
+			name: "Limit is capped",
+			in:   &pb.SearchItemsRequest{UserId: 1, Query: "invoice", Limit: 1000},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().Search(int64(1), "invoice", maxResults).Return(nil, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Empty query",
+			in:             &pb.SearchItemsRequest{UserId: 1, Query: "  "},
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Search query must not be empty",
+		},
+		{
+			name: "Database error",
+			in:   &pb.SearchItemsRequest{UserId: 1, Query: "invoice"},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().Search(int64(1), "invoice", defaultResults).Return(nil, errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "db error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.SearchItems(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, resp.Error)
+			} else {
+				assert.Equal(t, tt.expectedResults, resp.Results)
+			}
+		})
+	}
+}
+
+func TestServer_GetUpcomingOccurrences(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepo := mock_repository.NewMockTodoItem(ctrl)
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	serv := &Server{
+		ItemRepo: itemRepo,
+		ListRepo: listRepo,
+		Mapper:   utils.NewMapper(),
+	}
+
+	first := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	due := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name                string
+		in                  *pb.GetUpcomingOccurrencesRequest
+		mockRepoSetup       func()
+		expectedStatus      int
+		expectedError       string
+		expectedOccurrences []time.Time
+	}{
+		{
+			name: "Item series",
+			in: &pb.GetUpcomingOccurrencesRequest{
+				UserId: 1,
+				ItemId: 1,
+				Limit:  3,
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).
+					Return(&domain.TodoItem{Id: 1, DueAt: &due, Recurrence: "FREQ=WEEKLY;COUNT=4", RecurrenceStart: &first, ListId: 1}, int64(1), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleViewer).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedOccurrences: []time.Time{
+				time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 22, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Preview keeps local time across DST",
+			in: &pb.GetUpcomingOccurrencesRequest{
+				UserId:          1,
+				Recurrence:      "FREQ=WEEKLY",
+				DueAt:           timestamppb.New(time.Date(2024, 3, 25, 7, 0, 0, 0, time.UTC)),
+				TimeZone:        "Europe/Kyiv",
+				RecurrenceCount: 2,
+			},
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusOK,
+			expectedOccurrences: []time.Time{
+				time.Date(2024, 3, 25, 7, 0, 0, 0, time.UTC),
+				time.Date(2024, 4, 1, 6, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Due date outside the rule is the first occurrence",
+			in: &pb.GetUpcomingOccurrencesRequest{
+				UserId:          1,
+				Recurrence:      "FREQ=WEEKLY;BYDAY=MO",
+				DueAt:           timestamppb.New(time.Date(2024, 1, 3, 9, 0, 0, 0, time.UTC)),
+				RecurrenceUntil: timestamppb.New(time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC)),
+			},
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusOK,
+			expectedOccurrences: []time.Time{
+				time.Date(2024, 1, 3, 9, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "Item does not repeat",
+			in: &pb.GetUpcomingOccurrencesRequest{
+				UserId: 1,
+				ItemId: 1,
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(1)).Return(&domain.TodoItem{Id: 1, DueAt: &due, ListId: 1}, int64(1), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(1), domain.RoleViewer).Return(nil)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Item does not repeat",
+		},
+		{
+			name: "Forbidden",
+			in: &pb.GetUpcomingOccurrencesRequest{
+				UserId: 2,
+				ItemId: 1,
+			},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(2), int64(1)).
+					Return(&domain.TodoItem{Id: 1, DueAt: &due, Recurrence: "FREQ=WEEKLY", ListId: 1}, int64(1), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(2), int64(1), domain.RoleViewer).Return(repository.ErrUsersListNotFound)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  "User does not have access to this list",
+		},
+		{
+			name: "Preview without due date",
+			in: &pb.GetUpcomingOccurrencesRequest{
+				UserId:     1,
+				Recurrence: "FREQ=DAILY",
+			},
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Recurring items need a due date",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.GetUpcomingOccurrences(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, resp.Error)
+			}
+			assert.Equal(t, len(tt.expectedOccurrences), len(resp.Occurrences))
+			for i, occurrence := range tt.expectedOccurrences {
+				assert.True(t, occurrence.Equal(resp.Occurrences[i].AsTime()))
+			}
+		})
+	}
+}
+
+func TestServer_CreateTag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tagRepo := mock_repository.NewMockTag(ctrl)
+	serv := &Server{
+		TagRepo: tagRepo,
+	}
+
+	tests := []struct {
+		name           string
+		in             *pb.CreateTagRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name: "Success",
+			in: &pb.CreateTagRequest{
+				UserId: 1,
+				Name:   "  waiting-on ",
+			},
+			mockRepoSetup: func() {
+				tagRepo.EXPECT().Create(&domain.Tag{UserId: 1, Name: "waiting-on"}).Return(nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name: "Empty name",
+			in: &pb.CreateTagRequest{
+				UserId: 1,
+				Name:   "   ",
+			},
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Tag name must be between 1 and 64 characters",
+		},
+		{
+			name: "Duplicate name",
+			in: &pb.CreateTagRequest{
+				UserId: 1,
+				Name:   "bug",
+			},
+			mockRepoSetup: func() {
+				tagRepo.EXPECT().Create(&domain.Tag{UserId: 1, Name: "bug"}).Return(repository.ErrTagExists)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedError:  "tag with this name already exists",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.CreateTag(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, resp.Error)
+			} else {
+				assert.Equal(t, "waiting-on", resp.Tag.Name)
+			}
+		})
+	}
+}
+
+func TestServer_UpdateTag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tagRepo := mock_repository.NewMockTag(ctrl)
+	serv := &Server{
+		TagRepo: tagRepo,
+	}
+
+	tests := []struct {
+		name           string
+		in             *pb.UpdateTagRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name: "Success",
+			in: &pb.UpdateTagRequest{
+				UserId: 1,
+				Id:     3,
+				Name:   "blocked",
+			},
+			mockRepoSetup: func() {
+				tagRepo.EXPECT().GetById(int64(3)).Return(&domain.Tag{Id: 3, UserId: 1, Name: "bug"}, nil)
+				tagRepo.EXPECT().Update(&domain.Tag{Id: 3, UserId: 1, Name: "blocked"}).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Tag of another user",
+			in: &pb.UpdateTagRequest{
+				UserId: 2,
+				Id:     3,
+				Name:   "blocked",
+			},
+			mockRepoSetup: func() {
+				tagRepo.EXPECT().GetById(int64(3)).Return(&domain.Tag{Id: 3, UserId: 1, Name: "bug"}, nil)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  "Tag not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.UpdateTag(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, resp.Error)
+			}
+		})
+	}
+}
+
+func TestServer_DeleteTag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tagRepo := mock_repository.NewMockTag(ctrl)
+	serv := &Server{
+		TagRepo: tagRepo,
+	}
+
+	tests := []struct {
+		name           string
+		in             *pb.DeleteTagRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name: "Success",
+			in: &pb.DeleteTagRequest{
+				UserId: 1,
+				Id:     3,
+			},
+			mockRepoSetup: func() {
+				tagRepo.EXPECT().GetById(int64(3)).Return(&domain.Tag{Id: 3, UserId: 1, Name: "bug"}, nil)
+				tagRepo.EXPECT().Delete(int64(3)).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Not found",
+			in: &pb.DeleteTagRequest{
+				UserId: 1,
+				Id:     3,
+			},
+			mockRepoSetup: func() {
+				tagRepo.EXPECT().GetById(int64(3)).Return(nil, repository.ErrTagNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  "Tag not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.DeleteTag(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, resp.Error)
+			}
+		})
+	}
+}
+
+func TestServer_AttachTag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tagRepo := mock_repository.NewMockTag(ctrl)
+	itemRepo := mock_repository.NewMockTodoItem(ctrl)
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	serv := &Server{
+		TagRepo:  tagRepo,
+		ItemRepo: itemRepo,
+		ListRepo: listRepo,
+	}
+
+	tests := []struct {
+		name           string
+		in             *pb.AttachTagRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name: "Success",
+			in: &pb.AttachTagRequest{
+				UserId: 1,
+				ItemId: 5,
+				TagId:  3,
+			},
+			mockRepoSetup: func() {
+				tagRepo.EXPECT().GetById(int64(3)).Return(&domain.Tag{Id: 3, UserId: 1, Name: "bug"}, nil)
+				itemRepo.EXPECT().GetById(int64(1), int64(5)).Return(&domain.TodoItem{Id: 5, ListId: 2}, int64(2), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(2)).Return(nil)
+				tagRepo.EXPECT().Attach(int64(5), int64(3), gomock.Any()).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "No access to item",
+			in: &pb.AttachTagRequest{
+				UserId: 1,
+				ItemId: 5,
+				TagId:  3,
+			},
+			mockRepoSetup: func() {
+				tagRepo.EXPECT().GetById(int64(3)).Return(&domain.Tag{Id: 3, UserId: 1, Name: "bug"}, nil)
+				itemRepo.EXPECT().GetById(int64(1), int64(5)).Return(&domain.TodoItem{Id: 5, ListId: 2}, int64(2), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleEditor).Return(errors.New("no access"))
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  "User does not have access to this list",
+		},
+		{
+			name: "Item not found",
+			in: &pb.AttachTagRequest{
+				UserId: 1,
+				ItemId: 5,
+				TagId:  3,
+			},
+			mockRepoSetup: func() {
+				tagRepo.EXPECT().GetById(int64(3)).Return(&domain.Tag{Id: 3, UserId: 1, Name: "bug"}, nil)
+				itemRepo.EXPECT().GetById(int64(1), int64(5)).Return(nil, int64(0), repository.ErrTodoItemNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  "Item not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.AttachTag(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			if tt.expectedError != "" {
+				assert.Equal(t, tt.expectedError, resp.Error)
+			}
+		})
+	}
+}
+
+func TestServer_AddComment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepo := mock_repository.NewMockTodoItem(ctrl)
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	commentRepo := mock_repository.NewMockComment(ctrl)
+	serv := &Server{
+		ItemRepo:    itemRepo,
+		ListRepo:    listRepo,
+		CommentRepo: commentRepo,
+		Mapper:      utils.NewMapper(),
+	}
+
+	tests := []struct {
+		name           string
+		in             *pb.AddCommentRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name: "Success",
+			in:   &pb.AddCommentRequest{UserId: 1, ItemId: 5, Body: "  Done on staging  "},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(5)).Return(&domain.TodoItem{Id: 5, ListId: 2}, int64(2), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleViewer).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(2)).Return(nil)
+				commentRepo.EXPECT().Create(&domain.Comment{ItemId: 5, AuthorId: 1, Body: "Done on staging"}, gomock.Any()).Return(nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "Empty body",
+			in:             &pb.AddCommentRequest{UserId: 1, ItemId: 5, Body: "   "},
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  errCommentBody,
+		},
+		{
+			name: "Not a member",
+			in:   &pb.AddCommentRequest{UserId: 1, ItemId: 5, Body: "Hi"},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(5)).Return(&domain.TodoItem{Id: 5, ListId: 2}, int64(2), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleViewer).Return(repository.ErrUsersListNotFound)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  errForbidden,
+		},
+		{
+			name: "Archived list",
+			in:   &pb.AddCommentRequest{UserId: 1, ItemId: 5, Body: "Hi"},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(5)).Return(&domain.TodoItem{Id: 5, ListId: 2}, int64(2), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleViewer).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(2)).Return(repository.ErrListArchived)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedError:  errListArchived,
+		},
+		{
+			name: "Item not found",
+			in:   &pb.AddCommentRequest{UserId: 1, ItemId: 5, Body: "Hi"},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(5)).Return(nil, int64(0), repository.ErrTodoItemNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  errItemNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.AddComment(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			assert.Equal(t, tt.expectedError, resp.Error)
+		})
+	}
+}
+
+func TestServer_ListComments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepo := mock_repository.NewMockTodoItem(ctrl)
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	commentRepo := mock_repository.NewMockComment(ctrl)
+	serv := &Server{
+		ItemRepo:    itemRepo,
+		ListRepo:    listRepo,
+		CommentRepo: commentRepo,
+		Mapper:      utils.NewMapper(),
+	}
+
+	tests := []struct {
+		name              string
+		in                *pb.ListCommentsRequest
+		mockRepoSetup     func()
+		expectedStatus    int
+		expectedError     string
+		expectedComments  int
+		expectedPageToken string
+	}{
+		{
+			name: "First page",
+			in:   &pb.ListCommentsRequest{UserId: 1, ItemId: 5, PageSize: 2},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(5)).Return(&domain.TodoItem{Id: 5, ListId: 2}, int64(2), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleViewer).Return(nil)
+				commentRepo.EXPECT().GetAll(int64(5), 3, nil).Return([]*domain.Comment{
+					{Id: 1, ItemId: 5, AuthorId: 1, Body: "a"},
+					{Id: 2, ItemId: 5, AuthorId: 2, Body: "b"},
+					{Id: 3, ItemId: 5, AuthorId: 1, Body: "c"},
+				}, int64(3), nil)
+			},
+			expectedStatus:    http.StatusOK,
+			expectedComments:  2,
+			expectedPageToken: pageToken(domain.CommentCursor{Id: 2}),
+		},
+		{
+			name: "Next page",
+			in:   &pb.ListCommentsRequest{UserId: 1, ItemId: 5, PageSize: 2, PageToken: pageToken(domain.CommentCursor{Id: 2})},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(5)).Return(&domain.TodoItem{Id: 5, ListId: 2}, int64(2), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleViewer).Return(nil)
+				commentRepo.EXPECT().GetAll(int64(5), 3, &domain.CommentCursor{Id: 2}).Return([]*domain.Comment{
+					{Id: 3, ItemId: 5, AuthorId: 1, Body: "c"},
+				}, int64(3), nil)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedComments: 1,
+		},
+		{
+			name:           "Invalid page token",
+			in:             &pb.ListCommentsRequest{UserId: 1, ItemId: 5, PageToken: "not a token"},
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  errPageToken,
+		},
+		{
+			name: "Not a member",
+			in:   &pb.ListCommentsRequest{UserId: 1, ItemId: 5},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(5)).Return(&domain.TodoItem{Id: 5, ListId: 2}, int64(2), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleViewer).Return(repository.ErrUsersListNotFound)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  errForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.ListComments(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			assert.Equal(t, tt.expectedError, resp.Error)
+			assert.Len(t, resp.Comments, tt.expectedComments)
+			assert.Equal(t, tt.expectedPageToken, resp.NextPageToken)
+		})
+	}
+}
+
+func TestServer_EditComment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepo := mock_repository.NewMockTodoItem(ctrl)
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	commentRepo := mock_repository.NewMockComment(ctrl)
+	serv := &Server{
+		ItemRepo:    itemRepo,
+		ListRepo:    listRepo,
+		CommentRepo: commentRepo,
+		Mapper:      utils.NewMapper(),
+	}
+
+	tests := []struct {
+		name           string
+		in             *pb.EditCommentRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+		expectedEdited bool
+	}{
+		{
+			name: "Success",
+			in:   &pb.EditCommentRequest{UserId: 1, ItemId: 5, Id: 7, Body: "Fixed"},
+			mockRepoSetup: func() {
+				commentRepo.EXPECT().GetById(int64(7)).Return(&domain.Comment{Id: 7, ItemId: 5, AuthorId: 1, Body: "Fixd"}, nil)
+				itemRepo.EXPECT().GetById(int64(1), int64(5)).Return(&domain.TodoItem{Id: 5, ListId: 2}, int64(2), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleViewer).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(2)).Return(nil)
+				commentRepo.EXPECT().Update(&domain.Comment{Id: 7, ItemId: 5, AuthorId: 1, Body: "Fixed", Edited: true}, gomock.Any()).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedEdited: true,
+		},
+		{
+			name: "Unchanged body",
+			in:   &pb.EditCommentRequest{UserId: 1, ItemId: 5, Id: 7, Body: "Fixed"},
+			mockRepoSetup: func() {
+				commentRepo.EXPECT().GetById(int64(7)).Return(&domain.Comment{Id: 7, ItemId: 5, AuthorId: 1, Body: "Fixed"}, nil)
+				itemRepo.EXPECT().GetById(int64(1), int64(5)).Return(&domain.TodoItem{Id: 5, ListId: 2}, int64(2), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleViewer).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(2)).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Not the author",
+			in:   &pb.EditCommentRequest{UserId: 2, ItemId: 5, Id: 7, Body: "Fixed"},
+			mockRepoSetup: func() {
+				commentRepo.EXPECT().GetById(int64(7)).Return(&domain.Comment{Id: 7, ItemId: 5, AuthorId: 1, Body: "Fixd"}, nil)
+				itemRepo.EXPECT().GetById(int64(2), int64(5)).Return(&domain.TodoItem{Id: 5, ListId: 2}, int64(2), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(2), int64(2), domain.RoleViewer).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(2)).Return(nil)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  errCommentEdit,
+		},
+		{
+			name: "Comment of another item",
+			in:   &pb.EditCommentRequest{UserId: 1, ItemId: 6, Id: 7, Body: "Fixed"},
+			mockRepoSetup: func() {
+				commentRepo.EXPECT().GetById(int64(7)).Return(&domain.Comment{Id: 7, ItemId: 5, AuthorId: 1, Body: "Fixd"}, nil)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  errCommentNotFound,
+		},
+		{
+			name:           "Too long",
+			in:             &pb.EditCommentRequest{UserId: 1, ItemId: 5, Id: 7, Body: strings.Repeat("a", maxCommentLength+1)},
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  errCommentBody,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.EditComment(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			assert.Equal(t, tt.expectedError, resp.Error)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, tt.expectedEdited, resp.Comment.Edited)
+			}
+		})
+	}
+}
+
+func TestServer_DeleteComment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepo := mock_repository.NewMockTodoItem(ctrl)
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	commentRepo := mock_repository.NewMockComment(ctrl)
+	serv := &Server{
+		ItemRepo:    itemRepo,
+		ListRepo:    listRepo,
+		CommentRepo: commentRepo,
+		Mapper:      utils.NewMapper(),
+	}
+
+	tests := []struct {
+		name           string
+		in             *pb.DeleteCommentRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name: "Author",
+			in:   &pb.DeleteCommentRequest{UserId: 1, ItemId: 5, Id: 7},
+			mockRepoSetup: func() {
+				commentRepo.EXPECT().GetById(int64(7)).Return(&domain.Comment{Id: 7, ItemId: 5, AuthorId: 1}, nil)
+				itemRepo.EXPECT().GetById(int64(1), int64(5)).Return(&domain.TodoItem{Id: 5, ListId: 2}, int64(2), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleViewer).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(2)).Return(nil)
+				commentRepo.EXPECT().Delete(int64(7), gomock.Any()).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Owner of the list",
+			in:   &pb.DeleteCommentRequest{UserId: 3, ItemId: 5, Id: 7},
+			mockRepoSetup: func() {
+				commentRepo.EXPECT().GetById(int64(7)).Return(&domain.Comment{Id: 7, ItemId: 5, AuthorId: 1}, nil)
+				itemRepo.EXPECT().GetById(int64(3), int64(5)).Return(&domain.TodoItem{Id: 5, ListId: 2}, int64(2), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(3), int64(2), domain.RoleViewer).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(2)).Return(nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(3), int64(2), domain.RoleOwner).Return(nil)
+				commentRepo.EXPECT().Delete(int64(7), gomock.Any()).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Other member",
+			in:   &pb.DeleteCommentRequest{UserId: 3, ItemId: 5, Id: 7},
+			mockRepoSetup: func() {
+				commentRepo.EXPECT().GetById(int64(7)).Return(&domain.Comment{Id: 7, ItemId: 5, AuthorId: 1}, nil)
+				itemRepo.EXPECT().GetById(int64(3), int64(5)).Return(&domain.TodoItem{Id: 5, ListId: 2}, int64(2), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(3), int64(2), domain.RoleViewer).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(2)).Return(nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(3), int64(2), domain.RoleOwner).Return(repository.ErrInsufficientRole)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  errCommentDelete,
+		},
+		{
+			name: "Comment not found",
+			in:   &pb.DeleteCommentRequest{UserId: 1, ItemId: 5, Id: 7},
+			mockRepoSetup: func() {
+				commentRepo.EXPECT().GetById(int64(7)).Return(nil, repository.ErrCommentNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  errCommentNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.DeleteComment(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			assert.Equal(t, tt.expectedError, resp.Error)
+			assert.Equal(t, tt.expectedStatus == http.StatusOK, resp.Success)
+		})
+	}
+}
+
+// uploadStream feeds requests to UploadAttachment and keeps its response.
+type uploadStream struct {
+	grpc.ServerStream
+	requests []*pb.UploadAttachmentRequest
+	response *pb.UploadAttachmentResponse
+}
+
+func (s *uploadStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *uploadStream) Recv() (*pb.UploadAttachmentRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *uploadStream) SendAndClose(resp *pb.UploadAttachmentResponse) error {
+	s.response = resp
+	return nil
+}
+
+// downloadStream collects what DownloadAttachment sends.
+type downloadStream struct {
+	grpc.ServerStream
+	responses []*pb.DownloadAttachmentResponse
+}
+
+func (s *downloadStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *downloadStream) Send(resp *pb.DownloadAttachmentResponse) error {
+	s.responses = append(s.responses, resp)
+	return nil
+}
+
+func uploadRequests(info *pb.AttachmentInfo, chunks ...string) []*pb.UploadAttachmentRequest {
+	requests := []*pb.UploadAttachmentRequest{
+		{Data: &pb.UploadAttachmentRequest_Info{Info: info}},
+	}
+	for _, chunk := range chunks {
+		requests = append(requests, &pb.UploadAttachmentRequest{
+			Data: &pb.UploadAttachmentRequest_Chunk{Chunk: []byte(chunk)},
+		})
+	}
+	return requests
+}
+
+const pngHeader = "\x89PNG\r\n\x1a\n"
+
+func TestServer_UploadAttachment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepo := mock_repository.NewMockTodoItem(ctrl)
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	attachmentRepo := mock_repository.NewMockAttachment(ctrl)
+	blobs, err := storage.NewLocal(t.TempDir())
+	assert.NoError(t, err)
+	serv := &Server{
+		ItemRepo:       itemRepo,
+		ListRepo:       listRepo,
+		AttachmentRepo: attachmentRepo,
+		Storage:        blobs,
+		Attachments: AttachmentPolicy{
+			MaxSize:      64,
+			AllowedTypes: []string{"image/png", "text/plain"},
+		},
+		Mapper: utils.NewMapper(),
+	}
+
+	info := &pb.AttachmentInfo{UserId: 1, ItemId: 5, FileName: "../shots/screen.png"}
+	editable := func() {
+		itemRepo.EXPECT().GetById(int64(1), int64(5)).Return(&domain.TodoItem{Id: 5, ListId: 2}, int64(2), nil)
+		listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleEditor).Return(nil)
+		listRepo.EXPECT().CheckNotArchived(int64(2)).Return(nil)
+	}
+
+	var stored *domain.Attachment
+	tests := []struct {
+		name           string
+		requests       []*pb.UploadAttachmentRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name:     "Success",
+			requests: uploadRequests(info, pngHeader, "pixels", "more pixels"),
+			mockRepoSetup: func() {
+				editable()
+				attachmentRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(attachment *domain.Attachment, _ *domain.Activity) error {
+					assert.Equal(t, "screen.png", attachment.FileName)
+					assert.Equal(t, "image/png", attachment.ContentType)
+					assert.Equal(t, int64(len(pngHeader)+17), attachment.Size)
+					assert.Equal(t, int64(1), attachment.UploaderId)
+					stored = attachment
+					return nil
+				})
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:     "Type not allowed",
+			requests: uploadRequests(info, "<html><script>alert(1)</script></html>"),
+			mockRepoSetup: func() {
+				editable()
+			},
+			expectedStatus: http.StatusUnsupportedMediaType,
+			expectedError:  "Files of type text/html cannot be attached",
+		},
+		{
+			name:     "Too large",
+			requests: uploadRequests(info, pngHeader, strings.Repeat("x", 60)),
+			mockRepoSetup: func() {
+				editable()
+			},
+			expectedStatus: http.StatusRequestEntityTooLarge,
+			expectedError:  "Attachments can be at most 64 bytes",
+		},
+		{
+			name:     "Empty file",
+			requests: uploadRequests(info),
+			mockRepoSetup: func() {
+				editable()
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  errAttachmentEmpty,
+		},
+		{
+			name:           "Chunk before info",
+			requests:       uploadRequests(info, pngHeader)[1:],
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  errAttachmentInfo,
+		},
+		{
+			name:           "Missing file name",
+			requests:       uploadRequests(&pb.AttachmentInfo{UserId: 1, ItemId: 5, FileName: "shots/"}, pngHeader),
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  errFileName,
+		},
+		{
+			name:     "Viewer",
+			requests: uploadRequests(info, pngHeader),
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(5)).Return(&domain.TodoItem{Id: 5, ListId: 2}, int64(2), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleEditor).Return(repository.ErrInsufficientRole)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  errRole,
+		},
+		{
+			name:     "Repository error",
+			requests: uploadRequests(info, "plain text"),
+			mockRepoSetup: func() {
+				editable()
+				attachmentRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(attachment *domain.Attachment, _ *domain.Activity) error {
+					assert.Equal(t, "text/plain; charset=utf-8", attachment.ContentType)
+					stored = attachment
+					return errors.New("error saving attachment")
+				})
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "error saving attachment",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stored = nil
+			tt.mockRepoSetup()
+			stream := &uploadStream{requests: tt.requests}
+			err := serv.UploadAttachment(stream)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, int(stream.response.Status))
+			assert.Equal(t, tt.expectedError, stream.response.Error)
+
+			if stored == nil {
+				return
+			}
+			blob, err := blobs.Get(context.Background(), stored.StorageKey)
+			if tt.expectedStatus == http.StatusCreated {
+				assert.NoError(t, err)
+				content, _ := io.ReadAll(blob)
+				blob.Close()
+				assert.Equal(t, pngHeader+"pixelsmore pixels", string(content))
+			} else {
+				assert.ErrorIs(t, err, storage.ErrNotFound)
+			}
+		})
+	}
+}
+
+func TestServer_DownloadAttachment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepo := mock_repository.NewMockTodoItem(ctrl)
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	attachmentRepo := mock_repository.NewMockAttachment(ctrl)
+	blobs, err := storage.NewLocal(t.TempDir())
+	assert.NoError(t, err)
+	serv := &Server{
+		ItemRepo:       itemRepo,
+		ListRepo:       listRepo,
+		AttachmentRepo: attachmentRepo,
+		Storage:        blobs,
+		Mapper:         utils.NewMapper(),
+	}
+
+	content := strings.Repeat("0123456789", 7000)
+	err = blobs.Put(context.Background(), "items/5/abc", strings.NewReader(content), int64(len(content)), "text/plain")
+	assert.NoError(t, err)
+	attachment := &domain.Attachment{Id: 9, ItemId: 5, FileName: "notes.txt", ContentType: "text/plain", Size: int64(len(content)), StorageKey: "items/5/abc"}
+
+	tests := []struct {
+		name           string
+		in             *pb.DownloadAttachmentRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+		expectedChunks int
+	}{
+		{
+			name: "Success",
+			in:   &pb.DownloadAttachmentRequest{UserId: 1, ItemId: 5, Id: 9},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(5)).Return(&domain.TodoItem{Id: 5, ListId: 2}, int64(2), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleViewer).Return(nil)
+				attachmentRepo.EXPECT().GetById(int64(9)).Return(attachment, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedChunks: 2,
+		},
+		{
+			name: "Attachment of another item",
+			in:   &pb.DownloadAttachmentRequest{UserId: 1, ItemId: 6, Id: 9},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(6)).Return(&domain.TodoItem{Id: 6, ListId: 2}, int64(2), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleViewer).Return(nil)
+				attachmentRepo.EXPECT().GetById(int64(9)).Return(attachment, nil)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  errAttachmentFound,
+		},
+		{
+			name: "Not a member",
+			in:   &pb.DownloadAttachmentRequest{UserId: 1, ItemId: 5, Id: 9},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(5)).Return(&domain.TodoItem{Id: 5, ListId: 2}, int64(2), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleViewer).Return(repository.ErrUsersListNotFound)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  errForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			stream := &downloadStream{}
+			err := serv.DownloadAttachment(tt.in, stream)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, int(stream.responses[0].Status))
+			assert.Equal(t, tt.expectedError, stream.responses[0].Error)
+			assert.Len(t, stream.responses, 1+tt.expectedChunks)
+
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, "notes.txt", stream.responses[0].Attachment.FileName)
+				var received strings.Builder
+				for _, resp := range stream.responses[1:] {
+					received.Write(resp.Chunk)
+				}
+				assert.Equal(t, content, received.String())
+			}
+		})
+	}
+}
+
+func TestServer_ListAttachments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepo := mock_repository.NewMockTodoItem(ctrl)
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	attachmentRepo := mock_repository.NewMockAttachment(ctrl)
+	serv := &Server{
+		ItemRepo:       itemRepo,
+		ListRepo:       listRepo,
+		AttachmentRepo: attachmentRepo,
+		Mapper:         utils.NewMapper(),
+	}
+
+	tests := []struct {
+		name                string
+		in                  *pb.ListAttachmentsRequest
+		mockRepoSetup       func()
+		expectedStatus      int
+		expectedError       string
+		expectedAttachments int
+	}{
+		{
+			name: "Success",
+			in:   &pb.ListAttachmentsRequest{UserId: 1, ItemId: 5},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(5)).Return(&domain.TodoItem{Id: 5, ListId: 2}, int64(2), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleViewer).Return(nil)
+				attachmentRepo.EXPECT().GetAll(int64(5)).Return([]*domain.Attachment{
+					{Id: 1, ItemId: 5, FileName: "a.png"},
+					{Id: 2, ItemId: 5, FileName: "b.pdf"},
+				}, nil)
+			},
+			expectedStatus:      http.StatusOK,
+			expectedAttachments: 2,
+		},
+		{
+			name: "Item not found",
+			in:   &pb.ListAttachmentsRequest{UserId: 1, ItemId: 5},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(5)).Return(nil, int64(0), repository.ErrTodoItemNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  errItemNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.ListAttachments(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			assert.Equal(t, tt.expectedError, resp.Error)
+			assert.Len(t, resp.Attachments, tt.expectedAttachments)
+		})
+	}
+}
+
+func TestServer_DeleteAttachment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepo := mock_repository.NewMockTodoItem(ctrl)
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	attachmentRepo := mock_repository.NewMockAttachment(ctrl)
+	blobs, err := storage.NewLocal(t.TempDir())
+	assert.NoError(t, err)
+	serv := &Server{
+		ItemRepo:       itemRepo,
+		ListRepo:       listRepo,
+		AttachmentRepo: attachmentRepo,
+		Storage:        blobs,
+		Mapper:         utils.NewMapper(),
+	}
+
+	err = blobs.Put(context.Background(), "items/5/abc", strings.NewReader("hello"), 5, "text/plain")
+	assert.NoError(t, err)
+	attachment := &domain.Attachment{Id: 9, ItemId: 5, StorageKey: "items/5/abc"}
+
+	tests := []struct {
+		name           string
+		in             *pb.DeleteAttachmentRequest
+		mockRepoSetup  func()
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name: "Viewer",
+			in:   &pb.DeleteAttachmentRequest{UserId: 1, ItemId: 5, Id: 9},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(5)).Return(&domain.TodoItem{Id: 5, ListId: 2}, int64(2), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleEditor).Return(repository.ErrInsufficientRole)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  errRole,
+		},
+		{
+			name: "Success",
+			in:   &pb.DeleteAttachmentRequest{UserId: 1, ItemId: 5, Id: 9},
+			mockRepoSetup: func() {
+				itemRepo.EXPECT().GetById(int64(1), int64(5)).Return(&domain.TodoItem{Id: 5, ListId: 2}, int64(2), nil)
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(2)).Return(nil)
+				attachmentRepo.EXPECT().GetById(int64(9)).Return(attachment, nil)
+				attachmentRepo.EXPECT().Delete(int64(9), gomock.Any()).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.DeleteAttachment(context.Background(), tt.in)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, int(resp.Status))
+			assert.Equal(t, tt.expectedError, resp.Error)
+		})
+	}
+
+	_, err = blobs.Get(context.Background(), "items/5/abc")
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+}
+
+// exportStream collects what ExportLists sends.
+type exportStream struct {
+	grpc.ServerStream
+	responses []*pb.ExportListsResponse
+}
+
+func (s *exportStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *exportStream) Send(resp *pb.ExportListsResponse) error {
+	s.responses = append(s.responses, resp)
+	return nil
+}
+
+func TestServer_ExportLists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	transferRepo := mock_repository.NewMockTransfer(ctrl)
+	serv := &Server{
+		ListRepo:     listRepo,
+		TransferRepo: transferRepo,
+	}
+
+	lists := []*domain.TodoList{{
+		Title: "Groceries",
+		Items: []domain.TodoItem{{
+			Title:    "Milk",
+			Children: []*domain.TodoItem{{Title: "Oat", Done: true}},
+		}},
+	}}
+
+	tests := []struct {
+		name           string
+		in             *pb.ExportListsRequest
+		mockRepoSetup  func()
+		expectedStatus int64
+		expectedError  string
+		expectedType   string
+		expectedBody   string
+	}{
+		{
+			name: "Markdown",
+			in:   &pb.ExportListsRequest{UserId: 1, Format: "markdown", ListIds: []int64{2}},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleViewer).Return(nil)
+				transferRepo.EXPECT().GetLists(int64(1), []int64{2}).Return(lists, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedType:   "text/markdown; charset=utf-8",
+			expectedBody:   "# Groceries\n\n- [ ] Milk\n  - [x] Oat\n",
+		},
+		{
+			name:           "Unknown format",
+			in:             &pb.ExportListsRequest{UserId: 1, Format: "xml"},
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  errUnknownFormat,
+		},
+		{
+			name: "No access",
+			in:   &pb.ExportListsRequest{UserId: 1, Format: "json", ListIds: []int64{2}},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleViewer).Return(repository.ErrUsersListNotFound)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  errForbidden,
+		},
+		{
+			name: "Repository error",
+			in:   &pb.ExportListsRequest{UserId: 1, Format: "csv"},
+			mockRepoSetup: func() {
+				transferRepo.EXPECT().GetLists(int64(1), []int64(nil)).Return(nil, errors.New("error getting lists"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "error getting lists",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			stream := &exportStream{}
+			err := serv.ExportLists(tt.in, stream)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, stream.responses[0].Status)
+			assert.Equal(t, tt.expectedError, stream.responses[0].Error)
+			assert.Equal(t, tt.expectedType, stream.responses[0].ContentType)
+
+			var body strings.Builder
+			for _, resp := range stream.responses[1:] {
+				body.Write(resp.Chunk)
+			}
+			assert.Equal(t, tt.expectedBody, body.String())
+		})
+	}
+}
+
+// importStream feeds requests to ImportLists and keeps its response.
+type importStream struct {
+	grpc.ServerStream
+	requests []*pb.ImportListsRequest
+	response *pb.ImportListsResponse
+}
+
+func (s *importStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *importStream) Recv() (*pb.ImportListsRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *importStream) SendAndClose(resp *pb.ImportListsResponse) error {
+	s.response = resp
+	return nil
+}
+
+func importRequests(info *pb.ImportInfo, chunks ...string) []*pb.ImportListsRequest {
+	requests := []*pb.ImportListsRequest{
+		{Data: &pb.ImportListsRequest_Info{Info: info}},
+	}
+	for _, chunk := range chunks {
+		requests = append(requests, &pb.ImportListsRequest{
+			Data: &pb.ImportListsRequest_Chunk{Chunk: []byte(chunk)},
+		})
+	}
+	return requests
+}
+
+func TestServer_ImportLists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	transferRepo := mock_repository.NewMockTransfer(ctrl)
+	serv := &Server{
+		ListRepo:     listRepo,
+		TransferRepo: transferRepo,
+	}
+
+	markdown := "# Groceries\n\n- [ ] Milk\n  - [x] Oat\n- [ ] Bread\n"
+	ics := "BEGIN:VCALENDAR\r\n" +
+		"BEGIN:VTODO\r\nUID:a\r\nSUMMARY:Pay rent\r\nDUE:20240601T090000Z\r\nRRULE:FREQ=MONTHLY\r\nEND:VTODO\r\n" +
+		"BEGIN:VTODO\r\nUID:b\r\nSUMMARY:Transfer\r\nRELATED-TO:a\r\nEND:VTODO\r\n" +
+		"END:VCALENDAR\r\n"
+	editable := func() {
+		listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleEditor).Return(nil)
+		listRepo.EXPECT().CheckNotArchived(int64(2)).Return(nil)
+		listRepo.EXPECT().GetById(int64(2)).Return(&domain.TodoList{Id: 2, Title: "Bills"}, nil)
+	}
+
+	tests := []struct {
+		name           string
+		requests       []*pb.ImportListsRequest
+		mockRepoSetup  func()
+		expectedStatus int64
+		expectedError  string
+		expectedLists  []*pb.ImportedList
+	}{
+		{
+			name:     "Success",
+			requests: importRequests(&pb.ImportInfo{UserId: 1, FileName: "groceries.md"}, markdown[:20], markdown[20:]),
+			mockRepoSetup: func() {
+				transferRepo.EXPECT().Import(int64(1), gomock.Any(), gomock.Any()).DoAndReturn(func(_ int64, lists []*domain.TodoList, _ *domain.Activity) error {
+					assert.Len(t, lists, 1)
+					assert.Equal(t, "Oat", lists[0].Items[0].Children[0].Title)
+					lists[0].Id = 7
+					return nil
+				})
+			},
+			expectedStatus: http.StatusCreated,
+			expectedLists:  []*pb.ImportedList{{Id: 7, Title: "Groceries", ItemCount: 3}},
+		},
+		{
+			name:           "Dry run",
+			requests:       importRequests(&pb.ImportInfo{UserId: 1, Format: "markdown", DryRun: true}, markdown),
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusOK,
+			expectedLists:  []*pb.ImportedList{{Title: "Groceries", ItemCount: 3}},
+		},
+		{
+			name:     "Into a list",
+			requests: importRequests(&pb.ImportInfo{UserId: 1, FileName: "bills.ics", ListId: 2}, ics),
+			mockRepoSetup: func() {
+				editable()
+				transferRepo.EXPECT().ImportItems(int64(1), int64(2), gomock.Any(), gomock.Any()).DoAndReturn(func(_, _ int64, items []*domain.TodoItem, _ *domain.Activity) error {
+					assert.Len(t, items, 1)
+					assert.Equal(t, "FREQ=MONTHLY", items[0].Recurrence)
+					assert.Equal(t, items[0].DueAt, items[0].RecurrenceStart)
+					assert.Equal(t, "Transfer", items[0].Children[0].Title)
+					return nil
+				})
+			},
+			expectedStatus: http.StatusCreated,
+			expectedLists:  []*pb.ImportedList{{Id: 2, Title: "Bills", ItemCount: 2}},
+		},
+		{
+			name:     "Into a list, dry run",
+			requests: importRequests(&pb.ImportInfo{UserId: 1, Format: "ics", ListId: 2, DryRun: true}, ics),
+			mockRepoSetup: func() {
+				editable()
+			},
+			expectedStatus: http.StatusOK,
+			expectedLists:  []*pb.ImportedList{{Id: 2, Title: "Bills", ItemCount: 2}},
+		},
+		{
+			name:     "Into an archived list",
+			requests: importRequests(&pb.ImportInfo{UserId: 1, Format: "ics", ListId: 2}, ics),
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(2)).Return(repository.ErrListArchived)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedError:  errListArchived,
+		},
+		{
+			name:     "Into a list without items",
+			requests: importRequests(&pb.ImportInfo{UserId: 1, Format: "markdown", ListId: 2}, "# Empty\n"),
+			mockRepoSetup: func() {
+				editable()
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  errImportEmpty,
+		},
+		{
+			name:           "Unknown format",
+			requests:       importRequests(&pb.ImportInfo{UserId: 1, FileName: "groceries.xml"}, markdown),
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  errUnknownFormat,
+		},
+		{
+			name:           "Chunk before info",
+			requests:       importRequests(&pb.ImportInfo{UserId: 1}, markdown)[1:],
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  errImportInfo,
+		},
+		{
+			name:           "Invalid file",
+			requests:       importRequests(&pb.ImportInfo{UserId: 1, Format: "json"}, "{"),
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Invalid import: unexpected EOF",
+		},
+		{
+			name:           "Empty file",
+			requests:       importRequests(&pb.ImportInfo{UserId: 1, Format: "markdown"}),
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  errImportEmpty,
+		},
+		{
+			name:           "Invalid recurrence",
+			requests:       importRequests(&pb.ImportInfo{UserId: 1, Format: "csv"}, "list,title,recurrence\nChores,Dishes,FREQ=SOMETIMES\n"),
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  `Item "Dishes": ` + errInvalidRule,
+		},
+		{
+			name:           "Untitled item",
+			requests:       importRequests(&pb.ImportInfo{UserId: 1, Format: "json"}, `{"lists":[{"title":"Chores","items":[{"title":" "}]}]}`),
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  `Item " ": ` + errInvalidTitle,
+		},
+		{
+			name:           "Untitled list",
+			requests:       importRequests(&pb.ImportInfo{UserId: 1, Format: "json"}, `{"lists":[{"title":"","items":[{"title":"Dishes"}]}]}`),
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  `List "": ` + errInvalidTitle,
+		},
+		{
+			name:           "Title too long",
+			requests:       importRequests(&pb.ImportInfo{UserId: 1, Format: "json"}, `{"lists":[{"title":"`+strings.Repeat("a", maxTitleLength+1)+`"}]}`),
+			mockRepoSetup:  func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  `List "` + strings.Repeat("a", maxTitleLength+1) + `": ` + errInvalidTitle,
+		},
+		{
+			name:     "Repository error",
+			requests: importRequests(&pb.ImportInfo{UserId: 1, Format: "markdown"}, markdown),
+			mockRepoSetup: func() {
+				transferRepo.EXPECT().Import(int64(1), gomock.Any(), gomock.Any()).Return(errors.New("error importing lists"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "error importing lists",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			stream := &importStream{requests: tt.requests}
+			err := serv.ImportLists(stream)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, stream.response.Status)
+			assert.Equal(t, tt.expectedError, stream.response.Error)
+			assert.Equal(t, len(tt.expectedLists), len(stream.response.Lists))
+			for i, list := range tt.expectedLists {
+				assert.True(t, proto.Equal(list, stream.response.Lists[i]), stream.response.Lists[i])
+			}
+		})
+	}
+}
+
+func TestServer_GetCalendarFeed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	calendarRepo := mock_repository.NewMockCalendar(ctrl)
+	serv := &Server{
+		CalendarRepo: calendarRepo,
+	}
+
+	calendarRepo.EXPECT().GetOrCreate(gomock.Any()).DoAndReturn(func(feed *domain.CalendarFeed) (*domain.CalendarFeed, error) {
+		assert.Equal(t, int64(1), feed.UserId)
+		assert.Len(t, feed.Token, 64)
+		return &domain.CalendarFeed{UserId: 1, Token: "existing"}, nil
+	})
+	resp, err := serv.GetCalendarFeed(context.Background(), &pb.GetCalendarFeedRequest{UserId: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(http.StatusOK), resp.Status)
+	assert.Equal(t, "existing", resp.Token)
+
+	calendarRepo.EXPECT().GetOrCreate(gomock.Any()).Return(nil, errors.New("error getting feed"))
+	resp, err = serv.GetCalendarFeed(context.Background(), &pb.GetCalendarFeedRequest{UserId: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(http.StatusInternalServerError), resp.Status)
+	assert.Equal(t, "error getting feed", resp.Error)
+}
+
+func TestServer_ResetCalendarFeed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	calendarRepo := mock_repository.NewMockCalendar(ctrl)
+	serv := &Server{
+		CalendarRepo: calendarRepo,
+	}
+
+	var stored string
+	calendarRepo.EXPECT().Reset(gomock.Any()).DoAndReturn(func(feed *domain.CalendarFeed) error {
+		assert.Equal(t, int64(1), feed.UserId)
+		stored = feed.Token
+		return nil
+	})
+	resp, err := serv.ResetCalendarFeed(context.Background(), &pb.ResetCalendarFeedRequest{UserId: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(http.StatusOK), resp.Status)
+	assert.Len(t, resp.Token, 64)
+	assert.Equal(t, stored, resp.Token)
+}
+
+func TestServer_GetCalendar(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	itemRepo := mock_repository.NewMockTodoItem(ctrl)
+	calendarRepo := mock_repository.NewMockCalendar(ctrl)
+	serv := &Server{
+		ItemRepo:     itemRepo,
+		CalendarRepo: calendarRepo,
+	}
+
+	due := time.Date(2024, 5, 3, 18, 0, 0, 0, time.UTC)
+	parentId := int64(4)
+
+	tests := []struct {
+		name             string
+		token            string
+		mockRepoSetup    func()
+		expectedStatus   int64
+		expectedError    string
+		expectedContains []string
+	}{
+		{
+			name:  "Success",
+			token: "secret",
+			mockRepoSetup: func() {
+				calendarRepo.EXPECT().GetByToken("secret").Return(&domain.CalendarFeed{UserId: 3, Token: "secret"}, nil)
+				// Only the owner of the feed is asked for tags.
+				itemRepo.EXPECT().GetDue(int64(3), nil, nil, true).Return([]*domain.TodoItem{
+					{Id: 4, Title: "Report", DueAt: &due, Priority: domain.PriorityUrgent, Tags: []domain.Tag{{Id: 9, UserId: 3, Name: "work"}}},
+					{Id: 5, Title: "Figures", DueAt: &due, Done: true, ParentId: &parentId},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedContains: []string{
+				"X-WR-CALNAME:Todo\r\n",
+				"UID:item-4@go-grpc-todo\r\nDTSTAMP:",
+				"DUE:20240503T180000Z\r\nPRIORITY:1\r\nSTATUS:NEEDS-ACTION\r\n",
+				"CATEGORIES:work\r\n",
+				"STATUS:COMPLETED\r\nRELATED-TO;RELTYPE=PARENT:item-4@go-grpc-todo\r\n",
+			},
+		},
+		{
+			name:  "Unknown token",
+			token: "guess",
+			mockRepoSetup: func() {
+				calendarRepo.EXPECT().GetByToken("guess").Return(nil, repository.ErrCalendarFeedNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  errFeedNotFound,
+		},
+		{
+			name:  "Repository error",
+			token: "secret",
+			mockRepoSetup: func() {
+				calendarRepo.EXPECT().GetByToken("secret").Return(&domain.CalendarFeed{UserId: 1, Token: "secret"}, nil)
+				itemRepo.EXPECT().GetDue(int64(1), nil, nil, true).Return(nil, errors.New("error getting items"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "error getting items",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.GetCalendar(context.Background(), &pb.GetCalendarRequest{Token: tt.token})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, resp.Status)
+			assert.Equal(t, tt.expectedError, resp.Error)
+			for _, part := range tt.expectedContains {
+				assert.Contains(t, string(resp.Content), part)
+			}
+		})
+	}
+}
+
+func TestServer_SyncTodoTxt(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	listRepo := mock_repository.NewMockTodoList(ctrl)
+	itemRepo := mock_repository.NewMockTodoItem(ctrl)
+	transferRepo := mock_repository.NewMockTransfer(ctrl)
+	serv := &Server{
+		ListRepo:     listRepo,
+		ItemRepo:     itemRepo,
+		TransferRepo: transferRepo,
+	}
+
+	dueAt := time.Date(2024, 5, 3, 9, 0, 0, 0, time.UTC)
+	editable := func() {
+		listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleEditor).Return(nil)
+		listRepo.EXPECT().CheckNotArchived(int64(2)).Return(nil)
+		listRepo.EXPECT().GetById(int64(2)).Return(&domain.TodoList{Id: 2, Title: "Groceries"}, nil)
+	}
+	milk := func() {
+		itemRepo.EXPECT().GetById(int64(1), int64(5)).Return(&domain.TodoItem{
+			Id:          5,
+			Title:       "Milk",
+			Description: "Oat",
+			DueAt:       &dueAt,
+			ListId:      2,
+			Version:     3,
+		}, int64(2), nil)
+	}
+
+	tests := []struct {
+		name            string
+		in              *pb.SyncTodoTxtRequest
+		mockRepoSetup   func()
+		expectedStatus  int64
+		expectedError   string
+		expectedCreated int32
+		expectedUpdated int32
+		expectedContent string
+		expectedOther   []string
+	}{
+		{
+			name: "Success",
+			in: &pb.SyncTodoTxtRequest{UserId: 1, ListId: 2, Content: "x Milk +Groceries @store due:2024-05-03 aisle:4 id:5\n" +
+				"(B) Bread id:99\n" +
+				"Eggs\n" +
+				"Stamps +Post-office\n"},
+			mockRepoSetup: func() {
+				editable()
+				milk()
+				itemRepo.EXPECT().GetById(int64(1), int64(99)).Return(nil, int64(0), repository.ErrTodoItemNotFound)
+				transferRepo.EXPECT().SyncItems(int64(1), int64(2), gomock.Any(), gomock.Any()).DoAndReturn(func(_, _ int64, sync *domain.ItemSync, _ *domain.Activity) error {
+					if !assert.Len(t, sync.Update, 1) || !assert.Len(t, sync.Create, 3) {
+						return nil
+					}
+					item := sync.Update[0]
+					assert.True(t, item.Done)
+					assert.Equal(t, "Oat", item.Description)
+					assert.Equal(t, &dueAt, item.DueAt)
+					assert.Equal(t, int64(3), item.Version)
+					assert.Equal(t, []domain.Tag{{Name: "store"}}, item.Tags)
+					assert.Equal(t, map[string]string{"aisle": "4"}, item.Extensions)
+
+					assert.Equal(t, int64(0), sync.Create[0].Id)
+					assert.Equal(t, domain.PriorityHigh, sync.Create[0].Priority)
+					assert.Equal(t, "Eggs", sync.Create[1].Title)
+					assert.Empty(t, sync.Next)
+					return nil
+				})
+				transferRepo.EXPECT().GetLists(int64(1), []int64{2}).Return([]*domain.TodoList{{
+					Title: "Groceries",
+					Items: []domain.TodoItem{{Id: 5, Title: "Milk", Done: true}, {Id: 6, Title: "Eggs"}},
+				}}, nil)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedCreated: 3,
+			expectedUpdated: 1,
+			expectedContent: "x Milk +Groceries id:5\nEggs +Groceries id:6\n",
+			expectedOther:   []string{"Post-office"},
+		},
+		{
+			name: "Completing a recurring item",
+			in:   &pb.SyncTodoTxtRequest{UserId: 1, ListId: 2, Content: "x Milk due:2024-05-04 rec:1w id:5\n"},
+			mockRepoSetup: func() {
+				editable()
+				milk()
+				transferRepo.EXPECT().SyncItems(int64(1), int64(2), gomock.Any(), gomock.Any()).DoAndReturn(func(_, _ int64, sync *domain.ItemSync, _ *domain.Activity) error {
+					item := sync.Update[0]
+					assert.Equal(t, time.Date(2024, 5, 4, 0, 0, 0, 0, time.UTC), *item.DueAt)
+					assert.Equal(t, item.DueAt, item.RecurrenceStart)
+					if !assert.Contains(t, sync.Next, int64(5)) {
+						return nil
+					}
+					assert.Equal(t, time.Date(2024, 5, 11, 0, 0, 0, 0, time.UTC), *sync.Next[5].DueAt)
+					return nil
+				})
+				transferRepo.EXPECT().GetLists(int64(1), []int64{2}).Return(nil, nil)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedUpdated: 1,
+		},
+		{
+			name: "Dry run",
+			in:   &pb.SyncTodoTxtRequest{UserId: 1, ListId: 2, Content: "Milk id:5\nMilk id:5\n", DryRun: true},
+			mockRepoSetup: func() {
+				editable()
+				itemRepo.EXPECT().GetById(int64(1), int64(5)).Return(&domain.TodoItem{Id: 5, Title: "Milk"}, int64(3), nil)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedCreated: 2,
+		},
+		{
+			name: "Archived list",
+			in:   &pb.SyncTodoTxtRequest{UserId: 1, ListId: 2, Content: "Milk\n"},
+			mockRepoSetup: func() {
+				listRepo.EXPECT().CheckUserAccessToList(int64(1), int64(2), domain.RoleEditor).Return(nil)
+				listRepo.EXPECT().CheckNotArchived(int64(2)).Return(repository.ErrListArchived)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedError:  errListArchived,
+		},
+		{
+			name:           "Empty file",
+			in:             &pb.SyncTodoTxtRequest{UserId: 1, ListId: 2, Content: "\n\n"},
+			mockRepoSetup:  editable,
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  errImportEmpty,
+		},
+		{
+			name:           "Too large",
+			in:             &pb.SyncTodoTxtRequest{UserId: 1, ListId: 2, Content: strings.Repeat("x", maxImportSize+1)},
+			mockRepoSetup:  editable,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+			expectedError:  errImportSize,
+		},
+		{
+			name:           "Invalid item",
+			in:             &pb.SyncTodoTxtRequest{UserId: 1, ListId: 2, Content: "Pay rent rec:1m\n"},
+			mockRepoSetup:  editable,
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  `Item "Pay rent": ` + errRecurrenceDue,
+		},
+		{
+			name: "Version conflict",
+			in:   &pb.SyncTodoTxtRequest{UserId: 1, ListId: 2, Content: "Milk id:5\n"},
+			mockRepoSetup: func() {
+				editable()
+				milk()
+				transferRepo.EXPECT().SyncItems(int64(1), int64(2), gomock.Any(), gomock.Any()).Return(repository.ErrVersionConflict)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedError:  errVersionConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockRepoSetup()
+			resp, err := serv.SyncTodoTxt(context.Background(), tt.in)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, resp.Status)
+			assert.Equal(t, tt.expectedError, resp.Error)
+			assert.Equal(t, tt.expectedCreated, resp.Created)
+			assert.Equal(t, tt.expectedUpdated, resp.Updated)
+			assert.Equal(t, tt.expectedContent, resp.Content)
+			assert.Equal(t, tt.expectedOther, resp.OtherProjects)
+		})
+	}
+}
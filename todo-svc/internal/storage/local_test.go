@@ -0,0 +1,47 @@
+package storage
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLocal_PutGetDelete(t *testing.T) {
+	root := t.TempDir()
+	l, err := NewLocal(filepath.Join(root, "attachments"))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	err = l.Put(ctx, "items/1/abc", strings.NewReader("hello"), 5, "text/plain")
+	require.NoError(t, err)
+
+	blob, err := l.Get(ctx, "items/1/abc")
+	require.NoError(t, err)
+	content, _ := io.ReadAll(blob)
+	blob.Close()
+	assert.Equal(t, "hello", string(content))
+
+	entries, err := os.ReadDir(filepath.Join(root, "attachments", "items", "1"))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "temporary upload files are removed")
+
+	require.NoError(t, l.Delete(ctx, "items/1/abc"))
+	require.NoError(t, l.Delete(ctx, "items/1/abc"))
+	_, err = l.Get(ctx, "items/1/abc")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestLocal_InvalidKey(t *testing.T) {
+	l, err := NewLocal(t.TempDir())
+	require.NoError(t, err)
+
+	for _, key := range []string{"../outside", "/etc/passwd", "items/../../outside", ""} {
+		_, err := l.Get(context.Background(), key)
+		assert.ErrorIs(t, err, ErrInvalidKey, key)
+	}
+}
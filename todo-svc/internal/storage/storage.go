@@ -0,0 +1,36 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/config"
+	"io"
+)
+
+var (
+	ErrNotFound   = errors.New("blob not found")
+	ErrInvalidKey = errors.New("invalid blob key")
+)
+
+// Storage keeps the contents of attachments. Keys are slash-separated paths
+// such as "items/12/3f9a", deleting a missing key is not an error.
+type Storage interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// New returns the storage driver selected in the configuration.
+func New(cfg *config.Config) (Storage, error) {
+	storage := cfg.Attachments.Storage
+	switch storage.Driver {
+	case "local":
+		return NewLocal(storage.Path)
+	case "s3":
+		return NewS3(storage.S3.Endpoint, storage.S3.Region, storage.S3.Bucket,
+			storage.S3.AccessKey, storage.S3.SecretKey)
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", storage.Driver)
+	}
+}
@@ -0,0 +1,197 @@
+package transfer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/domain"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CSV has a row per item with the title of its list and its depth, one for
+// top-level items. Rows without a title stand for lists without items and
+// consecutive rows of the same list make up one list. Tags are separated by
+// commas. Descriptions of lists and recurrence starts are not kept.
+type CSV struct{}
+
+var csvHeader = []string{"list", "depth", "title", "description", "completed", "start_at", "due_at", "time_zone", "priority", "recurrence", "tags"}
+
+func (CSV) ContentType() string {
+	return "text/csv"
+}
+
+func (CSV) Extension() string {
+	return "csv"
+}
+
+func (CSV) Encode(w io.Writer, lists []*domain.TodoList) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, list := range lists {
+		if len(list.Items) == 0 {
+			if err := writer.Write([]string{list.Title, "", "", "", "", "", "", "", "", "", ""}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		err := Walk(list, func(item *domain.TodoItem, depth int) error {
+			tags := make([]string, 0, len(item.Tags))
+			for _, tag := range item.Tags {
+				tags = append(tags, tag.Name)
+			}
+
+			return writer.Write([]string{
+				list.Title,
+				strconv.Itoa(depth),
+				item.Title,
+				item.Description,
+				strconv.FormatBool(item.Done),
+				formatTime(item.StartAt),
+				formatTime(item.DueAt),
+				item.TimeZone,
+				strconv.Itoa(int(item.Priority)),
+				item.Recurrence,
+				strings.Join(tags, ","),
+			})
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func (CSV) Decode(r io.Reader) ([]*domain.TodoList, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["title"]; !ok {
+		return nil, errors.New("missing title column")
+	}
+
+	var lists []*domain.TodoList
+	var t tree
+	finish := func() {
+		if len(lists) > 0 {
+			lists[len(lists)-1].Items = t.items()
+		}
+		t = tree{}
+	}
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		title := field("list")
+		if _, ok := columns["list"]; !ok {
+			title = importedList
+		}
+		if len(lists) == 0 || lists[len(lists)-1].Title != title {
+			finish()
+			lists = append(lists, &domain.TodoList{Title: title})
+		}
+
+		if field("title") == "" {
+			continue
+		}
+
+		item, depth, err := csvItem(field)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		t.add(item, depth)
+	}
+	finish()
+
+	return lists, nil
+}
+
+func csvItem(field func(name string) string) (*domain.TodoItem, int, error) {
+	item := &domain.TodoItem{
+		Title:       field("title"),
+		Description: field("description"),
+		TimeZone:    field("time_zone"),
+		Recurrence:  field("recurrence"),
+	}
+
+	depth := 1
+	var err error
+	if value := field("depth"); value != "" {
+		if depth, err = strconv.Atoi(value); err != nil {
+			return nil, 0, fmt.Errorf("invalid depth %q", value)
+		}
+	}
+	if value := field("completed"); value != "" {
+		if item.Done, err = strconv.ParseBool(value); err != nil {
+			return nil, 0, fmt.Errorf("invalid completed %q", value)
+		}
+	}
+	if value := field("priority"); value != "" {
+		priority, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid priority %q", value)
+		}
+		item.Priority = domain.Priority(priority)
+	}
+	if item.StartAt, err = parseTime(field("start_at")); err != nil {
+		return nil, 0, err
+	}
+	if item.DueAt, err = parseTime(field("due_at")); err != nil {
+		return nil, 0, err
+	}
+	for _, name := range strings.Split(field("tags"), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			item.Tags = append(item.Tags, domain.Tag{Name: name})
+		}
+	}
+
+	return item, depth, nil
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func parseTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time %q, use RFC 3339", value)
+	}
+	return &t, nil
+}
@@ -0,0 +1,506 @@
+package transfer
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/domain"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ICal writes the items of the lists as the VTODOs of one iCalendar file
+// (RFC 5545), subtasks related to their parents:
+//
+//	BEGIN:VTODO
+//	UID:item-42@go-grpc-todo
+//	SUMMARY:Milk
+//	DUE:20240503T180000Z
+//	STATUS:NEEDS-ACTION
+//	END:VTODO
+//
+// Completion, priority, recurrence and tags map to STATUS, PRIORITY, RRULE
+// and CATEGORIES. Times are written in UTC. Items of flat lists, such as the
+// due items of a user, are related to their parents by ParentId.
+//
+// Decoding reads the VTODOs of a file into one list named by X-WR-CALNAME,
+// other components are skipped.
+type ICal struct{}
+
+const (
+	icalDomain   = "go-grpc-todo"
+	icalProduct  = "-//go-grpc-todo//todo-svc//EN"
+	icalTime     = "20060102T150405Z"
+	icalLocal    = "20060102T150405"
+	icalDate     = "20060102"
+	icalMaxLine  = 75
+	icalComplete = "COMPLETED"
+)
+
+var (
+	errNotICal = errors.New("not an iCalendar file")
+)
+
+// icalPriorities maps priorities to the PRIORITY of RFC 5545, where 1 is the
+// highest and 9 the lowest.
+var icalPriorities = map[domain.Priority]int{
+	domain.PriorityUrgent: 1,
+	domain.PriorityHigh:   3,
+	domain.PriorityMedium: 5,
+	domain.PriorityLow:    9,
+}
+
+func (ICal) ContentType() string {
+	return "text/calendar; charset=utf-8"
+}
+
+func (ICal) Extension() string {
+	return "ics"
+}
+
+func (ICal) Encode(w io.Writer, lists []*domain.TodoList) error {
+	out := &icalWriter{w: bufio.NewWriter(w)}
+	out.line("BEGIN:VCALENDAR")
+	out.line("VERSION:2.0")
+	out.line("PRODID:" + icalProduct)
+	out.line("CALSCALE:GREGORIAN")
+	if len(lists) == 1 {
+		out.line("X-WR-CALNAME:" + icalText(lists[0].Title))
+	}
+
+	now := time.Now()
+	created := 0
+	for _, list := range lists {
+		// The UIDs of the items the walk is in, so that subtasks can be
+		// related to their parents.
+		var parents []string
+		Walk(list, func(item *domain.TodoItem, depth int) error {
+			created++
+			uid := fmt.Sprintf("new-%d@%s", created, icalDomain)
+			if item.Id != 0 {
+				uid = icalUID(item.Id)
+			}
+
+			parent := ""
+			if parents = parents[:depth-1]; depth > 1 {
+				parent = parents[depth-2]
+			} else if item.ParentId != nil {
+				parent = icalUID(*item.ParentId)
+			}
+			parents = append(parents, uid)
+
+			stamp := item.CreatedAt
+			if stamp.IsZero() {
+				stamp = now
+			}
+
+			out.line("BEGIN:VTODO")
+			out.line("UID:" + uid)
+			out.line("DTSTAMP:" + stamp.UTC().Format(icalTime))
+			out.line("SUMMARY:" + icalText(item.Title))
+			if item.Description != "" {
+				out.line("DESCRIPTION:" + icalText(item.Description))
+			}
+			// Recurrences repeat from DTSTART, so recurring items need one.
+			if start := item.StartAt; start != nil || (item.Recurrence != "" && item.DueAt != nil) {
+				if start == nil {
+					start = item.DueAt
+				}
+				out.line("DTSTART:" + start.UTC().Format(icalTime))
+			}
+			if item.DueAt != nil {
+				out.line("DUE:" + item.DueAt.UTC().Format(icalTime))
+			}
+			if priority, ok := icalPriorities[item.Priority]; ok {
+				out.line("PRIORITY:" + strconv.Itoa(priority))
+			}
+			if item.Done {
+				out.line("STATUS:" + icalComplete)
+			} else {
+				out.line("STATUS:NEEDS-ACTION")
+			}
+			if item.Recurrence != "" {
+				out.line("RRULE:" + item.Recurrence)
+			}
+			if len(item.Tags) > 0 {
+				names := make([]string, len(item.Tags))
+				for i, tag := range item.Tags {
+					names[i] = icalText(tag.Name)
+				}
+				out.line("CATEGORIES:" + strings.Join(names, ","))
+			}
+			if parent != "" {
+				out.line("RELATED-TO;RELTYPE=PARENT:" + parent)
+			}
+			out.line("END:VTODO")
+			return nil
+		})
+	}
+
+	out.line("END:VCALENDAR")
+	return out.w.Flush()
+}
+
+func icalUID(itemId int64) string {
+	return fmt.Sprintf("item-%d@%s", itemId, icalDomain)
+}
+
+// icalWriter writes content lines ended by CRLF, folding the ones longer
+// than icalMaxLine octets.
+type icalWriter struct {
+	w *bufio.Writer
+}
+
+func (w *icalWriter) line(line string) {
+	limit := icalMaxLine
+	for len(line) > limit {
+		cut := limit
+		// Lines are folded between characters, not inside them.
+		for cut > 0 && !isRuneStart(line[cut]) {
+			cut--
+		}
+		w.w.WriteString(line[:cut])
+		w.w.WriteString("\r\n ")
+		line = line[cut:]
+		limit = icalMaxLine - 1
+	}
+	w.w.WriteString(line)
+	w.w.WriteString("\r\n")
+}
+
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
+
+var icalEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", "")
+
+func icalText(text string) string {
+	return icalEscaper.Replace(text)
+}
+
+// icalTodo is a VTODO being decoded.
+type icalTodo struct {
+	item   *domain.TodoItem
+	uid    string
+	parent string
+}
+
+func (ICal) Decode(r io.Reader) ([]*domain.TodoList, error) {
+	lines, err := unfoldICal(r)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &domain.TodoList{Title: importedList}
+	var todos []*icalTodo
+	var todo *icalTodo
+	// The components the line is in, innermost last.
+	var components []string
+	calendar := false
+
+	for _, line := range lines {
+		prop, err := parseICalLine(line)
+		if err != nil {
+			return nil, err
+		}
+
+		switch prop.name {
+		case "BEGIN":
+			component := strings.ToUpper(prop.value)
+			if len(components) == 0 && component != "VCALENDAR" {
+				return nil, errNotICal
+			}
+			components = append(components, component)
+			calendar = true
+			if component == "VTODO" && len(components) == 2 {
+				todo = &icalTodo{item: &domain.TodoItem{}}
+			}
+			continue
+		case "END":
+			component := strings.ToUpper(prop.value)
+			if len(components) == 0 || components[len(components)-1] != component {
+				return nil, fmt.Errorf("unexpected END:%s", prop.value)
+			}
+			components = components[:len(components)-1]
+			if component == "VTODO" && todo != nil && len(components) == 1 {
+				todos = append(todos, todo)
+				todo = nil
+			}
+			continue
+		}
+
+		switch {
+		case len(components) == 1 && prop.name == "X-WR-CALNAME":
+			if title := strings.TrimSpace(icalUnescape(prop.value)); title != "" {
+				list.Title = title
+			}
+		case len(components) == 2 && todo != nil:
+			if err := todo.set(prop); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if !calendar {
+		return nil, errNotICal
+	}
+	if len(components) > 0 {
+		return nil, fmt.Errorf("missing END:%s", components[len(components)-1])
+	}
+	if len(todos) == 0 {
+		return nil, nil
+	}
+
+	list.Items = relateICal(todos)
+	return []*domain.TodoList{list}, nil
+}
+
+func (t *icalTodo) set(prop icalProperty) error {
+	item := t.item
+	switch prop.name {
+	case "UID":
+		t.uid = prop.value
+	case "RELATED-TO":
+		if reltype := strings.ToUpper(prop.params["RELTYPE"]); reltype == "" || reltype == "PARENT" {
+			t.parent = prop.value
+		}
+	case "SUMMARY":
+		item.Title = strings.TrimSpace(icalUnescape(prop.value))
+	case "DESCRIPTION":
+		item.Description = strings.TrimSpace(icalUnescape(prop.value))
+	case "DTSTART":
+		start, _, err := parseICalTime(prop)
+		if err != nil {
+			return err
+		}
+		item.StartAt = start
+	case "DUE":
+		due, zone, err := parseICalTime(prop)
+		if err != nil {
+			return err
+		}
+		item.DueAt, item.TimeZone = due, zone
+	case "STATUS":
+		item.Done = strings.EqualFold(prop.value, icalComplete)
+	case icalComplete:
+		item.Done = true
+	case "PERCENT-COMPLETE":
+		if prop.value == "100" {
+			item.Done = true
+		}
+	case "PRIORITY":
+		priority, err := strconv.Atoi(prop.value)
+		if err != nil {
+			return fmt.Errorf("invalid priority %q", prop.value)
+		}
+		item.Priority = icalPriority(priority)
+	case "RRULE":
+		item.Recurrence = prop.value
+	case "CATEGORIES":
+		for _, name := range splitICalList(prop.value) {
+			if name = strings.TrimSpace(name); name != "" && !hasTag(item, name) {
+				item.Tags = append(item.Tags, domain.Tag{Name: name})
+			}
+		}
+	}
+	return nil
+}
+
+func hasTag(item *domain.TodoItem, name string) bool {
+	for _, tag := range item.Tags {
+		if tag.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// icalPriority maps a PRIORITY to the priority of the range it is in.
+func icalPriority(priority int) domain.Priority {
+	switch {
+	case priority <= 0 || priority > 9:
+		return domain.PriorityNone
+	case priority <= 2:
+		return domain.PriorityUrgent
+	case priority <= 4:
+		return domain.PriorityHigh
+	case priority == 5:
+		return domain.PriorityMedium
+	default:
+		return domain.PriorityLow
+	}
+}
+
+// relateICal makes the to-dos subtasks of the to-dos they are related to.
+// To-dos related to nothing in the file, or to one of their own subtasks,
+// are top-level items.
+func relateICal(todos []*icalTodo) []domain.TodoItem {
+	byUID := make(map[string]*icalTodo, len(todos))
+	for _, todo := range todos {
+		if todo.uid != "" {
+			byUID[todo.uid] = todo
+		}
+	}
+
+	var top []*domain.TodoItem
+	for _, todo := range todos {
+		item := todo.item
+		// Encode writes DTSTART for recurrences to repeat from, it is not a
+		// start date of its own.
+		if item.Recurrence != "" && item.StartAt != nil && item.DueAt != nil && item.StartAt.Equal(*item.DueAt) {
+			item.StartAt = nil
+		}
+
+		if parent := byUID[todo.parent]; parent != nil && !icalCycle(todo, byUID) {
+			parent.item.Children = append(parent.item.Children, item)
+			continue
+		}
+		top = append(top, item)
+	}
+
+	items := make([]domain.TodoItem, len(top))
+	for i, item := range top {
+		items[i] = *item
+	}
+	return items
+}
+
+// icalCycle reports whether todo is among its own ancestors.
+func icalCycle(todo *icalTodo, byUID map[string]*icalTodo) bool {
+	seen := map[*icalTodo]bool{}
+	for ancestor := byUID[todo.parent]; ancestor != nil; ancestor = byUID[ancestor.parent] {
+		if ancestor == todo {
+			return true
+		}
+		if seen[ancestor] {
+			return false
+		}
+		seen[ancestor] = true
+	}
+	return false
+}
+
+// unfoldICal reads the content lines of r, joining folded lines.
+func unfoldICal(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+		if (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+type icalProperty struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// parseICalLine splits a content line into its name, parameters and value.
+// The value starts at the first colon that is not inside a quoted parameter.
+func parseICalLine(line string) (icalProperty, error) {
+	quoted := false
+	colon := -1
+	for i := 0; i < len(line) && colon < 0; i++ {
+		switch line[i] {
+		case '"':
+			quoted = !quoted
+		case ':':
+			if !quoted {
+				colon = i
+			}
+		}
+	}
+	if colon < 0 {
+		return icalProperty{}, fmt.Errorf("invalid line %q", line)
+	}
+
+	parts := strings.Split(line[:colon], ";")
+	prop := icalProperty{
+		name:   strings.ToUpper(parts[0]),
+		params: make(map[string]string),
+		value:  line[colon+1:],
+	}
+	for _, param := range parts[1:] {
+		if name, value, ok := strings.Cut(param, "="); ok {
+			prop.params[strings.ToUpper(name)] = strings.Trim(value, `"`)
+		}
+	}
+	return prop, nil
+}
+
+// parseICalTime parses a DATE-TIME or DATE value. Times with a TZID are in
+// that zone, whose name is returned along with them, floating times are
+// taken as UTC.
+func parseICalTime(prop icalProperty) (*time.Time, string, error) {
+	loc, zone := time.UTC, ""
+	if tzid := prop.params["TZID"]; tzid != "" {
+		if l, err := time.LoadLocation(tzid); err == nil {
+			loc, zone = l, tzid
+		}
+	}
+
+	var t time.Time
+	var err error
+	switch {
+	case strings.HasSuffix(prop.value, "Z"):
+		t, err = time.Parse(icalTime, prop.value)
+	case len(prop.value) == len(icalDate):
+		t, err = time.ParseInLocation(icalDate, prop.value, loc)
+	default:
+		t, err = time.ParseInLocation(icalLocal, prop.value, loc)
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid %s %q", prop.name, prop.value)
+	}
+	return &t, zone, nil
+}
+
+func icalUnescape(text string) string {
+	var b strings.Builder
+	for i := 0; i < len(text); i++ {
+		if text[i] != '\\' || i == len(text)-1 {
+			b.WriteByte(text[i])
+			continue
+		}
+		i++
+		switch text[i] {
+		case 'n', 'N':
+			b.WriteByte('\n')
+		default:
+			b.WriteByte(text[i])
+		}
+	}
+	return b.String()
+}
+
+// splitICalList splits a list value at the commas that are not escaped and
+// unescapes its values.
+func splitICalList(value string) []string {
+	var values []string
+	start := 0
+	for i := 0; i < len(value); i++ {
+		switch value[i] {
+		case '\\':
+			i++
+		case ',':
+			values = append(values, icalUnescape(value[start:i]))
+			start = i + 1
+		}
+	}
+	return append(values, icalUnescape(value[start:]))
+}
@@ -0,0 +1,146 @@
+package transfer
+
+import (
+	"encoding/json"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/domain"
+	"io"
+	"time"
+)
+
+// JSON keeps everything lists and their items hold except what the database
+// assigns, such as ids and positions, so exports import back unchanged.
+type JSON struct{}
+
+type jsonExport struct {
+	Lists []jsonList `json:"lists"`
+}
+
+type jsonList struct {
+	Title       string     `json:"title"`
+	Description string     `json:"description,omitempty"`
+	ArchivedAt  *time.Time `json:"archived_at,omitempty"`
+	Items       []jsonItem `json:"items,omitempty"`
+}
+
+type jsonItem struct {
+	Title           string            `json:"title"`
+	Description     string            `json:"description,omitempty"`
+	Completed       bool              `json:"completed"`
+	StartAt         *time.Time        `json:"start_at,omitempty"`
+	DueAt           *time.Time        `json:"due_at,omitempty"`
+	TimeZone        string            `json:"time_zone,omitempty"`
+	Priority        domain.Priority   `json:"priority,omitempty"`
+	Recurrence      string            `json:"recurrence,omitempty"`
+	RecurrenceStart *time.Time        `json:"recurrence_start,omitempty"`
+	Tags            []string          `json:"tags,omitempty"`
+	Extensions      map[string]string `json:"extensions,omitempty"`
+	Subtasks        []jsonItem        `json:"subtasks,omitempty"`
+}
+
+func (JSON) ContentType() string {
+	return "application/json"
+}
+
+func (JSON) Extension() string {
+	return "json"
+}
+
+func (JSON) Encode(w io.Writer, lists []*domain.TodoList) error {
+	export := jsonExport{Lists: make([]jsonList, 0, len(lists))}
+	for _, list := range lists {
+		items := make([]*domain.TodoItem, len(list.Items))
+		for i := range list.Items {
+			items[i] = &list.Items[i]
+		}
+
+		export.Lists = append(export.Lists, jsonList{
+			Title:       list.Title,
+			Description: list.Description,
+			ArchivedAt:  list.ArchivedAt,
+			Items:       toJSONItems(items),
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	return encoder.Encode(export)
+}
+
+func toJSONItems(items []*domain.TodoItem) []jsonItem {
+	if len(items) == 0 {
+		return nil
+	}
+
+	out := make([]jsonItem, 0, len(items))
+	for _, item := range items {
+		var tags []string
+		for _, tag := range item.Tags {
+			tags = append(tags, tag.Name)
+		}
+
+		out = append(out, jsonItem{
+			Title:           item.Title,
+			Description:     item.Description,
+			Completed:       item.Done,
+			StartAt:         item.StartAt,
+			DueAt:           item.DueAt,
+			TimeZone:        item.TimeZone,
+			Priority:        item.Priority,
+			Recurrence:      item.Recurrence,
+			RecurrenceStart: item.RecurrenceStart,
+			Tags:            tags,
+			Extensions:      item.Extensions,
+			Subtasks:        toJSONItems(item.Children),
+		})
+	}
+	return out
+}
+
+func (JSON) Decode(r io.Reader) ([]*domain.TodoList, error) {
+	var export jsonExport
+	if err := json.NewDecoder(r).Decode(&export); err != nil {
+		return nil, err
+	}
+
+	lists := make([]*domain.TodoList, 0, len(export.Lists))
+	for _, list := range export.Lists {
+		var t tree
+		for _, item := range list.Items {
+			addJSONItem(&t, item, 1)
+		}
+
+		lists = append(lists, &domain.TodoList{
+			Title:       list.Title,
+			Description: list.Description,
+			ArchivedAt:  list.ArchivedAt,
+			Items:       t.items(),
+		})
+	}
+	return lists, nil
+}
+
+func addJSONItem(t *tree, item jsonItem, depth int) {
+	var tags []domain.Tag
+	for _, name := range item.Tags {
+		tags = append(tags, domain.Tag{Name: name})
+	}
+
+	t.add(&domain.TodoItem{
+		Title:           item.Title,
+		Description:     item.Description,
+		Done:            item.Completed,
+		StartAt:         item.StartAt,
+		DueAt:           item.DueAt,
+		TimeZone:        item.TimeZone,
+		Priority:        item.Priority,
+		Recurrence:      item.Recurrence,
+		RecurrenceStart: item.RecurrenceStart,
+		Tags:            tags,
+		Extensions:      item.Extensions,
+	}, depth)
+
+	for _, subtask := range item.Subtasks {
+		addJSONItem(t, subtask, depth+1)
+	}
+}
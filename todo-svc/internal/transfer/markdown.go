@@ -0,0 +1,173 @@
+package transfer
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/domain"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// Markdown writes a heading per list followed by its description and a
+// checklist of its items, subtasks indented under their parents:
+//
+//	# Groceries
+//
+//	- [ ] Milk
+//	  Oat, if they have it
+//	  - [x] Check the fridge
+//
+// Lines indented under an item are its description, other text is the
+// description of the list. Only titles, descriptions and completion are kept.
+// Items before the first heading go to a list of their own.
+type Markdown struct{}
+
+var (
+	markdownHeading = regexp.MustCompile(`^#{1,6}\s+(.*?)(?:\s+#+)?$`)
+	markdownItem    = regexp.MustCompile(`^([ \t]*)[-*+]\s+\[([ xX])\]\s*(.*)$`)
+)
+
+// markdownIndent is how much subtasks and descriptions are indented under
+// their item.
+const markdownIndent = "  "
+
+func (Markdown) ContentType() string {
+	return "text/markdown; charset=utf-8"
+}
+
+func (Markdown) Extension() string {
+	return "md"
+}
+
+func (Markdown) Encode(w io.Writer, lists []*domain.TodoList) error {
+	out := bufio.NewWriter(w)
+	for i, list := range lists {
+		if i > 0 {
+			out.WriteString("\n")
+		}
+		fmt.Fprintf(out, "# %s\n", oneLine(list.Title))
+		if list.Description != "" {
+			fmt.Fprintf(out, "\n%s\n", list.Description)
+		}
+		if len(list.Items) > 0 {
+			out.WriteString("\n")
+		}
+
+		Walk(list, func(item *domain.TodoItem, depth int) error {
+			indent := strings.Repeat(markdownIndent, depth-1)
+			mark := " "
+			if item.Done {
+				mark = "x"
+			}
+			fmt.Fprintf(out, "%s- [%s] %s\n", indent, mark, oneLine(item.Title))
+
+			if item.Description != "" {
+				for _, line := range strings.Split(item.Description, "\n") {
+					if line == "" {
+						out.WriteString("\n")
+						continue
+					}
+					fmt.Fprintf(out, "%s%s%s\n", indent, markdownIndent, line)
+				}
+			}
+			return nil
+		})
+	}
+	return out.Flush()
+}
+
+// oneLine keeps titles on the line of their heading or item.
+func oneLine(title string) string {
+	return strings.Join(strings.Fields(title), " ")
+}
+
+func (Markdown) Decode(r io.Reader) ([]*domain.TodoList, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+
+	var lists []*domain.TodoList
+	var t tree
+	var description []string
+	// The indentation of the open items, the last one is the item lines
+	// below it describe.
+	var indents []int
+	var blank int
+
+	finish := func() {
+		if len(lists) > 0 {
+			list := lists[len(lists)-1]
+			list.Description = strings.TrimSpace(strings.Join(description, "\n"))
+			list.Items = t.items()
+		}
+		t, description, indents, blank = tree{}, nil, nil, 0
+	}
+
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \t\r")
+		if line == "" {
+			blank++
+			continue
+		}
+
+		if match := markdownHeading.FindStringSubmatch(line); match != nil {
+			finish()
+			lists = append(lists, &domain.TodoList{Title: match[1]})
+			continue
+		}
+
+		indent := indentWidth(line)
+		if match := markdownItem.FindStringSubmatch(line); match != nil {
+			if len(lists) == 0 {
+				lists = append(lists, &domain.TodoList{Title: importedList})
+			}
+			for len(indents) > 0 && indents[len(indents)-1] >= indent {
+				indents = indents[:len(indents)-1]
+			}
+			indents = append(indents, indent)
+			t.add(&domain.TodoItem{
+				Title: strings.TrimSpace(match[3]),
+				Done:  match[2] != " ",
+			}, len(indents))
+			blank = 0
+			continue
+		}
+
+		text := strings.TrimSpace(line)
+		if len(indents) > 0 && indent > indents[len(indents)-1] {
+			item := t.parents[len(t.parents)-1]
+			if item.Description != "" {
+				item.Description += strings.Repeat("\n", min(blank, 1)+1)
+			}
+			item.Description += text
+		} else if len(lists) > 0 {
+			if len(description) > 0 && blank > 0 {
+				description = append(description, "")
+			}
+			description = append(description, text)
+		}
+		blank = 0
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	finish()
+
+	return lists, nil
+}
+
+// indentWidth counts the leading whitespace of line, tabs as four spaces.
+func indentWidth(line string) int {
+	width := 0
+	for _, r := range line {
+		switch r {
+		case ' ':
+			width++
+		case '\t':
+			width += 4
+		default:
+			return width
+		}
+	}
+	return width
+}
@@ -0,0 +1,365 @@
+package transfer
+
+import (
+	"bufio"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/domain"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TodoTxt writes a line per item in the todo.txt format:
+//
+//	(A) Milk +Groceries @store due:2024-05-03 id:42
+//	x Check the fridge +Groceries pri:B id:43
+//
+// Lists are the project of their items and tags their contexts. Due and
+// start dates go to due: and t:, simple recurrences to rec: and others to
+// rrule:, the priority of completed items to pri:. Any other key:value pair
+// is kept in the Extensions of the item and written back after the known
+// ones. Subtasks are items of their own, descriptions are not written and
+// spaces in project and context names become dashes. Title words that would
+// be read as something else, such as +project, @context, key:value or a
+// leading x, are escaped with a backslash.
+//
+// Decoding creates a list per project, items without one go to a list of
+// their own.
+type TodoTxt struct{}
+
+// TodoTxtTask is an item read from a todo.txt line along with the first
+// project of the line, empty when there is none. Items read from lines
+// with an id: keep it as their Id.
+type TodoTxtTask struct {
+	Item    *domain.TodoItem
+	Project string
+}
+
+const todoTxtDate = "2006-01-02"
+
+var (
+	todoTxtPriority  = regexp.MustCompile(`^\(([A-Z])\)$`)
+	todoTxtKey       = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*$`)
+	todoTxtRec       = regexp.MustCompile(`^\+?(\d*)([dwmy])$`)
+	todoTxtSimpleRec = regexp.MustCompile(`^FREQ=(DAILY|WEEKLY|MONTHLY|YEARLY)(?:;INTERVAL=(\d+))?$`)
+)
+
+var (
+	todoTxtLetters = map[domain.Priority]string{
+		domain.PriorityUrgent: "A",
+		domain.PriorityHigh:   "B",
+		domain.PriorityMedium: "C",
+		domain.PriorityLow:    "D",
+	}
+	todoTxtFrequencies = map[string]string{
+		"d": "DAILY",
+		"w": "WEEKLY",
+		"m": "MONTHLY",
+		"y": "YEARLY",
+	}
+)
+
+func (TodoTxt) ContentType() string {
+	return "text/plain; charset=utf-8"
+}
+
+func (TodoTxt) Extension() string {
+	return "txt"
+}
+
+func (TodoTxt) Encode(w io.Writer, lists []*domain.TodoList) error {
+	out := bufio.NewWriter(w)
+	for _, list := range lists {
+		project := TodoTxtName(list.Title)
+		Walk(list, func(item *domain.TodoItem, _ int) error {
+			out.WriteString(todoTxtLine(item, project))
+			out.WriteString("\n")
+			return nil
+		})
+	}
+	return out.Flush()
+}
+
+// todoTxtLine returns the line of item in project, which may be empty.
+func todoTxtLine(item *domain.TodoItem, project string) string {
+	var words []string
+	letter, prioritized := todoTxtLetters[item.Priority]
+	if item.Done {
+		words = append(words, "x")
+	} else if prioritized {
+		words = append(words, "("+letter+")")
+	}
+
+	for i, word := range strings.Fields(item.Title) {
+		words = append(words, todoTxtTitleWord(word, i == 0))
+	}
+	if project != "" {
+		words = append(words, "+"+project)
+	}
+	for _, tag := range item.Tags {
+		words = append(words, "@"+TodoTxtName(tag.Name))
+	}
+
+	loc, err := time.LoadLocation(item.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+	// Extensions with the key of a field that is written are values of the
+	// field that could not be read, the field replaces them.
+	written := make(map[string]bool)
+	add := func(key, value string) {
+		words = append(words, key+":"+value)
+		written[key] = true
+	}
+	if item.DueAt != nil {
+		add("due", item.DueAt.In(loc).Format(todoTxtDate))
+	}
+	if item.StartAt != nil {
+		add("t", item.StartAt.In(loc).Format(todoTxtDate))
+	}
+	if item.Recurrence != "" {
+		key, value := todoTxtRecurrence(item.Recurrence)
+		add(key, value)
+		written["rec"], written["rrule"] = true, true
+	}
+	if item.Done && prioritized {
+		add("pri", letter)
+	}
+
+	keys := make([]string, 0, len(item.Extensions))
+	for key := range item.Extensions {
+		if !written[key] && !(key == "id" && item.Id != 0) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		words = append(words, key+":"+item.Extensions[key])
+	}
+
+	if item.Id != 0 {
+		words = append(words, "id:"+strconv.FormatInt(item.Id, 10))
+	}
+	return strings.Join(words, " ")
+}
+
+// todoTxtTitleWord escapes word of a title when it would not be read back
+// as such. Only the first word can be taken for the completion mark, a
+// priority or a date.
+func todoTxtTitleWord(word string, first bool) string {
+	_, _, pair := todoTxtPair(word)
+	_, err := time.Parse(todoTxtDate, word)
+	leading := first && (word == "x" || todoTxtPriority.MatchString(word) || err == nil)
+	if pair || leading || word[0] == '\\' || len(word) > 1 && (word[0] == '+' || word[0] == '@') {
+		return `\` + word
+	}
+	return word
+}
+
+// todoTxtPair splits word into the key and value of a key:value pair,
+// reporting whether it is one. URLs are not.
+func todoTxtPair(word string) (string, string, bool) {
+	key, value, ok := strings.Cut(word, ":")
+	if !ok || value == "" || !todoTxtKey.MatchString(key) || strings.HasPrefix(value, "//") {
+		return "", "", false
+	}
+	return key, value, true
+}
+
+// TodoTxtName returns the name of a project or context as written, spaces
+// become dashes so that it stays one word.
+func TodoTxtName(name string) string {
+	return strings.Join(strings.Fields(name), "-")
+}
+
+// todoTxtRecurrence returns the key and value rule is written as.
+func todoTxtRecurrence(rule string) (string, string) {
+	match := todoTxtSimpleRec.FindStringSubmatch(rule)
+	if match == nil {
+		return "rrule", rule
+	}
+
+	interval := match[2]
+	if interval == "" {
+		interval = "1"
+	}
+	for unit, frequency := range todoTxtFrequencies {
+		if frequency == match[1] {
+			return "rec", interval + unit
+		}
+	}
+	return "rrule", rule
+}
+
+func (TodoTxt) Decode(r io.Reader) ([]*domain.TodoList, error) {
+	tasks, err := ParseTodoTxt(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var lists []*domain.TodoList
+	byProject := make(map[string]*domain.TodoList)
+	for _, task := range tasks {
+		title := task.Project
+		if title == "" {
+			title = importedList
+		}
+
+		list, ok := byProject[title]
+		if !ok {
+			list = &domain.TodoList{Title: title}
+			byProject[title] = list
+			lists = append(lists, list)
+		}
+
+		task.Item.Id = 0
+		list.Items = append(list.Items, *task.Item)
+	}
+	return lists, nil
+}
+
+// ParseTodoTxt reads the tasks of a todo.txt file in order. Values of known
+// keys that cannot be read, such as due:tomorrow, are kept as extensions.
+// Words escaped with a backslash are part of the title.
+func ParseTodoTxt(r io.Reader) ([]TodoTxtTask, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+
+	var tasks []TodoTxtTask
+	for scanner.Scan() {
+		if words := strings.Fields(scanner.Text()); len(words) > 0 {
+			tasks = append(tasks, parseTodoTxtLine(words))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+func parseTodoTxtLine(words []string) TodoTxtTask {
+	item := &domain.TodoItem{}
+	var project string
+	var title []string
+
+	// Completed tasks may start with their completion and creation dates,
+	// others with their priority and creation date.
+	dates := 1
+	if words[0] == "x" {
+		item.Done = true
+		words = words[1:]
+		dates = 2
+	}
+	if len(words) > 0 {
+		if match := todoTxtPriority.FindStringSubmatch(words[0]); match != nil {
+			item.Priority = todoTxtLetterPriority(match[1])
+			words = words[1:]
+		}
+	}
+	for ; dates > 0 && len(words) > 0; dates-- {
+		if _, err := time.Parse(todoTxtDate, words[0]); err != nil {
+			break
+		}
+		words = words[1:]
+	}
+
+	for _, word := range words {
+		switch {
+		case len(word) > 1 && word[0] == '\\':
+			title = append(title, word[1:])
+		case len(word) > 1 && word[0] == '+' && project == "":
+			project = word[1:]
+		case len(word) > 1 && word[0] == '@':
+			if name := word[1:]; !hasTag(item, name) {
+				item.Tags = append(item.Tags, domain.Tag{Name: name})
+			}
+		default:
+			key, value, ok := todoTxtPair(word)
+			if !ok {
+				title = append(title, word)
+			} else if !setTodoTxtKey(item, key, value) {
+				if item.Extensions == nil {
+					item.Extensions = make(map[string]string)
+				}
+				item.Extensions[key] = value
+			}
+		}
+	}
+
+	item.Title = strings.Join(title, " ")
+	return TodoTxtTask{Item: item, Project: project}
+}
+
+// setTodoTxtKey sets the field of item key is written to, reporting whether
+// key is known and its value could be read.
+func setTodoTxtKey(item *domain.TodoItem, key, value string) bool {
+	switch key {
+	case "due", "t":
+		date, err := time.Parse(todoTxtDate, value)
+		if err != nil {
+			return false
+		}
+		if key == "due" {
+			item.DueAt = &date
+		} else {
+			item.StartAt = &date
+		}
+	case "rec":
+		match := todoTxtRec.FindStringSubmatch(value)
+		if match == nil {
+			return false
+		}
+		item.Recurrence = "FREQ=" + todoTxtFrequencies[match[2]]
+		if match[1] != "" && match[1] != "1" {
+			item.Recurrence += ";INTERVAL=" + match[1]
+		}
+	case "rrule":
+		item.Recurrence = value
+	case "pri":
+		if len(value) != 1 || value[0] < 'A' || value[0] > 'Z' {
+			return false
+		}
+		item.Priority = todoTxtLetterPriority(value)
+	case "id":
+		id, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || id <= 0 {
+			return false
+		}
+		item.Id = id
+	default:
+		return false
+	}
+	return true
+}
+
+func todoTxtLetterPriority(letter string) domain.Priority {
+	for priority, l := range todoTxtLetters {
+		if l == letter {
+			return priority
+		}
+	}
+	return domain.PriorityLow
+}
+
+// MergeTodoTxtDate returns the time to keep when date, read from a todo.txt
+// line, replaces t of item. Dates are all todo.txt keeps of times, so t is
+// kept when it falls on date in the time zone of item, otherwise date starts
+// in that time zone.
+func MergeTodoTxtDate(item *domain.TodoItem, t, date *time.Time) *time.Time {
+	if date == nil {
+		return nil
+	}
+
+	loc, err := time.LoadLocation(item.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+	if t != nil && t.In(loc).Format(todoTxtDate) == date.Format(todoTxtDate) {
+		return t
+	}
+
+	merged := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, loc)
+	return &merged
+}
@@ -0,0 +1,114 @@
+// Package transfer converts lists with their items to and from the formats
+// they are exported and imported in. Lists are trees: the Items of a list are
+// its top-level items and the Children of an item its subtasks, in order.
+package transfer
+
+import (
+	"errors"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/domain"
+	"io"
+	"path"
+	"strings"
+)
+
+var (
+	ErrUnknownFormat = errors.New("unknown format")
+)
+
+// importedList is the list items go to when the file does not name one.
+const importedList = "Imported"
+
+// Codec encodes and decodes lists in one format. Decoded lists and items are
+// new, they carry no ids.
+type Codec interface {
+	ContentType() string
+	Extension() string
+	Encode(w io.Writer, lists []*domain.TodoList) error
+	Decode(r io.Reader) ([]*domain.TodoList, error)
+}
+
+var codecs = map[string]Codec{
+	"json":     JSON{},
+	"csv":      CSV{},
+	"markdown": Markdown{},
+	"ics":      ICal{},
+	"todotxt":  TodoTxt{},
+}
+
+// Lookup returns the codec of the named format.
+func Lookup(format string) (Codec, error) {
+	codec, ok := codecs[strings.ToLower(format)]
+	if !ok {
+		return nil, ErrUnknownFormat
+	}
+	return codec, nil
+}
+
+// ForFileName returns the codec of the format files with the extension of
+// fileName are in.
+func ForFileName(fileName string) (Codec, error) {
+	ext := strings.ToLower(strings.TrimPrefix(path.Ext(fileName), "."))
+	for _, codec := range codecs {
+		if codec.Extension() == ext {
+			return codec, nil
+		}
+	}
+	return nil, ErrUnknownFormat
+}
+
+// Walk calls fn for every item of list, parents before their subtasks, with
+// the depth of the item, one for top-level items. It stops at the first
+// error fn returns.
+func Walk(list *domain.TodoList, fn func(item *domain.TodoItem, depth int) error) error {
+	var walk func(items []*domain.TodoItem, depth int) error
+	walk = func(items []*domain.TodoItem, depth int) error {
+		for _, item := range items {
+			if err := fn(item, depth); err != nil {
+				return err
+			}
+			if err := walk(item.Children, depth+1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	top := make([]*domain.TodoItem, len(list.Items))
+	for i := range list.Items {
+		top[i] = &list.Items[i]
+	}
+	return walk(top, 1)
+}
+
+// tree builds the items of a list from items in walk order and their depths.
+// Items deeper than the item before them become its subtasks.
+type tree struct {
+	top     []*domain.TodoItem
+	parents []*domain.TodoItem
+}
+
+func (t *tree) add(item *domain.TodoItem, depth int) {
+	depth = max(1, min(depth, len(t.parents)+1))
+	t.parents = t.parents[:depth-1]
+
+	if depth == 1 {
+		t.top = append(t.top, item)
+	} else {
+		parent := t.parents[depth-2]
+		parent.Children = append(parent.Children, item)
+	}
+	t.parents = append(t.parents, item)
+}
+
+// items returns the top-level items, as TodoList.Items keeps them.
+func (t *tree) items() []domain.TodoItem {
+	if len(t.top) == 0 {
+		return nil
+	}
+
+	items := make([]domain.TodoItem, len(t.top))
+	for i, item := range t.top {
+		items[i] = *item
+	}
+	return items
+}
@@ -0,0 +1,456 @@
+package transfer
+
+import (
+	"bytes"
+	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testLists() []*domain.TodoList {
+	due := time.Date(2024, 5, 3, 18, 0, 0, 0, time.UTC)
+	archived := time.Date(2024, 6, 1, 9, 30, 0, 0, time.UTC)
+
+	return []*domain.TodoList{
+		{
+			Title:       "Groceries",
+			Description: "For the weekend",
+			Items: []domain.TodoItem{
+				{
+					Title:       "Milk",
+					Description: "Oat, if they have it",
+					Children: []*domain.TodoItem{
+						{Title: "Check the fridge", Done: true},
+					},
+				},
+				{
+					Title:           "Bread",
+					DueAt:           &due,
+					TimeZone:        "Europe/Kyiv",
+					Priority:        domain.PriorityHigh,
+					Recurrence:      "FREQ=WEEKLY",
+					RecurrenceStart: &due,
+					Tags:            []domain.Tag{{Name: "bakery"}, {Name: "weekly"}},
+					Extensions:      map[string]string{"store": "corner"},
+				},
+			},
+		},
+		{
+			Title:      "Someday",
+			ArchivedAt: &archived,
+		},
+	}
+}
+
+func TestJSON_roundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, JSON{}.Encode(&buf, testLists()))
+
+	lists, err := JSON{}.Decode(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, testLists(), lists)
+}
+
+func TestCSV_roundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, CSV{}.Encode(&buf, testLists()))
+
+	lists, err := CSV{}.Decode(&buf)
+	require.NoError(t, err)
+
+	// CSV keeps neither list descriptions, archiving, recurrence starts nor
+	// extensions.
+	expected := testLists()
+	expected[0].Description = ""
+	expected[0].Items[1].RecurrenceStart = nil
+	expected[0].Items[1].Extensions = nil
+	expected[1].ArchivedAt = nil
+	assert.Equal(t, expected, lists)
+}
+
+func TestCSV_Decode(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []*domain.TodoList
+		err      string
+	}{
+		{
+			name:  "Reordered and missing columns",
+			input: "Title,Completed\nMilk,true\n  Bread ,\n",
+			expected: []*domain.TodoList{{Title: "Imported", Items: []domain.TodoItem{
+				{Title: "Milk", Done: true},
+				{Title: "Bread"},
+			}}},
+		},
+		{
+			name:  "Depth skipping levels",
+			input: "list,depth,title\nHome,1,Clean\nHome,3,Kitchen\nWork,1,Report\n",
+			expected: []*domain.TodoList{
+				{Title: "Home", Items: []domain.TodoItem{
+					{Title: "Clean", Children: []*domain.TodoItem{{Title: "Kitchen"}}},
+				}},
+				{Title: "Work", Items: []domain.TodoItem{{Title: "Report"}}},
+			},
+		},
+		{
+			name:  "Missing title column",
+			input: "list,name\nHome,Clean\n",
+			err:   "missing title column",
+		},
+		{
+			name:  "Invalid time",
+			input: "title,due_at\nClean,tomorrow\n",
+			err:   `line 2: invalid time "tomorrow", use RFC 3339`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lists, err := CSV{}.Decode(strings.NewReader(tt.input))
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, lists)
+		})
+	}
+}
+
+func TestMarkdown_roundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Markdown{}.Encode(&buf, testLists()))
+
+	assert.Equal(t, `# Groceries
+
+For the weekend
+
+- [ ] Milk
+  Oat, if they have it
+  - [x] Check the fridge
+- [ ] Bread
+
+# Someday
+`, buf.String())
+
+	lists, err := Markdown{}.Decode(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, []*domain.TodoList{
+		{
+			Title:       "Groceries",
+			Description: "For the weekend",
+			Items: []domain.TodoItem{
+				{
+					Title:       "Milk",
+					Description: "Oat, if they have it",
+					Children:    []*domain.TodoItem{{Title: "Check the fridge", Done: true}},
+				},
+				{Title: "Bread"},
+			},
+		},
+		{Title: "Someday"},
+	}, lists)
+}
+
+func TestMarkdown_Decode(t *testing.T) {
+	input := "Notes before any list are skipped.\n" +
+		"* [X] Loose item\n" +
+		"\n" +
+		"## C# ##\n" +
+		"- [ ] Learn\n" +
+		"\t- [ ] Generics\n" +
+		"      First line\n" +
+		"\n" +
+		"      Second paragraph\n" +
+		"- [ ] Practice\n" +
+		"Closing words\n"
+
+	lists, err := Markdown{}.Decode(strings.NewReader(input))
+	require.NoError(t, err)
+	assert.Equal(t, []*domain.TodoList{
+		{Title: "Imported", Items: []domain.TodoItem{{Title: "Loose item", Done: true}}},
+		{
+			Title:       "C#",
+			Description: "Closing words",
+			Items: []domain.TodoItem{
+				{Title: "Learn", Children: []*domain.TodoItem{
+					{Title: "Generics", Description: "First line\n\nSecond paragraph"},
+				}},
+				{Title: "Practice"},
+			},
+		},
+	}, lists)
+}
+
+func TestICal_roundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, ICal{}.Encode(&buf, testLists()[:1]))
+
+	encoded := buf.String()
+	assert.Contains(t, encoded, "X-WR-CALNAME:Groceries\r\n")
+	assert.Contains(t, encoded, "DESCRIPTION:Oat\\, if they have it\r\n")
+	assert.Contains(t, encoded, "RELATED-TO;RELTYPE=PARENT:new-1@go-grpc-todo\r\n")
+	assert.Contains(t, encoded, "DTSTART:20240503T180000Z\r\nDUE:20240503T180000Z\r\nPRIORITY:3\r\n")
+
+	lists, err := ICal{}.Decode(&buf)
+	require.NoError(t, err)
+
+	// iCalendar keeps neither list descriptions, time zones, recurrence
+	// starts nor extensions.
+	expected := testLists()[:1]
+	expected[0].Description = ""
+	expected[0].Items[1].TimeZone = ""
+	expected[0].Items[1].RecurrenceStart = nil
+	expected[0].Items[1].Extensions = nil
+	assert.Equal(t, expected, lists)
+}
+
+func TestICal_Encode_folding(t *testing.T) {
+	lists := []*domain.TodoList{{
+		Title: "Long",
+		Items: []domain.TodoItem{{Id: 7, Title: strings.Repeat("é", 60)}},
+	}}
+
+	var buf bytes.Buffer
+	require.NoError(t, ICal{}.Encode(&buf, lists))
+
+	for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), icalMaxLine)
+	}
+	assert.Contains(t, buf.String(), "UID:item-7@go-grpc-todo\r\n")
+
+	decoded, err := ICal{}.Decode(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, strings.Repeat("é", 60), decoded[0].Items[0].Title)
+}
+
+func TestICal_Decode(t *testing.T) {
+	kyiv, err := time.LoadLocation("Europe/Kyiv")
+	require.NoError(t, err)
+	due := time.Date(2024, 5, 3, 9, 0, 0, 0, kyiv)
+	day := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		input    string
+		expected []*domain.TodoList
+		err      string
+	}{
+		{
+			name: "To-dos",
+			input: "BEGIN:VCALENDAR\r\n" +
+				"VERSION:2.0\r\n" +
+				"BEGIN:VEVENT\r\n" +
+				"SUMMARY:Meeting\r\n" +
+				"END:VEVENT\r\n" +
+				"BEGIN:VTODO\r\n" +
+				"UID:b\r\n" +
+				"SUMMARY:Sub\r\n" +
+				" task\r\n" +
+				"RELATED-TO:a\r\n" +
+				"STATUS:COMPLETED\r\n" +
+				"END:VTODO\r\n" +
+				"BEGIN:VTODO\r\n" +
+				"UID:a\r\n" +
+				"SUMMARY:Report\\; final\r\n" +
+				"DESCRIPTION:Line one\\nLine two\r\n" +
+				"DUE;TZID=Europe/Kyiv:20240503T090000\r\n" +
+				"PRIORITY:1\r\n" +
+				"CATEGORIES:work,a\\,b,work\r\n" +
+				"BEGIN:VALARM\r\n" +
+				"SUMMARY:Reminder\r\n" +
+				"END:VALARM\r\n" +
+				"END:VTODO\r\n" +
+				"BEGIN:VTODO\r\n" +
+				"UID:c\r\n" +
+				"SUMMARY:Loop\r\n" +
+				"RELATED-TO;RELTYPE=PARENT:c\r\n" +
+				"DUE;VALUE=DATE:20240601\r\n" +
+				"PRIORITY:7\r\n" +
+				"END:VTODO\r\n" +
+				"END:VCALENDAR\r\n",
+			expected: []*domain.TodoList{{
+				Title: "Imported",
+				Items: []domain.TodoItem{
+					{
+						Title:       "Report; final",
+						Description: "Line one\nLine two",
+						DueAt:       &due,
+						TimeZone:    "Europe/Kyiv",
+						Priority:    domain.PriorityUrgent,
+						Tags:        []domain.Tag{{Name: "work"}, {Name: "a,b"}},
+						Children:    []*domain.TodoItem{{Title: "Subtask", Done: true}},
+					},
+					{Title: "Loop", DueAt: &day, Priority: domain.PriorityLow},
+				},
+			}},
+		},
+		{
+			name:     "No to-dos",
+			input:    "BEGIN:VCALENDAR\nX-WR-CALNAME:Empty\nEND:VCALENDAR\n",
+			expected: nil,
+		},
+		{
+			name:  "Not a calendar",
+			input: "BEGIN:VCARD\nEND:VCARD\n",
+			err:   "not an iCalendar file",
+		},
+		{
+			name:  "Unbalanced",
+			input: "BEGIN:VCALENDAR\nBEGIN:VTODO\nEND:VCALENDAR\n",
+			err:   "unexpected END:VCALENDAR",
+		},
+		{
+			name:  "Invalid due date",
+			input: "BEGIN:VCALENDAR\nBEGIN:VTODO\nDUE:tomorrow\nEND:VTODO\nEND:VCALENDAR\n",
+			err:   `invalid DUE "tomorrow"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lists, err := ICal{}.Decode(strings.NewReader(tt.input))
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, lists)
+		})
+	}
+}
+
+func TestTodoTxt_roundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, TodoTxt{}.Encode(&buf, testLists()))
+
+	assert.Equal(t, "Milk +Groceries\n"+
+		"x Check the fridge +Groceries\n"+
+		"(B) Bread +Groceries @bakery @weekly due:2024-05-03 rec:1w store:corner\n", buf.String())
+
+	lists, err := TodoTxt{}.Decode(&buf)
+	require.NoError(t, err)
+
+	// Due dates are read as the start of their day in UTC.
+	due := time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, []*domain.TodoList{{
+		Title: "Groceries",
+		Items: []domain.TodoItem{
+			{Title: "Milk"},
+			{Title: "Check the fridge", Done: true},
+			{
+				Title:      "Bread",
+				DueAt:      &due,
+				Priority:   domain.PriorityHigh,
+				Recurrence: "FREQ=WEEKLY",
+				Tags:       []domain.Tag{{Name: "bakery"}, {Name: "weekly"}},
+				Extensions: map[string]string{"store": "corner"},
+			},
+		},
+	}}, lists)
+
+	// Title words that look like something else are escaped.
+	titles := []string{
+		"x marks the spot",
+		"(A) is not a priority",
+		"2024-05-01 was a Wednesday",
+		"Email bob@example.com about +1 votes and @channel",
+		"Ask for status:done, not +Groceries",
+		`Escape C:\ and \n`,
+		`\`,
+	}
+	list := &domain.TodoList{Title: "Groceries"}
+	for _, title := range titles {
+		list.Items = append(list.Items, domain.TodoItem{Title: title})
+	}
+	buf.Reset()
+	require.NoError(t, TodoTxt{}.Encode(&buf, []*domain.TodoList{list}))
+	assert.Contains(t, buf.String(), `\x marks the spot +Groceries`)
+	assert.Contains(t, buf.String(), `\@channel`)
+
+	lists, err = TodoTxt{}.Decode(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, []*domain.TodoList{list}, lists)
+}
+
+func TestParseTodoTxt(t *testing.T) {
+	input := "x 2024-05-04 2024-05-01 Call mom +Family @phone pri:A id:7\n" +
+		"\n" +
+		"(C) 2024-05-01 Standup at 10:30 see https://meet.example +Work +Team @office due:2024-05-06 t:2024-05-05 rec:+2w id:abc\n" +
+		"Plan trip due:tomorrow rrule:FREQ=MONTHLY;BYDAY=1SA @travel @travel\n"
+
+	tasks, err := ParseTodoTxt(strings.NewReader(input))
+	require.NoError(t, err)
+
+	due := time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)
+	start := time.Date(2024, 5, 5, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, []TodoTxtTask{
+		{
+			Item:    &domain.TodoItem{Id: 7, Title: "Call mom", Done: true, Priority: domain.PriorityUrgent, Tags: []domain.Tag{{Name: "phone"}}},
+			Project: "Family",
+		},
+		{
+			Item: &domain.TodoItem{
+				Title:      "Standup at 10:30 see https://meet.example +Team",
+				DueAt:      &due,
+				StartAt:    &start,
+				Priority:   domain.PriorityMedium,
+				Recurrence: "FREQ=WEEKLY;INTERVAL=2",
+				Tags:       []domain.Tag{{Name: "office"}},
+				Extensions: map[string]string{"id": "abc"},
+			},
+			Project: "Work",
+		},
+		{
+			Item: &domain.TodoItem{
+				Title:      "Plan trip",
+				Recurrence: "FREQ=MONTHLY;BYDAY=1SA",
+				Tags:       []domain.Tag{{Name: "travel"}},
+				Extensions: map[string]string{"due": "tomorrow"},
+			},
+		},
+	}, tasks)
+
+	// Values that could not be read are written back as they were, unless
+	// the field has been set since.
+	assert.Equal(t, "Plan trip @travel rrule:FREQ=MONTHLY;BYDAY=1SA due:tomorrow", todoTxtLine(tasks[2].Item, ""))
+	tasks[2].Item.DueAt = &due
+	assert.Equal(t, "Plan trip @travel due:2024-05-06 rrule:FREQ=MONTHLY;BYDAY=1SA", todoTxtLine(tasks[2].Item, ""))
+}
+
+func TestMergeTodoTxtDate(t *testing.T) {
+	item := &domain.TodoItem{TimeZone: "America/New_York"}
+	loc, err := time.LoadLocation(item.TimeZone)
+	require.NoError(t, err)
+
+	// 2024-05-06 01:30 UTC is still the 5th in New York.
+	dueAt := time.Date(2024, 5, 6, 1, 30, 0, 0, time.UTC)
+	same := time.Date(2024, 5, 5, 0, 0, 0, 0, time.UTC)
+	other := time.Date(2024, 5, 7, 0, 0, 0, 0, time.UTC)
+
+	assert.Same(t, &dueAt, MergeTodoTxtDate(item, &dueAt, &same))
+	assert.True(t, time.Date(2024, 5, 7, 0, 0, 0, 0, loc).Equal(*MergeTodoTxtDate(item, &dueAt, &other)))
+	assert.True(t, time.Date(2024, 5, 7, 0, 0, 0, 0, loc).Equal(*MergeTodoTxtDate(item, nil, &other)))
+	assert.Nil(t, MergeTodoTxtDate(item, &dueAt, nil))
+}
+
+func TestLookup(t *testing.T) {
+	codec, err := Lookup("Markdown")
+	require.NoError(t, err)
+	assert.Equal(t, Markdown{}, codec)
+
+	codec, err = ForFileName("lists.CSV")
+	require.NoError(t, err)
+	assert.Equal(t, CSV{}, codec)
+
+	codec, err = ForFileName("todo.txt")
+	require.NoError(t, err)
+	assert.Equal(t, TodoTxt{}, codec)
+
+	_, err = Lookup("xml")
+	assert.ErrorIs(t, err, ErrUnknownFormat)
+}
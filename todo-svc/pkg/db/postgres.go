@@ -9,12 +9,14 @@ import (
 	"log"
 )
 
-func ConnectToPostgreSQL(cfg *config.Config) (db *gorm.DB) {
-	dsn := fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=%s",
+func DSN(cfg *config.Config) string {
+	return fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=%s",
 		cfg.Database.Host, cfg.Database.Port, cfg.Database.PostgresUser, cfg.Database.PostgresDB,
 		cfg.Database.PostgresPassword, cfg.Database.PostgresSSLMode)
+}
 
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+func ConnectToPostgreSQL(cfg *config.Config) (db *gorm.DB) {
+	db, err := gorm.Open(postgres.Open(DSN(cfg)), &gorm.Config{})
 	if err != nil {
 		log.Fatalf("Failed to connect to database. Error: %v", err)
 	}
@@ -24,6 +26,14 @@ func ConnectToPostgreSQL(cfg *config.Config) (db *gorm.DB) {
 		&domain.UsersList{},
 		&domain.TodoItem{},
 		&domain.ListsItem{},
+		&domain.Tag{},
+		&domain.ItemsTag{},
+		&domain.Invitation{},
+		&domain.Comment{},
+		&domain.Attachment{},
+		&domain.Activity{},
+		&domain.IdempotencyKey{},
+		&domain.CalendarFeed{},
 	)
 	if err != nil {
 		log.Fatalf("Failed to migrate database. Error: %v", err)
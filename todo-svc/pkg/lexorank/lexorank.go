@@ -0,0 +1,225 @@
+package lexorank
+
+import (
+	"errors"
+	"strings"
+)
+
+// Keys are an integer part followed by an optional fraction, both written in
+// base 62. The first character of the integer part encodes its length, 'a'
+// and up for positive integers and 'Z' and down for negative ones, so that
+// appending and prepending keep keys short. Keys must be compared byte by
+// byte, e.g. with COLLATE "C" in Postgres.
+const digits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// smallestInteger is the lowest integer part, no key can come before it.
+var smallestInteger = "A" + strings.Repeat(digits[:1], 26)
+
+var (
+	ErrInvalidKey = errors.New("invalid order key")
+	ErrOrder      = errors.New("order keys are not in ascending order")
+	ErrExhausted  = errors.New("no order key left in this direction")
+)
+
+// Between returns a key that sorts after before and ahead of after. An empty
+// before means the start and an empty after the end of the sequence, so
+// Between("", "") returns the first key of an empty sequence.
+func Between(before, after string) (string, error) {
+	if before != "" {
+		if err := validate(before); err != nil {
+			return "", err
+		}
+	}
+	if after != "" {
+		if err := validate(after); err != nil {
+			return "", err
+		}
+	}
+	if before != "" && after != "" && before >= after {
+		return "", ErrOrder
+	}
+
+	if before == "" {
+		if after == "" {
+			return "a" + digits[:1], nil
+		}
+
+		integer := integerPart(after)
+		if integer == smallestInteger {
+			return integer + midpoint("", after[len(integer):]), nil
+		}
+		if integer < after {
+			return integer, nil
+		}
+		previous := decrement(integer)
+		if previous == "" {
+			return "", ErrExhausted
+		}
+		// The smallest integer only comes with a fraction.
+		if previous == smallestInteger {
+			return previous + midpoint("", ""), nil
+		}
+		return previous, nil
+	}
+
+	integer := integerPart(before)
+	fraction := before[len(integer):]
+	if after == "" {
+		next := increment(integer)
+		if next == "" {
+			return integer + midpoint(fraction, ""), nil
+		}
+		return next, nil
+	}
+
+	if afterInteger := integerPart(after); integer == afterInteger {
+		return integer + midpoint(fraction, after[len(afterInteger):]), nil
+	}
+	next := increment(integer)
+	if next == "" {
+		return "", ErrExhausted
+	}
+	if next < after {
+		return next, nil
+	}
+	return integer + midpoint(fraction, ""), nil
+}
+
+// midpoint returns a fraction between a and b, an empty b stands for one.
+func midpoint(a, b string) string {
+	if b != "" {
+		n := 0
+		for n < len(b) && digitAt(a, n) == b[n] {
+			n++
+		}
+		if n > 0 {
+			if n > len(a) {
+				return b[:n] + midpoint("", b[n:])
+			}
+			return b[:n] + midpoint(a[n:], b[n:])
+		}
+	}
+
+	low := 0
+	if a != "" {
+		low = strings.IndexByte(digits, a[0])
+	}
+	high := len(digits)
+	if b != "" {
+		high = strings.IndexByte(digits, b[0])
+	}
+
+	if high-low > 1 {
+		return digits[(low+high+1)/2 : (low+high+1)/2+1]
+	}
+	if len(b) > 1 {
+		return b[:1]
+	}
+	if a == "" {
+		return digits[low:low+1] + midpoint("", "")
+	}
+	return a[:1] + midpoint(a[1:], "")
+}
+
+func digitAt(s string, i int) byte {
+	if i < len(s) {
+		return s[i]
+	}
+	return digits[0]
+}
+
+func integerLength(head byte) int {
+	switch {
+	case head >= 'a' && head <= 'z':
+		return int(head-'a') + 2
+	case head >= 'A' && head <= 'Z':
+		return int('Z'-head) + 2
+	default:
+		return 0
+	}
+}
+
+func integerPart(key string) string {
+	return key[:integerLength(key[0])]
+}
+
+func validate(key string) error {
+	length := integerLength(key[0])
+	if length == 0 || length > len(key) || key == smallestInteger {
+		return ErrInvalidKey
+	}
+	for i := 1; i < len(key); i++ {
+		if strings.IndexByte(digits, key[i]) < 0 {
+			return ErrInvalidKey
+		}
+	}
+	if len(key) > length && key[len(key)-1] == digits[0] {
+		return ErrInvalidKey
+	}
+	return nil
+}
+
+// increment returns the integer after x, or an empty string past the largest.
+func increment(x string) string {
+	head, body := x[0], []byte(x[1:])
+	carry := true
+	for i := len(body) - 1; carry && i >= 0; i-- {
+		d := strings.IndexByte(digits, body[i]) + 1
+		if d == len(digits) {
+			body[i] = digits[0]
+		} else {
+			body[i] = digits[d]
+			carry = false
+		}
+	}
+	if !carry {
+		return string(head) + string(body)
+	}
+
+	switch head {
+	case 'Z':
+		return "a" + digits[:1]
+	case 'z':
+		return ""
+	}
+	head++
+	if head > 'a' {
+		body = append(body, digits[0])
+	} else {
+		body = body[:len(body)-1]
+	}
+	return string(head) + string(body)
+}
+
+// decrement returns the integer before x, or an empty string past the
+// smallest.
+func decrement(x string) string {
+	head, body := x[0], []byte(x[1:])
+	borrow := true
+	for i := len(body) - 1; borrow && i >= 0; i-- {
+		d := strings.IndexByte(digits, body[i]) - 1
+		if d == -1 {
+			body[i] = digits[len(digits)-1]
+		} else {
+			body[i] = digits[d]
+			borrow = false
+		}
+	}
+	if !borrow {
+		return string(head) + string(body)
+	}
+
+	switch head {
+	case 'a':
+		return "Z" + digits[len(digits)-1:]
+	case 'A':
+		return ""
+	}
+	head--
+	if head < 'Z' {
+		body = append(body, digits[len(digits)-1])
+	} else {
+		body = body[:len(body)-1]
+	}
+	return string(head) + string(body)
+}
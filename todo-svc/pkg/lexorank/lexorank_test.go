@@ -0,0 +1,92 @@
+package lexorank
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestBetween(t *testing.T) {
+	largest := "z" + strings.Repeat("z", 26)
+
+	tests := []struct {
+		name        string
+		before      string
+		after       string
+		expectedKey string
+		expectedErr error
+	}{
+		{name: "Empty sequence", expectedKey: "a0"},
+		{name: "Append", before: "a0", expectedKey: "a1"},
+		{name: "Append with a longer integer", before: "az", expectedKey: "b00"},
+		{name: "Append after a negative integer", before: "Zz", expectedKey: "a0"},
+		{name: "Prepend", after: "a0", expectedKey: "Zz"},
+		{name: "Prepend to a fraction", after: "a0V", expectedKey: "a0"},
+		{name: "Bisect integers", before: "a0", after: "a2", expectedKey: "a1"},
+		{name: "Adjacent integers", before: "a0", after: "a1", expectedKey: "a0V"},
+		{name: "Adjacent negative integers", before: "Zz", after: "a0", expectedKey: "ZzV"},
+		{name: "Bisect fractions", before: "a0", after: "a0V", expectedKey: "a0G"},
+		{name: "Fraction before the next integer", before: "a0V", after: "a1", expectedKey: "a0l"},
+		{name: "Adjacent fractions", before: "a0", after: "a0001", expectedKey: "a0000V"},
+		{name: "Append past the largest integer", before: largest, expectedKey: largest + "V"},
+		{
+			name:        "Prepend at the smallest integer",
+			after:       smallestInteger + "1",
+			expectedKey: smallestInteger + "0V",
+		},
+		{
+			name:        "Prepend next to the smallest integer",
+			after:       "A" + strings.Repeat("0", 25) + "1",
+			expectedKey: smallestInteger + "V",
+		},
+		{name: "Keys in the wrong order", before: "a1", after: "a0", expectedErr: ErrOrder},
+		{name: "Equal keys", before: "a1", after: "a1", expectedErr: ErrOrder},
+		{name: "Integer part too short", before: "b", expectedErr: ErrInvalidKey},
+		{name: "Trailing zero", before: "a00", expectedErr: ErrInvalidKey},
+		{name: "Invalid digit", after: "a0!", expectedErr: ErrInvalidKey},
+		{name: "Smallest integer alone", after: smallestInteger, expectedErr: ErrInvalidKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := Between(tt.before, tt.after)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expectedKey, key)
+			if err == nil {
+				assert.NoError(t, validate(key))
+				assert.True(t, tt.before == "" || tt.before < key, key)
+				assert.True(t, tt.after == "" || key < tt.after, key)
+			}
+		})
+	}
+}
+
+func TestBetween_repeated(t *testing.T) {
+	// Inserting again and again at the same spot keeps the order.
+	before, after := "a0", "a1"
+	for i := 0; i < 200; i++ {
+		key, err := Between(before, after)
+		assert.NoError(t, err)
+		assert.True(t, before < key && key < after, key)
+		if i%2 == 0 {
+			before = key
+		} else {
+			after = key
+		}
+	}
+
+	// So does prepending and appending.
+	first, last := "a0", "a0"
+	for i := 0; i < 200; i++ {
+		key, err := Between("", first)
+		assert.NoError(t, err)
+		assert.Less(t, key, first)
+		first = key
+
+		key, err = Between(last, "")
+		assert.NoError(t, err)
+		assert.Greater(t, key, last)
+		last = key
+	}
+}
@@ -0,0 +1,36 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid page token")
+)
+
+// Encode turns a cursor into an opaque page token. Clients only pass tokens
+// back and must not rely on what is inside.
+func Encode(cursor any) (string, error) {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// Decode reads a page token created by Encode into cursor.
+func Decode(token string, cursor any) error {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return ErrInvalidToken
+	}
+
+	if err := json.Unmarshal(data, cursor); err != nil {
+		return ErrInvalidToken
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type cursor struct {
+	Id        int64      `json:"id"`
+	Title     string     `json:"title,omitempty"`
+	DueAt     *time.Time `json:"due_at,omitempty"`
+	Ascending bool       `json:"ascending,omitempty"`
+}
+
+func TestEncode_roundTrip(t *testing.T) {
+	dueAt := time.Date(2024, 5, 3, 18, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		cursor cursor
+	}{
+		{name: "Id only", cursor: cursor{Id: 42}},
+		{name: "All fields", cursor: cursor{Id: 7, Title: "Milk & bread?/+", DueAt: &dueAt, Ascending: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := Encode(tt.cursor)
+			require.NoError(t, err)
+			// Tokens go into query strings as they are.
+			assert.NotContains(t, token, "=")
+			assert.NotContains(t, token, "+")
+			assert.NotContains(t, token, "/")
+
+			var decoded cursor
+			require.NoError(t, Decode(token, &decoded))
+			assert.Equal(t, tt.cursor, decoded)
+		})
+	}
+}
+
+func TestDecode_invalid(t *testing.T) {
+	valid, err := Encode(cursor{Id: 42})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "Not base64", token: "not a token!"},
+		{name: "Padded", token: base64.URLEncoding.EncodeToString([]byte(`{"id":4}`))},
+		{name: "Truncated", token: valid[:len(valid)-3]},
+		{name: "Tampered", token: "f" + valid[1:]},
+		{name: "Not JSON", token: base64.RawURLEncoding.EncodeToString([]byte("id=42"))},
+		{name: "Wrong type", token: base64.RawURLEncoding.EncodeToString([]byte(`{"id":"42"}`))},
+		{name: "Not an object", token: base64.RawURLEncoding.EncodeToString([]byte(`[42]`))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var decoded cursor
+			assert.ErrorIs(t, Decode(tt.token, &decoded), ErrInvalidToken)
+		})
+	}
+}
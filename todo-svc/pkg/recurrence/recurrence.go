@@ -0,0 +1,115 @@
+package recurrence
+
+import (
+	"errors"
+	"github.com/teambition/rrule-go"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidRule          = errors.New("invalid recurrence rule")
+	ErrUnsupportedFrequency = errors.New("recurrence must not repeat more often than hourly")
+	ErrCountAndUntil        = errors.New("recurrence can end after a number of occurrences or at a date, not both")
+)
+
+// Normalize validates an RFC 5545 RRULE such as "FREQ=WEEKLY;BYDAY=MO",
+// applies the optional count and until end conditions and returns the rule in
+// canonical form. DTSTART is not part of the rule, series are anchored at the
+// due date of their first item instead.
+func Normalize(rule string, count int32, until *time.Time) (string, error) {
+	option, err := parse(rule)
+	if err != nil {
+		return "", err
+	}
+
+	if count < 0 {
+		return "", ErrInvalidRule
+	}
+	if count > 0 {
+		option.Count = int(count)
+	}
+	if until != nil {
+		option.Until = until.UTC()
+	}
+
+	if option.Count != 0 && !option.Until.IsZero() {
+		return "", ErrCountAndUntil
+	}
+
+	if _, err := rrule.NewRRule(*option); err != nil {
+		return "", ErrInvalidRule
+	}
+
+	return option.RRuleString(), nil
+}
+
+// Occurrences returns up to limit occurrences of the series that starts at
+// dtstart, skipping those before from. Occurrences are computed in loc so that
+// e.g. a weekly 9:00 item stays at 9:00 across DST changes.
+func Occurrences(rule string, dtstart time.Time, loc *time.Location, from time.Time, limit int) ([]time.Time, error) {
+	option, err := parse(rule)
+	if err != nil {
+		return nil, err
+	}
+	dtstart = dtstart.In(loc).Truncate(time.Second)
+	option.Dtstart = dtstart
+
+	// RFC 5545 counts DTSTART as the first occurrence even if it does not
+	// match the rule while rrule-go skips it, so COUNT is enforced here.
+	count := option.Count
+	option.Count = 0
+
+	r, err := rrule.NewRRule(*option)
+	if err != nil {
+		return nil, ErrInvalidRule
+	}
+
+	var occurrences []time.Time
+	series := r.Iterator()
+	occurrence, ok := dtstart, true
+	for n := 1; ok && len(occurrences) < limit; n++ {
+		if count != 0 && n > count {
+			break
+		}
+		if !occurrence.Before(from) {
+			occurrences = append(occurrences, occurrence.UTC())
+		}
+
+		occurrence, ok = series()
+		if ok && occurrence.Equal(dtstart) {
+			occurrence, ok = series()
+		}
+	}
+
+	return occurrences, nil
+}
+
+// Next returns the first occurrence of the series after current, or nil once
+// the series has ended.
+func Next(rule string, dtstart time.Time, loc *time.Location, current time.Time) (*time.Time, error) {
+	occurrences, err := Occurrences(rule, dtstart, loc, current.Add(time.Second), 1)
+	if err != nil || len(occurrences) == 0 {
+		return nil, err
+	}
+
+	return &occurrences[0], nil
+}
+
+func parse(rule string) (*rrule.ROption, error) {
+	rule = strings.TrimSpace(rule)
+	if rule == "" || strings.Contains(rule, "\n") {
+		return nil, ErrInvalidRule
+	}
+
+	option, err := rrule.StrToROption(rule)
+	if err != nil || !option.Dtstart.IsZero() {
+		return nil, ErrInvalidRule
+	}
+
+	if option.Freq == rrule.MINUTELY || option.Freq == rrule.SECONDLY {
+		return nil, ErrUnsupportedFrequency
+	}
+
+	return option, nil
+}
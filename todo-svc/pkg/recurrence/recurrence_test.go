@@ -0,0 +1,152 @@
+package recurrence
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestNormalize(t *testing.T) {
+	until := time.Date(2024, 6, 30, 0, 0, 0, 0, time.FixedZone("CET", 3600))
+
+	tests := []struct {
+		name         string
+		rule         string
+		count        int32
+		until        *time.Time
+		expectedRule string
+		expectedErr  error
+	}{
+		{name: "Weekly", rule: "FREQ=WEEKLY;BYDAY=MO", expectedRule: "FREQ=WEEKLY;BYDAY=MO"},
+		{name: "Hourly", rule: "FREQ=HOURLY;BYHOUR=9,17", expectedRule: "FREQ=HOURLY;BYHOUR=9,17"},
+		{name: "Count in the rule", rule: "FREQ=DAILY;COUNT=5", expectedRule: "FREQ=DAILY;COUNT=5"},
+		{name: "Count given", rule: "FREQ=DAILY", count: 3, expectedRule: "FREQ=DAILY;COUNT=3"},
+		{name: "Until in the rule", rule: "FREQ=DAILY;UNTIL=20240630T000000Z", expectedRule: "FREQ=DAILY;UNTIL=20240630T000000Z"},
+		{name: "Until given in UTC", rule: "FREQ=MONTHLY", until: &until, expectedRule: "FREQ=MONTHLY;UNTIL=20240629T230000Z"},
+		{name: "Count and until", rule: "FREQ=DAILY;COUNT=2", until: &until, expectedErr: ErrCountAndUntil},
+		{name: "Negative count", rule: "FREQ=DAILY", count: -1, expectedErr: ErrInvalidRule},
+		{name: "Too frequent", rule: "FREQ=MINUTELY", expectedErr: ErrUnsupportedFrequency},
+		{name: "Unknown frequency", rule: "FREQ=SOMETIMES", expectedErr: ErrInvalidRule},
+		{name: "Empty", rule: " ", expectedErr: ErrInvalidRule},
+		{name: "With DTSTART", rule: "DTSTART=20240101T000000Z;FREQ=DAILY", expectedErr: ErrInvalidRule},
+		{name: "Several lines", rule: "DTSTART:20240101T000000Z\nRRULE:FREQ=DAILY", expectedErr: ErrInvalidRule},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := Normalize(tt.rule, tt.count, tt.until)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expectedRule, rule)
+		})
+	}
+}
+
+func TestOccurrences(t *testing.T) {
+	kyiv, err := time.LoadLocation("Europe/Kyiv")
+	require.NoError(t, err)
+
+	// 9:00 in Kyiv, a week before the clocks go forward.
+	dtstart := time.Date(2024, 3, 25, 7, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	tests := []struct {
+		name                string
+		rule                string
+		from                time.Time
+		limit               int
+		expectedOccurrences []time.Time
+		expectedErr         error
+	}{
+		{
+			name:  "Stays at the same local time",
+			rule:  "FREQ=WEEKLY",
+			from:  dtstart,
+			limit: 3,
+			expectedOccurrences: []time.Time{
+				dtstart,
+				time.Date(2024, 4, 1, 6, 0, 0, 0, time.UTC),
+				time.Date(2024, 4, 8, 6, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:  "DTSTART counts even off the rule",
+			rule:  "FREQ=WEEKLY;BYDAY=WE",
+			from:  dtstart,
+			limit: 3,
+			expectedOccurrences: []time.Time{
+				dtstart,
+				dtstart.Add(2 * day),
+				time.Date(2024, 4, 3, 6, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:                "Count includes DTSTART",
+			rule:                "FREQ=DAILY;COUNT=3",
+			from:                dtstart.Add(day),
+			limit:               10,
+			expectedOccurrences: []time.Time{dtstart.Add(day), dtstart.Add(2 * day)},
+		},
+		{
+			name:                "Until",
+			rule:                "FREQ=DAILY;UNTIL=20240327T070000Z",
+			from:                dtstart,
+			limit:               10,
+			expectedOccurrences: []time.Time{dtstart, dtstart.Add(day), dtstart.Add(2 * day)},
+		},
+		{
+			name:  "Ended",
+			rule:  "FREQ=DAILY;COUNT=2",
+			from:  dtstart.Add(2 * day),
+			limit: 10,
+		},
+		{
+			name:        "Invalid rule",
+			rule:        "FREQ=SOMETIMES",
+			from:        dtstart,
+			limit:       10,
+			expectedErr: ErrInvalidRule,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			occurrences, err := Occurrences(tt.rule, dtstart, kyiv, tt.from, tt.limit)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expectedOccurrences, occurrences)
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	dtstart := time.Date(2024, 3, 25, 9, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	tests := []struct {
+		name         string
+		rule         string
+		current      time.Time
+		expectedNext *time.Time
+		expectedErr  error
+	}{
+		{name: "Next occurrence", rule: "FREQ=DAILY;COUNT=2", current: dtstart, expectedNext: ptr(dtstart.Add(day))},
+		{name: "Count reached", rule: "FREQ=DAILY;COUNT=2", current: dtstart.Add(day)},
+		{name: "Until passed", rule: "FREQ=DAILY;UNTIL=20240327T000000Z", current: dtstart.Add(day)},
+		{name: "Invalid rule", rule: "FREQ=MINUTELY", current: dtstart, expectedErr: ErrUnsupportedFrequency},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next, err := Next(tt.rule, dtstart, time.UTC, tt.current)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expectedNext, next)
+		})
+	}
+}
+
+func ptr(t time.Time) *time.Time {
+	return &t
+}
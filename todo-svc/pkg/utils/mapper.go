@@ -3,11 +3,24 @@ package utils
 import (
 	"github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/domain"
 	pb "github.com/cloud9cloud9/go-grpc-todo/todo-svc/internal/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
 )
 
 type Mapper interface {
 	FromDomainLists(domainList []*domain.TodoList) []*pb.TodoList
 	FromDomainItems(domainList []*domain.TodoItem) []*pb.TodoItem
+	FromDomainItem(item *domain.TodoItem) *pb.TodoItem
+	FromDomainTags(domainTags []*domain.Tag) []*pb.Tag
+	FromDomainSearchResults(domainResults []*domain.SearchResult) []*pb.SearchResult
+	FromDomainMembers(domainMembers []*domain.UsersList) []*pb.ListMember
+	FromDomainInvitations(domainInvitations []*domain.Invitation) []*pb.Invitation
+	FromDomainInvitation(invitation *domain.Invitation) *pb.Invitation
+	FromDomainComments(domainComments []*domain.Comment) []*pb.Comment
+	FromDomainComment(comment *domain.Comment) *pb.Comment
+	FromDomainAttachments(domainAttachments []*domain.Attachment) []*pb.Attachment
+	FromDomainAttachment(attachment *domain.Attachment) *pb.Attachment
+	FromDomainActivities(domainActivities []*domain.Activity) []*pb.Activity
 }
 
 type MapperImpl struct {
@@ -21,8 +34,12 @@ func (m *MapperImpl) FromDomainLists(domainList []*domain.TodoList) []*pb.TodoLi
 	var lists []*pb.TodoList
 	for _, list := range domainList {
 		lists = append(lists, &pb.TodoList{
-			Id:    list.Id,
-			Title: list.Title,
+			Id:         list.Id,
+			Title:      list.Title,
+			DeletedAt:  ToTimestamp(&list.DeletedAt.Time),
+			ArchivedAt: ToTimestamp(list.ArchivedAt),
+			Position:   list.Position,
+			Version:    list.Version,
 		})
 	}
 
@@ -32,13 +49,189 @@ func (m *MapperImpl) FromDomainLists(domainList []*domain.TodoList) []*pb.TodoLi
 func (m *MapperImpl) FromDomainItems(domainList []*domain.TodoItem) []*pb.TodoItem {
 	var items []*pb.TodoItem
 	for _, item := range domainList {
-		items = append(items, &pb.TodoItem{
-			Id:          item.Id,
-			Title:       item.Title,
-			Description: item.Description,
-			Completed:   item.Done,
-		})
+		items = append(items, m.FromDomainItem(item))
 	}
 
 	return items
 }
+
+func (m *MapperImpl) FromDomainItem(item *domain.TodoItem) *pb.TodoItem {
+	var parentId int64
+	if item.ParentId != nil {
+		parentId = *item.ParentId
+	}
+
+	return &pb.TodoItem{
+		Id:          item.Id,
+		Title:       item.Title,
+		Description: item.Description,
+		Completed:   item.Done,
+		StartAt:     ToTimestamp(item.StartAt),
+		DueAt:       ToTimestamp(item.DueAt),
+		TimeZone:    item.TimeZone,
+		ListId:      item.ListId,
+		Priority:    pb.Priority(item.Priority),
+		CreatedAt:   ToTimestamp(&item.CreatedAt),
+		Tags:        m.fromDomainItemTags(item.Tags),
+		ParentId:    parentId,
+		Children:    m.FromDomainItems(item.Children),
+		Recurrence:  item.Recurrence,
+		DeletedAt:   ToTimestamp(&item.DeletedAt.Time),
+		Position:    item.Position,
+		Version:     item.Version,
+	}
+}
+
+func (m *MapperImpl) FromDomainTags(domainTags []*domain.Tag) []*pb.Tag {
+	var tags []*pb.Tag
+	for _, tag := range domainTags {
+		tags = append(tags, &pb.Tag{
+			Id:   tag.Id,
+			Name: tag.Name,
+		})
+	}
+
+	return tags
+}
+
+func (m *MapperImpl) FromDomainSearchResults(domainResults []*domain.SearchResult) []*pb.SearchResult {
+	var results []*pb.SearchResult
+	for _, result := range domainResults {
+		results = append(results, &pb.SearchResult{
+			Item: m.FromDomainItem(&result.Item),
+			List: &pb.TodoList{
+				Id:    result.Item.ListId,
+				Title: result.ListTitle,
+			},
+			Snippet: result.Snippet,
+			Rank:    result.Rank,
+		})
+	}
+
+	return results
+}
+
+func (m *MapperImpl) FromDomainMembers(domainMembers []*domain.UsersList) []*pb.ListMember {
+	var members []*pb.ListMember
+	for _, member := range domainMembers {
+		members = append(members, &pb.ListMember{
+			UserId: member.UserId,
+			Role:   pb.ListRole(member.Role),
+		})
+	}
+
+	return members
+}
+
+func (m *MapperImpl) FromDomainInvitations(domainInvitations []*domain.Invitation) []*pb.Invitation {
+	var invitations []*pb.Invitation
+	for _, invitation := range domainInvitations {
+		invitations = append(invitations, m.FromDomainInvitation(invitation))
+	}
+
+	return invitations
+}
+
+func (m *MapperImpl) FromDomainInvitation(invitation *domain.Invitation) *pb.Invitation {
+	return &pb.Invitation{
+		Id:             invitation.Id,
+		ListId:         invitation.ListId,
+		Email:          invitation.Email,
+		Role:           pb.ListRole(invitation.Role),
+		Token:          invitation.Token,
+		InviterId:      invitation.InviterId,
+		ExpiresAt:      ToTimestamp(&invitation.ExpiresAt),
+		AwaitingSignup: invitation.InviteeId == nil,
+	}
+}
+
+func (m *MapperImpl) FromDomainComments(domainComments []*domain.Comment) []*pb.Comment {
+	var comments []*pb.Comment
+	for _, comment := range domainComments {
+		comments = append(comments, m.FromDomainComment(comment))
+	}
+
+	return comments
+}
+
+func (m *MapperImpl) FromDomainComment(comment *domain.Comment) *pb.Comment {
+	return &pb.Comment{
+		Id:        comment.Id,
+		ItemId:    comment.ItemId,
+		AuthorId:  comment.AuthorId,
+		Body:      comment.Body,
+		Edited:    comment.Edited,
+		CreatedAt: ToTimestamp(&comment.CreatedAt),
+		UpdatedAt: ToTimestamp(&comment.UpdatedAt),
+	}
+}
+
+func (m *MapperImpl) FromDomainAttachments(domainAttachments []*domain.Attachment) []*pb.Attachment {
+	var attachments []*pb.Attachment
+	for _, attachment := range domainAttachments {
+		attachments = append(attachments, m.FromDomainAttachment(attachment))
+	}
+
+	return attachments
+}
+
+func (m *MapperImpl) FromDomainAttachment(attachment *domain.Attachment) *pb.Attachment {
+	return &pb.Attachment{
+		Id:          attachment.Id,
+		ItemId:      attachment.ItemId,
+		UploaderId:  attachment.UploaderId,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		CreatedAt:   ToTimestamp(&attachment.CreatedAt),
+	}
+}
+
+func (m *MapperImpl) fromDomainItemTags(domainTags []domain.Tag) []*pb.Tag {
+	var tags []*pb.Tag
+	for _, tag := range domainTags {
+		tags = append(tags, &pb.Tag{
+			Id:   tag.Id,
+			Name: tag.Name,
+		})
+	}
+
+	return tags
+}
+
+// ToTimestamp converts an optional time into its protobuf representation.
+// Zero times are treated as unset.
+func ToTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// FromTimestamp converts an optional protobuf timestamp into a UTC time.
+func FromTimestamp(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+func (m *MapperImpl) FromDomainActivities(domainActivities []*domain.Activity) []*pb.Activity {
+	var activities []*pb.Activity
+	for _, activity := range domainActivities {
+		activities = append(activities, &pb.Activity{
+			Id:         activity.Id,
+			ListId:     activity.ListId,
+			ActorId:    activity.ActorId,
+			Action:     string(activity.Action),
+			TargetType: string(activity.TargetType),
+			TargetId:   activity.TargetId,
+			Before:     string(activity.Before),
+			After:      string(activity.After),
+			CreatedAt:  ToTimestamp(&activity.CreatedAt),
+		})
+	}
+
+	return activities
+}